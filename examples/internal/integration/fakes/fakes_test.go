@@ -0,0 +1,104 @@
+//go:build grpcgateway_fakes
+
+package fakes_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/examples/internal/proto/examplepb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// TestGenerateUnboundMethodsEchoService tests the HTTP bindings of
+// GenerateUnboundMethodsEchoService in process with the fakes generated with the
+// generate_fakes option, which are only built with the grpcgateway_fakes build tag:
+//
+//	go test -tags grpcgateway_fakes ./examples/internal/integration/fakes
+func TestGenerateUnboundMethodsEchoService(t *testing.T) {
+	ctx := context.Background()
+	msg := &examplepb.GenerateUnboundMethodsSimpleMessage{
+		Id:       "foo",
+		Num:      42,
+		Duration: durationpb.New(3 * time.Second),
+	}
+
+	for _, spec := range []struct {
+		name       string
+		newRequest func(context.Context, *examplepb.GenerateUnboundMethodsSimpleMessage) (*http.Request, error)
+		server     *examplepb.FakeGenerateUnboundMethodsEchoServiceServer
+		wantCode   int
+	}{
+		{
+			name:       "Echo",
+			newRequest: examplepb.NewGenerateUnboundMethodsEchoService_Echo_0_Request,
+			server: &examplepb.FakeGenerateUnboundMethodsEchoServiceServer{
+				EchoFunc: func(_ context.Context, req *examplepb.GenerateUnboundMethodsSimpleMessage) (*examplepb.GenerateUnboundMethodsSimpleMessage, error) {
+					return req, nil
+				},
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:       "EchoBody",
+			newRequest: examplepb.NewGenerateUnboundMethodsEchoService_EchoBody_0_Request,
+			server: &examplepb.FakeGenerateUnboundMethodsEchoServiceServer{
+				EchoBodyFunc: func(_ context.Context, req *examplepb.GenerateUnboundMethodsSimpleMessage) (*examplepb.GenerateUnboundMethodsSimpleMessage, error) {
+					return req, nil
+				},
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:       "EchoDelete error",
+			newRequest: examplepb.NewGenerateUnboundMethodsEchoService_EchoDelete_0_Request,
+			server: &examplepb.FakeGenerateUnboundMethodsEchoServiceServer{
+				EchoDeleteFunc: func(context.Context, *examplepb.GenerateUnboundMethodsSimpleMessage) (*examplepb.GenerateUnboundMethodsSimpleMessage, error) {
+					return nil, status.Error(codes.NotFound, "no such message")
+				},
+			},
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:       "unimplemented",
+			newRequest: examplepb.NewGenerateUnboundMethodsEchoService_Echo_0_Request,
+			server:     &examplepb.FakeGenerateUnboundMethodsEchoServiceServer{},
+			wantCode:   http.StatusNotImplemented,
+		},
+	} {
+		t.Run(spec.name, func(t *testing.T) {
+			mux, err := examplepb.NewGenerateUnboundMethodsEchoServiceHandlerTestMux(spec.server)
+			if err != nil {
+				t.Fatalf("examplepb.NewGenerateUnboundMethodsEchoServiceHandlerTestMux() failed with %v; want success", err)
+			}
+			req, err := spec.newRequest(ctx, msg)
+			if err != nil {
+				t.Fatalf("spec.newRequest(ctx, %v) failed with %v; want success", msg, err)
+			}
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, req)
+			if got, want := w.Code, spec.wantCode; got != want {
+				t.Fatalf("w.Code = %d; want %d; body: %s", got, want, w.Body.String())
+			}
+			if spec.wantCode != http.StatusOK {
+				return
+			}
+			got := new(examplepb.GenerateUnboundMethodsSimpleMessage)
+			if err := protojson.Unmarshal(w.Body.Bytes(), got); err != nil {
+				t.Fatalf("protojson.Unmarshal(%s) failed with %v; want success", w.Body.String(), err)
+			}
+			if diff := cmp.Diff(proto.Message(msg), proto.Message(got), protocmp.Transform()); diff != "" {
+				t.Errorf("response differs from the request (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,385 @@
+// Package gatewayclient contains an end-to-end oracle test for
+// protoc-gen-grpc-gateway-client: it stands up the example gRPC services and a
+// grpc-gateway in front of them, then sends the same calls through the
+// generated HTTP clients and through plain gRPC clients. Since both clients
+// implement the same interfaces, the results must be identical.
+//
+// The HTTP clients under examples/internal/proto/examplepb are regenerated by
+// `make generate`; this test is the consumer.
+package gatewayclient_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	examples "github.com/grpc-ecosystem/grpc-gateway/v2/examples/internal/proto/examplepb"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/examples/internal/proto/pathenum"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/examples/internal/proto/sub"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/examples/internal/proto/sub2"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/examples/internal/server"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime/httpclient"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// startGateway serves the example gRPC services on a random loopback port and
+// a grpc-gateway in front of them, and returns a connection to the gRPC server
+// and an HTTP client of the gateway.
+func startGateway(t *testing.T) (*grpc.ClientConn, *httpclient.Client) {
+	t.Helper()
+
+	grpcLis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen grpc: %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	serveErr := make(chan error, 1)
+	go func() { serveErr <- server.ServeGRPC(ctx, grpcLis) }()
+	t.Cleanup(func() {
+		cancel()
+		if err := <-serveErr; err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+			t.Errorf("server.ServeGRPC: %v", err)
+		}
+	})
+
+	conn, err := grpc.NewClient(grpcLis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("dial grpc: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	mux := runtime.NewServeMux()
+	for _, register := range []func(context.Context, *runtime.ServeMux, *grpc.ClientConn) error{
+		examples.RegisterEchoServiceHandler,
+		examples.RegisterABitOfEverythingServiceHandler,
+		examples.RegisterStreamServiceHandler,
+		examples.RegisterResponseBodyServiceHandler,
+	} {
+		if err := register(ctx, mux, conn); err != nil {
+			t.Fatalf("register gateway: %v", err)
+		}
+	}
+	httpSrv := httptest.NewServer(mux)
+	t.Cleanup(httpSrv.Close)
+
+	client, err := httpclient.New(httpSrv.URL)
+	if err != nil {
+		t.Fatalf("httpclient.New(%q): %v", httpSrv.URL, err)
+	}
+	return conn, client
+}
+
+// call is a call of a method of a service, made with the client c of either
+// the gRPC server or the gateway.
+type call[C any] struct {
+	name string
+	do   func(ctx context.Context, c C) (proto.Message, error)
+}
+
+// compareCalls makes the calls through the gRPC and the HTTP client and
+// checks they return the same results.
+func compareCalls[C any](t *testing.T, grpcClient, httpClient C, calls []call[C], opts ...cmp.Option) {
+	t.Helper()
+	opts = append(opts, protocmp.Transform())
+	for _, c := range calls {
+		t.Run(c.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			want, wantErr := c.do(ctx, grpcClient)
+			got, gotErr := c.do(ctx, httpClient)
+			if got, want := status.Code(gotErr), status.Code(wantErr); got != want {
+				t.Fatalf("HTTP call returned code %v (%v); gRPC call returned %v (%v)", got, gotErr, want, wantErr)
+			}
+			if wantErr != nil {
+				if got, want := status.Convert(gotErr).Message(), status.Convert(wantErr).Message(); got != want {
+					t.Errorf("HTTP call returned message %q; gRPC call returned %q", got, want)
+				}
+				return
+			}
+			if diff := cmp.Diff(got, want, opts...); diff != "" {
+				t.Errorf("HTTP and gRPC responses differ (-http +grpc):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestEchoService(t *testing.T) {
+	conn, client := startGateway(t)
+	type C = examples.EchoServiceClient
+	echo := func(msg *examples.SimpleMessage) func(context.Context, C) (proto.Message, error) {
+		return func(ctx context.Context, c C) (proto.Message, error) { return c.Echo(ctx, msg) }
+	}
+	compareCalls(t, examples.NewEchoServiceClient(conn), examples.NewEchoServiceHTTPClient(client), []call[C]{
+		{"Echo/id", echo(&examples.SimpleMessage{Id: "a b"})},
+		{"Echo/num", echo(&examples.SimpleMessage{Id: "foo", Num: 42})},
+		{"Echo/lang", echo(&examples.SimpleMessage{Id: "foo", Num: 42, Code: &examples.SimpleMessage_Lang{Lang: "en"}})},
+		{"Echo/nested path", echo(&examples.SimpleMessage{
+			Id:     "foo",
+			Code:   &examples.SimpleMessage_LineNum{LineNum: 3},
+			Status: &examples.Embedded{Mark: &examples.Embedded_Note{Note: "note"}},
+		})},
+		{"Echo/oneof path", echo(&examples.SimpleMessage{Ext: &examples.SimpleMessage_No{No: &examples.Embedded{Mark: &examples.Embedded_Note{Note: "no"}}}})},
+		{"Echo/query", echo(&examples.SimpleMessage{
+			Id:     "foo",
+			Status: &examples.Embedded{Mark: &examples.Embedded_Progress{Progress: 7}},
+			Ext:    &examples.SimpleMessage_En{En: 8},
+		})},
+		{"EchoBody/whole body", func(ctx context.Context, c C) (proto.Message, error) {
+			return c.EchoBody(ctx, &examples.SimpleMessage{Id: "foo", Num: 1, Status: &examples.Embedded{Mark: &examples.Embedded_Note{Note: "x"}}})
+		}},
+		{"EchoDelete", func(ctx context.Context, c C) (proto.Message, error) {
+			return c.EchoDelete(ctx, &examples.SimpleMessage{Id: "foo", Num: 1})
+		}},
+		{"EchoPatch", func(ctx context.Context, c C) (proto.Message, error) {
+			return c.EchoPatch(ctx, &examples.DynamicMessageUpdate{
+				Body: &examples.DynamicMessage{
+					StructField: &structpb.Struct{Fields: map[string]*structpb.Value{"k": structpb.NewStringValue("v")}},
+					ValueField:  structpb.NewNumberValue(1),
+				},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"struct_field", "value_field"}},
+			})
+		}},
+		{"EchoUnauthorized", func(ctx context.Context, c C) (proto.Message, error) {
+			return c.EchoUnauthorized(ctx, &examples.SimpleMessage{Id: "foo"})
+		}},
+	})
+}
+
+func TestEchoServiceMetadata(t *testing.T) {
+	_, client := startGateway(t)
+	c := examples.NewEchoServiceHTTPClient(client)
+
+	var header, trailer metadata.MD
+	if _, err := c.EchoBody(context.Background(), &examples.SimpleMessage{Id: "foo"}, grpc.Header(&header), grpc.Trailer(&trailer)); err != nil {
+		t.Fatalf("c.EchoBody: %v", err)
+	}
+	if got, want := header.Get("foo"), []string{"foo1"}; !cmp.Equal(got, want) {
+		t.Errorf("header.Get(%q) = %q; want %q", "foo", got, want)
+	}
+	if got, want := trailer.Get("foo"), []string{"foo2"}; !cmp.Equal(got, want) {
+		t.Errorf("trailer.Get(%q) = %q; want %q", "foo", got, want)
+	}
+}
+
+func TestABitOfEverythingService(t *testing.T) {
+	conn, client := startGateway(t)
+	type C = examples.ABitOfEverythingServiceClient
+	abe := &examples.ABitOfEverything{
+		FloatValue:               1.5,
+		DoubleValue:              2.5,
+		Int64Value:               -4611686018427387904,
+		Uint64Value:              9223372036854775807,
+		Int32Value:               -1073741824,
+		Fixed64Value:             9223372036854775807,
+		Fixed32Value:             4294967295,
+		BoolValue:                true,
+		StringValue:              "strprefix/a b",
+		Uint32Value:              4294967295,
+		Sfixed32Value:            -2147483648,
+		Sfixed64Value:            -4611686018427387904,
+		Sint32Value:              2147483647,
+		Sint64Value:              4611686018427387903,
+		NonConventionalNameValue: "camelCase",
+		EnumValue:                examples.NumericEnum_ONE,
+		PathEnumValue:            pathenum.PathEnum_DEF,
+		NestedPathEnumValue:      pathenum.MessagePathEnum_JKL,
+		EnumValueAnnotation:      examples.NumericEnum_ONE,
+		BytesValue:               []byte{0xfb, 0xff, 0x00},
+		RepeatedStringValue:      []string{"a", "b"},
+		RepeatedEnumValue:        []examples.NumericEnum{examples.NumericEnum_ONE, examples.NumericEnum_ZERO},
+		TimestampValue:           timestamppb.New(time.Date(2016, 5, 10, 10, 19, 13, 123456789, time.UTC)),
+		MapValue:                 map[string]examples.NumericEnum{"a": examples.NumericEnum_ONE},
+		SingleNested:             &examples.ABitOfEverything_Nested{Name: "nested", Amount: 10, Ok: examples.ABitOfEverything_Nested_TRUE},
+		OneofValue:               &examples.ABitOfEverything_OneofString{OneofString: "oneof"},
+	}
+	// The gateway does not unescape "/" in single segment path parameters by
+	// default.
+	singleSegment := proto.Clone(abe).(*examples.ABitOfEverything)
+	singleSegment.StringValue = "a b"
+	withBody := proto.Clone(abe).(*examples.ABitOfEverything)
+	withBody.Nested = []*examples.ABitOfEverything_Nested{{Name: "n1"}, {Name: "n2", Amount: 2}}
+	withBody.MappedNestedValue = map[string]*examples.ABitOfEverything_Nested{"k": {Name: "mapped"}}
+
+	compareCalls(t, examples.NewABitOfEverythingServiceClient(conn), examples.NewABitOfEverythingServiceHTTPClient(client), []call[C]{
+		{"Create", func(ctx context.Context, c C) (proto.Message, error) { return c.Create(ctx, abe) }},
+		{"CreateBody", func(ctx context.Context, c C) (proto.Message, error) { return c.CreateBody(ctx, withBody) }},
+		{"CreateBook", func(ctx context.Context, c C) (proto.Message, error) {
+			return c.CreateBook(ctx, &examples.CreateBookRequest{Parent: "publishers/1", BookId: "book", Book: &examples.Book{Id: "book"}})
+		}},
+		{"Lookup/not found", func(ctx context.Context, c C) (proto.Message, error) {
+			return c.Lookup(ctx, &sub2.IdMessage{Uuid: "unknown"})
+		}},
+		{"Echo", func(ctx context.Context, c C) (proto.Message, error) {
+			return c.Echo(ctx, &sub.StringMessage{Value: proto.String("hello world")})
+		}},
+		{"DeepPathEcho", func(ctx context.Context, c C) (proto.Message, error) { return c.DeepPathEcho(ctx, withBody) }},
+		{"CheckGetQueryParams", func(ctx context.Context, c C) (proto.Message, error) { return c.CheckGetQueryParams(ctx, abe) }},
+		{"CheckPostQueryParams", func(ctx context.Context, c C) (proto.Message, error) {
+			return c.CheckPostQueryParams(ctx, singleSegment)
+		}},
+		{"GetRepeatedQuery", func(ctx context.Context, c C) (proto.Message, error) {
+			return c.GetRepeatedQuery(ctx, &examples.ABitOfEverythingRepeated{
+				PathRepeatedFloatValue:    []float32{1.5, -1.5},
+				PathRepeatedDoubleValue:   []float64{2.5, -2.5},
+				PathRepeatedInt64Value:    []int64{4611686018427387903, -4611686018427387904},
+				PathRepeatedUint64Value:   []uint64{0, 9223372036854775807},
+				PathRepeatedInt32Value:    []int32{2147483647, -2147483648},
+				PathRepeatedFixed64Value:  []uint64{0, 9223372036854775807},
+				PathRepeatedFixed32Value:  []uint32{0, 4294967295},
+				PathRepeatedBoolValue:     []bool{true, false},
+				PathRepeatedStringValue:   []string{"foo", "bar"},
+				PathRepeatedBytesValue:    [][]byte{{0x00}, {0xff}},
+				PathRepeatedUint32Value:   []uint32{0, 4294967295},
+				PathRepeatedEnumValue:     []examples.NumericEnum{examples.NumericEnum_ZERO, examples.NumericEnum_ONE},
+				PathRepeatedSfixed32Value: []int32{2147483647, -2147483648},
+				PathRepeatedSfixed64Value: []int64{4611686018427387903, -4611686018427387904},
+				PathRepeatedSint32Value:   []int32{2147483647, -2147483648},
+				PathRepeatedSint64Value:   []int64{4611686018427387903, -4611686018427387904},
+			})
+		}},
+		{"ErrorWithDetails", func(ctx context.Context, c C) (proto.Message, error) {
+			return c.ErrorWithDetails(ctx, &emptypb.Empty{})
+		}},
+	}, protocmp.IgnoreFields(new(examples.ABitOfEverything), "uuid"))
+
+	if _, err := examples.NewABitOfEverythingServiceHTTPClient(client).NoBindings(context.Background(), durationpb.New(time.Second)); status.Code(err) != codes.Unimplemented {
+		t.Errorf("NoBindings returned %v; want code %v", err, codes.Unimplemented)
+	}
+}
+
+func TestABitOfEverythingServiceLifecycle(t *testing.T) {
+	_, client := startGateway(t)
+	c := examples.NewABitOfEverythingServiceHTTPClient(client)
+	ctx := context.Background()
+
+	created, err := c.CreateBody(ctx, &examples.ABitOfEverything{StringValue: "foo", Int32Value: 1})
+	if err != nil {
+		t.Fatalf("c.CreateBody: %v", err)
+	}
+	got, err := c.Lookup(ctx, &sub2.IdMessage{Uuid: created.GetUuid()})
+	if err != nil {
+		t.Fatalf("c.Lookup(%q): %v", created.GetUuid(), err)
+	}
+	if diff := cmp.Diff(got, created, protocmp.Transform()); diff != "" {
+		t.Errorf("c.Lookup(%q) differs (-got +want):\n%s", created.GetUuid(), diff)
+	}
+	updated := proto.Clone(created).(*examples.ABitOfEverything)
+	updated.StringValue = "bar"
+	if _, err := c.Update(ctx, updated); err != nil {
+		t.Fatalf("c.Update: %v", err)
+	}
+	if _, err := c.Delete(ctx, &sub2.IdMessage{Uuid: created.GetUuid()}); err != nil {
+		t.Fatalf("c.Delete: %v", err)
+	}
+	if _, err := c.Lookup(ctx, &sub2.IdMessage{Uuid: created.GetUuid()}); status.Code(err) != codes.NotFound {
+		t.Errorf("c.Lookup(%q) after c.Delete returned %v; want code %v", created.GetUuid(), err, codes.NotFound)
+	}
+}
+
+func TestStreamService(t *testing.T) {
+	conn, client := startGateway(t)
+	ctx := context.Background()
+
+	// Create some messages to list.
+	abe := examples.NewABitOfEverythingServiceHTTPClient(client)
+	for _, s := range []string{"foo", "bar"} {
+		if _, err := abe.CreateBody(ctx, &examples.ABitOfEverything{StringValue: s}); err != nil {
+			t.Fatalf("c.CreateBody: %v", err)
+		}
+	}
+
+	recvAll := func(c examples.StreamServiceClient, opts *examples.Options) ([]*examples.ABitOfEverything, error) {
+		stream, err := c.List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		var msgs []*examples.ABitOfEverything
+		for {
+			msg, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				return msgs, nil
+			}
+			if err != nil {
+				return msgs, err
+			}
+			msgs = append(msgs, msg)
+		}
+	}
+	for _, spec := range []struct {
+		name string
+		opts *examples.Options
+	}{
+		{"ok", &examples.Options{}},
+		{"error", &examples.Options{Error: true}},
+	} {
+		t.Run(spec.name, func(t *testing.T) {
+			want, wantErr := recvAll(examples.NewStreamServiceClient(conn), spec.opts)
+			got, gotErr := recvAll(examples.NewStreamServiceHTTPClient(client), spec.opts)
+			if got, want := status.Code(gotErr), status.Code(wantErr); got != want {
+				t.Fatalf("HTTP stream returned code %v (%v); gRPC stream returned %v (%v)", got, gotErr, want, wantErr)
+			}
+			if len(want) == 0 && wantErr == nil {
+				t.Fatalf("gRPC stream returned no message")
+			}
+			// The server streams the messages in no particular order.
+			byUUID := cmpopts.SortSlices(func(a, b *examples.ABitOfEverything) bool { return a.GetUuid() < b.GetUuid() })
+			if diff := cmp.Diff(got, want, protocmp.Transform(), byUUID); diff != "" {
+				t.Errorf("HTTP and gRPC streams differ (-http +grpc):\n%s", diff)
+			}
+		})
+	}
+
+	if _, err := examples.NewStreamServiceHTTPClient(client).BulkCreate(ctx); status.Code(err) != codes.Unimplemented {
+		t.Errorf("BulkCreate returned %v; want code %v", err, codes.Unimplemented)
+	}
+}
+
+func TestResponseBodyService(t *testing.T) {
+	conn, client := startGateway(t)
+	type C = examples.ResponseBodyServiceClient
+	in := &examples.ResponseBodyIn{Data: "foo"}
+	compareCalls(t, examples.NewResponseBodyServiceClient(conn), examples.NewResponseBodyServiceHTTPClient(client), []call[C]{
+		{"GetResponseBody", func(ctx context.Context, c C) (proto.Message, error) { return c.GetResponseBody(ctx, in) }},
+		{"ListResponseBodies", func(ctx context.Context, c C) (proto.Message, error) { return c.ListResponseBodies(ctx, in) }},
+		{"ListResponseStrings", func(ctx context.Context, c C) (proto.Message, error) { return c.ListResponseStrings(ctx, in) }},
+		{"GetResponseBodySameName", func(ctx context.Context, c C) (proto.Message, error) { return c.GetResponseBodySameName(ctx, in) }},
+		{"GetResponseBodyImportedType", func(ctx context.Context, c C) (proto.Message, error) {
+			return c.GetResponseBodyImportedType(ctx, in)
+		}},
+		{"GetResponseBodyStream", func(ctx context.Context, c C) (proto.Message, error) {
+			stream, err := c.GetResponseBodyStream(ctx, in)
+			if err != nil {
+				return nil, err
+			}
+			out := &examples.RepeatedResponseBodyOut{}
+			for {
+				msg, err := stream.Recv()
+				if errors.Is(err, io.EOF) {
+					return out, nil
+				}
+				if err != nil {
+					return nil, err
+				}
+				out.Response = append(out.Response, &examples.RepeatedResponseBodyOut_Response{Data: msg.GetResponse().GetData()})
+			}
+		}},
+	})
+}
@@ -0,0 +1,135 @@
+package integration_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/examples/internal/proto/examplepb"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func runHandlerOptionsGateway(t *testing.T, register func(context.Context, *runtime.ServeMux, *grpc.ClientConn) error) *httptest.Server {
+	t.Helper()
+	if *network != "tcp" {
+		t.Skipf("network %q is not supported", *network)
+	}
+	conn, err := grpc.NewClient(*endpoint, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("grpc.NewClient(%q) failed with %v; want success", *endpoint, err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	mux := runtime.NewServeMux()
+	if err := register(context.Background(), mux, conn); err != nil {
+		t.Fatalf("register(ctx, mux, conn) failed with %v; want success", err)
+	}
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestHandlerOptionsUnary(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+		return
+	}
+
+	srv := runHandlerOptionsGateway(t, func(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+		return examplepb.RegisterEchoServiceHandlerClientWithOptions(ctx, mux, examplepb.NewEchoServiceClient(conn),
+			runtime.WithRequestMutator(examplepb.EchoService_Echo_HandlerMethod, func(ctx context.Context, req *examplepb.SimpleMessage) error {
+				if req.GetId() == "forbidden" {
+					return status.Error(codes.PermissionDenied, "forbidden id")
+				}
+				req.Id = "mutated-" + req.GetId()
+				return nil
+			}),
+			runtime.WithResponseRewriter(examplepb.EchoService_Echo_HandlerMethod, func(ctx context.Context, resp *examplepb.SimpleMessage) (*examplepb.SimpleMessage, error) {
+				resp.Id = strings.ToUpper(resp.GetId())
+				return resp, nil
+			}),
+			runtime.WithMethodErrorHandler(examplepb.EchoService_Echo_HandlerMethod, func(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+				w.WriteHeader(http.StatusTeapot)
+				fmt.Fprint(w, status.Convert(err).Message())
+			}),
+		)
+	})
+
+	for _, tc := range []struct {
+		url      string
+		wantCode int
+		wantBody string
+	}{
+		{url: "/v1/example/echo/myid", wantCode: http.StatusOK, wantBody: `"id":"MUTATED-MYID"`},
+		{url: "/v1/example/echo/forbidden", wantCode: http.StatusTeapot, wantBody: "forbidden id"},
+		// EchoBody is not customized.
+		{url: "/v1/example/echo_body", wantCode: http.StatusOK, wantBody: `"id":"myid"`},
+	} {
+		resp, err := http.Post(srv.URL+tc.url, "application/json", strings.NewReader(`{"id":"myid"}`))
+		if err != nil {
+			t.Fatalf("http.Post(%q) failed with %v; want success", tc.url, err)
+		}
+		buf, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			t.Fatalf("io.ReadAll(resp.Body) failed with %v; want success", err)
+		}
+		if got, want := resp.StatusCode, tc.wantCode; got != want {
+			t.Errorf("POST %s: resp.StatusCode = %d; want %d", tc.url, got, want)
+		}
+		if !strings.Contains(string(buf), tc.wantBody) {
+			t.Errorf("POST %s: body = %s; want it to contain %s", tc.url, buf, tc.wantBody)
+		}
+	}
+}
+
+func TestHandlerOptionsServerStreaming(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+		return
+	}
+
+	var forwarded bool
+	srv := runHandlerOptionsGateway(t, func(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+		return examplepb.RegisterResponseBodyServiceHandlerClientWithOptions(ctx, mux, examplepb.NewResponseBodyServiceClient(conn),
+			runtime.WithResponseRewriter(examplepb.ResponseBodyService_GetResponseBodyStream_HandlerMethod, func(ctx context.Context, resp *examplepb.ResponseBodyOut) (*examplepb.ResponseBodyOut, error) {
+				resp.GetResponse().Data += " rewritten"
+				return resp, nil
+			}),
+			runtime.WithResponseStreamForwarder(examplepb.ResponseBodyService_GetResponseBodyStream_HandlerMethod, func(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, req *http.Request, recv func() (proto.Message, error), opts ...func(context.Context, http.ResponseWriter, proto.Message) error) {
+				forwarded = true
+				runtime.ForwardResponseStream(ctx, mux, marshaler, w, req, recv, opts...)
+			}),
+		)
+	})
+
+	apiURL := srv.URL + "/responsebody/stream/foo"
+	resp, err := http.Get(apiURL)
+	if err != nil {
+		t.Fatalf("http.Get(%q) failed with %v; want success", apiURL, err)
+	}
+	defer resp.Body.Close()
+	buf, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("io.ReadAll(resp.Body) failed with %v; want success", err)
+	}
+	if got, want := resp.StatusCode, http.StatusOK; got != want {
+		t.Errorf("resp.StatusCode = %d; want %d", got, want)
+	}
+	want := `{"result":{"data":"first foo rewritten"}}` + "\n" + `{"result":{"data":"second foo rewritten"}}` + "\n"
+	if got := string(buf); got != want {
+		t.Errorf("body = %q; want %q", got, want)
+	}
+	if !forwarded {
+		t.Error("the stream forwarder was not called")
+	}
+}
@@ -27,6 +27,7 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 // Suppress "imported and not used" errors
@@ -2404,11 +2405,12 @@ func RegisterABitOfEverythingServiceHandlerServer(ctx context.Context, mux *runt
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Create", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/{float_value}/{double_value}/{int64_value}/separator/{uint64_value}/{int32_value}/{fixed64_value}/{fixed32_value}/{bool_value}/{string_value=strprefix/*}/{uint32_value}/{sfixed32_value}/{sfixed64_value}/{sint32_value}/{sint64_value}/{nonConventionalNameValue}/{enum_value}/{path_enum_value}/{nested_path_enum_value}/{enum_value_annotation}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Create", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/{float_value}/{double_value}/{int64_value}/separator/{uint64_value}/{int32_value}/{fixed64_value}/{fixed32_value}/{bool_value}/{string_value=strprefix/*}/{uint32_value}/{sfixed32_value}/{sfixed64_value}/{sint32_value}/{sint64_value}/{nonConventionalNameValue}/{enum_value}/{path_enum_value}/{nested_path_enum_value}/{enum_value_annotation}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_ABitOfEverythingService_Create_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -2417,18 +2419,19 @@ func RegisterABitOfEverythingServiceHandlerServer(ctx context.Context, mux *runt
 			return
 		}
 		forward_ABitOfEverythingService_Create_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Create"))
 	mux.Handle(http.MethodPost, pattern_ABitOfEverythingService_CreateBody_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CreateBody", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CreateBody", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_ABitOfEverythingService_CreateBody_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -2437,18 +2440,19 @@ func RegisterABitOfEverythingServiceHandlerServer(ctx context.Context, mux *runt
 			return
 		}
 		forward_ABitOfEverythingService_CreateBody_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CreateBody"))
 	mux.Handle(http.MethodPut, pattern_ABitOfEverythingService_UpdateEntity_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/UpdateEntity", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/entity/{id.value}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/UpdateEntity", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/entity/{id.value}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_ABitOfEverythingService_UpdateEntity_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -2457,18 +2461,19 @@ func RegisterABitOfEverythingServiceHandlerServer(ctx context.Context, mux *runt
 			return
 		}
 		forward_ABitOfEverythingService_UpdateEntity_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/UpdateEntity"))
 	mux.Handle(http.MethodPost, pattern_ABitOfEverythingService_CreateBook_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CreateBook", runtime.WithHTTPPathPattern("/v1/{parent=publishers/*}/books"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CreateBook", runtime.WithHTTPPathPattern("/v1/{parent=publishers/*}/books"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_ABitOfEverythingService_CreateBook_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -2477,18 +2482,19 @@ func RegisterABitOfEverythingServiceHandlerServer(ctx context.Context, mux *runt
 			return
 		}
 		forward_ABitOfEverythingService_CreateBook_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CreateBook"))
 	mux.Handle(http.MethodPatch, pattern_ABitOfEverythingService_UpdateBook_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/UpdateBook", runtime.WithHTTPPathPattern("/v1/{book.name=publishers/*/books/*}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/UpdateBook", runtime.WithHTTPPathPattern("/v1/{book.name=publishers/*/books/*}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_ABitOfEverythingService_UpdateBook_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -2497,18 +2503,19 @@ func RegisterABitOfEverythingServiceHandlerServer(ctx context.Context, mux *runt
 			return
 		}
 		forward_ABitOfEverythingService_UpdateBook_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/UpdateBook"))
 	mux.Handle(http.MethodGet, pattern_ABitOfEverythingService_Lookup_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Lookup", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/{uuid}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Lookup", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/{uuid}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_ABitOfEverythingService_Lookup_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -2517,18 +2524,19 @@ func RegisterABitOfEverythingServiceHandlerServer(ctx context.Context, mux *runt
 			return
 		}
 		forward_ABitOfEverythingService_Lookup_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Lookup"))
 	mux.Handle(http.MethodPost, pattern_ABitOfEverythingService_Custom_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Custom", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/{uuid}:custom"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Custom", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/{uuid}:custom"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_ABitOfEverythingService_Custom_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -2537,18 +2545,19 @@ func RegisterABitOfEverythingServiceHandlerServer(ctx context.Context, mux *runt
 			return
 		}
 		forward_ABitOfEverythingService_Custom_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Custom"))
 	mux.Handle(http.MethodGet, pattern_ABitOfEverythingService_Custom_1, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Custom", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/custom/{optional_string_value}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Custom", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/custom/{optional_string_value}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_ABitOfEverythingService_Custom_1(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -2557,18 +2566,19 @@ func RegisterABitOfEverythingServiceHandlerServer(ctx context.Context, mux *runt
 			return
 		}
 		forward_ABitOfEverythingService_Custom_1(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Custom"))
 	mux.Handle(http.MethodPost, pattern_ABitOfEverythingService_DoubleColon_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/DoubleColon", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/{uuid}:custom:custom"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/DoubleColon", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/{uuid}:custom:custom"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_ABitOfEverythingService_DoubleColon_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -2577,18 +2587,19 @@ func RegisterABitOfEverythingServiceHandlerServer(ctx context.Context, mux *runt
 			return
 		}
 		forward_ABitOfEverythingService_DoubleColon_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/DoubleColon"))
 	mux.Handle(http.MethodPut, pattern_ABitOfEverythingService_Update_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Update", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/{uuid}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Update", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/{uuid}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_ABitOfEverythingService_Update_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -2597,18 +2608,19 @@ func RegisterABitOfEverythingServiceHandlerServer(ctx context.Context, mux *runt
 			return
 		}
 		forward_ABitOfEverythingService_Update_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Update"))
 	mux.Handle(http.MethodPut, pattern_ABitOfEverythingService_UpdateV2_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/UpdateV2", runtime.WithHTTPPathPattern("/v2/example/a_bit_of_everything/{abe.uuid}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/UpdateV2", runtime.WithHTTPPathPattern("/v2/example/a_bit_of_everything/{abe.uuid}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_ABitOfEverythingService_UpdateV2_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -2617,18 +2629,19 @@ func RegisterABitOfEverythingServiceHandlerServer(ctx context.Context, mux *runt
 			return
 		}
 		forward_ABitOfEverythingService_UpdateV2_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/UpdateV2"))
 	mux.Handle(http.MethodPatch, pattern_ABitOfEverythingService_UpdateV2_1, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/UpdateV2", runtime.WithHTTPPathPattern("/v2/example/a_bit_of_everything/{abe.uuid}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/UpdateV2", runtime.WithHTTPPathPattern("/v2/example/a_bit_of_everything/{abe.uuid}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_ABitOfEverythingService_UpdateV2_1(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -2637,18 +2650,19 @@ func RegisterABitOfEverythingServiceHandlerServer(ctx context.Context, mux *runt
 			return
 		}
 		forward_ABitOfEverythingService_UpdateV2_1(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/UpdateV2"))
 	mux.Handle(http.MethodPatch, pattern_ABitOfEverythingService_UpdateV2_2, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/UpdateV2", runtime.WithHTTPPathPattern("/v2a/example/a_bit_of_everything/{abe.uuid}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/UpdateV2", runtime.WithHTTPPathPattern("/v2a/example/a_bit_of_everything/{abe.uuid}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_ABitOfEverythingService_UpdateV2_2(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -2657,18 +2671,19 @@ func RegisterABitOfEverythingServiceHandlerServer(ctx context.Context, mux *runt
 			return
 		}
 		forward_ABitOfEverythingService_UpdateV2_2(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/UpdateV2"))
 	mux.Handle(http.MethodPost, pattern_ABitOfEverythingService_CreateNestedBodyOneof_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CreateNestedBodyOneof", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/nested_body_oneof"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CreateNestedBodyOneof", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/nested_body_oneof"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_ABitOfEverythingService_CreateNestedBodyOneof_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -2677,18 +2692,19 @@ func RegisterABitOfEverythingServiceHandlerServer(ctx context.Context, mux *runt
 			return
 		}
 		forward_ABitOfEverythingService_CreateNestedBodyOneof_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CreateNestedBodyOneof"))
 	mux.Handle(http.MethodDelete, pattern_ABitOfEverythingService_Delete_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Delete", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/{uuid}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Delete", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/{uuid}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_ABitOfEverythingService_Delete_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -2697,18 +2713,19 @@ func RegisterABitOfEverythingServiceHandlerServer(ctx context.Context, mux *runt
 			return
 		}
 		forward_ABitOfEverythingService_Delete_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Delete"))
 	mux.Handle(http.MethodGet, pattern_ABitOfEverythingService_GetQuery_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/GetQuery", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/query/{uuid}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/GetQuery", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/query/{uuid}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_ABitOfEverythingService_GetQuery_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -2717,18 +2734,19 @@ func RegisterABitOfEverythingServiceHandlerServer(ctx context.Context, mux *runt
 			return
 		}
 		forward_ABitOfEverythingService_GetQuery_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/GetQuery"))
 	mux.Handle(http.MethodGet, pattern_ABitOfEverythingService_GetRepeatedQuery_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/GetRepeatedQuery", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything_repeated/{path_repeated_float_value}/{path_repeated_double_value}/{path_repeated_int64_value}/{path_repeated_uint64_value}/{path_repeated_int32_value}/{path_repeated_fixed64_value}/{path_repeated_fixed32_value}/{path_repeated_bool_value}/{path_repeated_string_value}/{path_repeated_bytes_value}/{path_repeated_uint32_value}/{path_repeated_enum_value}/{path_repeated_sfixed32_value}/{path_repeated_sfixed64_value}/{path_repeated_sint32_value}/{path_repeated_sint64_value}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/GetRepeatedQuery", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything_repeated/{path_repeated_float_value}/{path_repeated_double_value}/{path_repeated_int64_value}/{path_repeated_uint64_value}/{path_repeated_int32_value}/{path_repeated_fixed64_value}/{path_repeated_fixed32_value}/{path_repeated_bool_value}/{path_repeated_string_value}/{path_repeated_bytes_value}/{path_repeated_uint32_value}/{path_repeated_enum_value}/{path_repeated_sfixed32_value}/{path_repeated_sfixed64_value}/{path_repeated_sint32_value}/{path_repeated_sint64_value}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_ABitOfEverythingService_GetRepeatedQuery_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -2737,18 +2755,19 @@ func RegisterABitOfEverythingServiceHandlerServer(ctx context.Context, mux *runt
 			return
 		}
 		forward_ABitOfEverythingService_GetRepeatedQuery_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/GetRepeatedQuery"))
 	mux.Handle(http.MethodGet, pattern_ABitOfEverythingService_Echo_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Echo", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/echo/{value}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Echo", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/echo/{value}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_ABitOfEverythingService_Echo_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -2757,18 +2776,19 @@ func RegisterABitOfEverythingServiceHandlerServer(ctx context.Context, mux *runt
 			return
 		}
 		forward_ABitOfEverythingService_Echo_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Echo"))
 	mux.Handle(http.MethodPost, pattern_ABitOfEverythingService_Echo_1, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Echo", runtime.WithHTTPPathPattern("/v2/example/echo"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Echo", runtime.WithHTTPPathPattern("/v2/example/echo"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_ABitOfEverythingService_Echo_1(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -2777,18 +2797,19 @@ func RegisterABitOfEverythingServiceHandlerServer(ctx context.Context, mux *runt
 			return
 		}
 		forward_ABitOfEverythingService_Echo_1(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Echo"))
 	mux.Handle(http.MethodGet, pattern_ABitOfEverythingService_Echo_2, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Echo", runtime.WithHTTPPathPattern("/v2/example/echo"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Echo", runtime.WithHTTPPathPattern("/v2/example/echo"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_ABitOfEverythingService_Echo_2(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -2797,18 +2818,19 @@ func RegisterABitOfEverythingServiceHandlerServer(ctx context.Context, mux *runt
 			return
 		}
 		forward_ABitOfEverythingService_Echo_2(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Echo"))
 	mux.Handle(http.MethodPost, pattern_ABitOfEverythingService_DeepPathEcho_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/DeepPathEcho", runtime.WithHTTPPathPattern("/v1/example/deep_path/{single_nested.name}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/DeepPathEcho", runtime.WithHTTPPathPattern("/v1/example/deep_path/{single_nested.name}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_ABitOfEverythingService_DeepPathEcho_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -2817,18 +2839,19 @@ func RegisterABitOfEverythingServiceHandlerServer(ctx context.Context, mux *runt
 			return
 		}
 		forward_ABitOfEverythingService_DeepPathEcho_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/DeepPathEcho"))
 	mux.Handle(http.MethodGet, pattern_ABitOfEverythingService_Timeout_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Timeout", runtime.WithHTTPPathPattern("/v2/example/timeout"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Timeout", runtime.WithHTTPPathPattern("/v2/example/timeout"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_ABitOfEverythingService_Timeout_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -2837,18 +2860,19 @@ func RegisterABitOfEverythingServiceHandlerServer(ctx context.Context, mux *runt
 			return
 		}
 		forward_ABitOfEverythingService_Timeout_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Timeout"))
 	mux.Handle(http.MethodGet, pattern_ABitOfEverythingService_ErrorWithDetails_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/ErrorWithDetails", runtime.WithHTTPPathPattern("/v2/example/errorwithdetails"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/ErrorWithDetails", runtime.WithHTTPPathPattern("/v2/example/errorwithdetails"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_ABitOfEverythingService_ErrorWithDetails_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -2857,18 +2881,19 @@ func RegisterABitOfEverythingServiceHandlerServer(ctx context.Context, mux *runt
 			return
 		}
 		forward_ABitOfEverythingService_ErrorWithDetails_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/ErrorWithDetails"))
 	mux.Handle(http.MethodPost, pattern_ABitOfEverythingService_GetMessageWithBody_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/GetMessageWithBody", runtime.WithHTTPPathPattern("/v2/example/withbody/{id}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/GetMessageWithBody", runtime.WithHTTPPathPattern("/v2/example/withbody/{id}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_ABitOfEverythingService_GetMessageWithBody_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -2877,18 +2902,19 @@ func RegisterABitOfEverythingServiceHandlerServer(ctx context.Context, mux *runt
 			return
 		}
 		forward_ABitOfEverythingService_GetMessageWithBody_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/GetMessageWithBody"))
 	mux.Handle(http.MethodPost, pattern_ABitOfEverythingService_PostWithEmptyBody_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/PostWithEmptyBody", runtime.WithHTTPPathPattern("/v2/example/postwithemptybody/{name}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/PostWithEmptyBody", runtime.WithHTTPPathPattern("/v2/example/postwithemptybody/{name}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_ABitOfEverythingService_PostWithEmptyBody_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -2897,18 +2923,19 @@ func RegisterABitOfEverythingServiceHandlerServer(ctx context.Context, mux *runt
 			return
 		}
 		forward_ABitOfEverythingService_PostWithEmptyBody_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/PostWithEmptyBody"))
 	mux.Handle(http.MethodGet, pattern_ABitOfEverythingService_CheckGetQueryParams_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CheckGetQueryParams", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/params/get/{single_nested.name}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CheckGetQueryParams", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/params/get/{single_nested.name}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_ABitOfEverythingService_CheckGetQueryParams_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -2917,18 +2944,19 @@ func RegisterABitOfEverythingServiceHandlerServer(ctx context.Context, mux *runt
 			return
 		}
 		forward_ABitOfEverythingService_CheckGetQueryParams_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CheckGetQueryParams"))
 	mux.Handle(http.MethodGet, pattern_ABitOfEverythingService_CheckNestedEnumGetQueryParams_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CheckNestedEnumGetQueryParams", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/params/get/nested_enum/{single_nested.ok}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CheckNestedEnumGetQueryParams", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/params/get/nested_enum/{single_nested.ok}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_ABitOfEverythingService_CheckNestedEnumGetQueryParams_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -2937,18 +2965,19 @@ func RegisterABitOfEverythingServiceHandlerServer(ctx context.Context, mux *runt
 			return
 		}
 		forward_ABitOfEverythingService_CheckNestedEnumGetQueryParams_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CheckNestedEnumGetQueryParams"))
 	mux.Handle(http.MethodPost, pattern_ABitOfEverythingService_CheckPostQueryParams_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CheckPostQueryParams", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/params/post/{string_value}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CheckPostQueryParams", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/params/post/{string_value}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_ABitOfEverythingService_CheckPostQueryParams_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -2957,18 +2986,19 @@ func RegisterABitOfEverythingServiceHandlerServer(ctx context.Context, mux *runt
 			return
 		}
 		forward_ABitOfEverythingService_CheckPostQueryParams_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CheckPostQueryParams"))
 	mux.Handle(http.MethodPost, pattern_ABitOfEverythingService_OverwriteRequestContentType_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/OverwriteRequestContentType", runtime.WithHTTPPathPattern("/v2/example/overwriterequestcontenttype"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/OverwriteRequestContentType", runtime.WithHTTPPathPattern("/v2/example/overwriterequestcontenttype"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_ABitOfEverythingService_OverwriteRequestContentType_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -2977,18 +3007,19 @@ func RegisterABitOfEverythingServiceHandlerServer(ctx context.Context, mux *runt
 			return
 		}
 		forward_ABitOfEverythingService_OverwriteRequestContentType_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/OverwriteRequestContentType"))
 	mux.Handle(http.MethodGet, pattern_ABitOfEverythingService_OverwriteResponseContentType_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/OverwriteResponseContentType", runtime.WithHTTPPathPattern("/v2/example/overwriteresponsecontenttype"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/OverwriteResponseContentType", runtime.WithHTTPPathPattern("/v2/example/overwriteresponsecontenttype"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_ABitOfEverythingService_OverwriteResponseContentType_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -2997,18 +3028,19 @@ func RegisterABitOfEverythingServiceHandlerServer(ctx context.Context, mux *runt
 			return
 		}
 		forward_ABitOfEverythingService_OverwriteResponseContentType_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/OverwriteResponseContentType"))
 	mux.Handle(http.MethodGet, pattern_ABitOfEverythingService_CheckExternalPathEnum_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CheckExternalPathEnum", runtime.WithHTTPPathPattern("/v2/{value}:check"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CheckExternalPathEnum", runtime.WithHTTPPathPattern("/v2/{value}:check"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_ABitOfEverythingService_CheckExternalPathEnum_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -3017,18 +3049,19 @@ func RegisterABitOfEverythingServiceHandlerServer(ctx context.Context, mux *runt
 			return
 		}
 		forward_ABitOfEverythingService_CheckExternalPathEnum_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CheckExternalPathEnum"))
 	mux.Handle(http.MethodGet, pattern_ABitOfEverythingService_CheckExternalNestedPathEnum_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CheckExternalNestedPathEnum", runtime.WithHTTPPathPattern("/v3/{value}:check"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CheckExternalNestedPathEnum", runtime.WithHTTPPathPattern("/v3/{value}:check"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_ABitOfEverythingService_CheckExternalNestedPathEnum_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -3037,18 +3070,19 @@ func RegisterABitOfEverythingServiceHandlerServer(ctx context.Context, mux *runt
 			return
 		}
 		forward_ABitOfEverythingService_CheckExternalNestedPathEnum_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CheckExternalNestedPathEnum"))
 	mux.Handle(http.MethodGet, pattern_ABitOfEverythingService_CheckStatus_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CheckStatus", runtime.WithHTTPPathPattern("/v1/example/checkStatus"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CheckStatus", runtime.WithHTTPPathPattern("/v1/example/checkStatus"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_ABitOfEverythingService_CheckStatus_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -3057,18 +3091,19 @@ func RegisterABitOfEverythingServiceHandlerServer(ctx context.Context, mux *runt
 			return
 		}
 		forward_ABitOfEverythingService_CheckStatus_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CheckStatus"))
 	mux.Handle(http.MethodHead, pattern_ABitOfEverythingService_Exists_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Exists", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/{uuid}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Exists", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/{uuid}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_ABitOfEverythingService_Exists_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -3077,18 +3112,19 @@ func RegisterABitOfEverythingServiceHandlerServer(ctx context.Context, mux *runt
 			return
 		}
 		forward_ABitOfEverythingService_Exists_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Exists"))
 	mux.Handle(http.MethodOptions, pattern_ABitOfEverythingService_CustomOptionsRequest_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CustomOptionsRequest", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/{uuid}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CustomOptionsRequest", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/{uuid}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_ABitOfEverythingService_CustomOptionsRequest_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -3097,18 +3133,19 @@ func RegisterABitOfEverythingServiceHandlerServer(ctx context.Context, mux *runt
 			return
 		}
 		forward_ABitOfEverythingService_CustomOptionsRequest_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CustomOptionsRequest"))
 	mux.Handle(http.MethodTrace, pattern_ABitOfEverythingService_TraceRequest_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/TraceRequest", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/{uuid}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/TraceRequest", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/{uuid}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_ABitOfEverythingService_TraceRequest_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -3117,18 +3154,19 @@ func RegisterABitOfEverythingServiceHandlerServer(ctx context.Context, mux *runt
 			return
 		}
 		forward_ABitOfEverythingService_TraceRequest_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/TraceRequest"))
 	mux.Handle(http.MethodPost, pattern_ABitOfEverythingService_PostOneofEnum_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/PostOneofEnum", runtime.WithHTTPPathPattern("/v1/example/oneofenum"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/PostOneofEnum", runtime.WithHTTPPathPattern("/v1/example/oneofenum"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_ABitOfEverythingService_PostOneofEnum_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -3137,18 +3175,19 @@ func RegisterABitOfEverythingServiceHandlerServer(ctx context.Context, mux *runt
 			return
 		}
 		forward_ABitOfEverythingService_PostOneofEnum_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/PostOneofEnum"))
 	mux.Handle(http.MethodPost, pattern_ABitOfEverythingService_PostRequiredMessageType_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/PostRequiredMessageType", runtime.WithHTTPPathPattern("/v1/example/requiredmessagetype"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/PostRequiredMessageType", runtime.WithHTTPPathPattern("/v1/example/requiredmessagetype"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_ABitOfEverythingService_PostRequiredMessageType_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -3157,7 +3196,7 @@ func RegisterABitOfEverythingServiceHandlerServer(ctx context.Context, mux *runt
 			return
 		}
 		forward_ABitOfEverythingService_PostRequiredMessageType_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/PostRequiredMessageType"))
 
 	return nil
 }
@@ -3174,11 +3213,12 @@ func RegisterCamelCaseServiceNameHandlerServer(ctx context.Context, mux *runtime
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.CamelCaseServiceName/Empty", runtime.WithHTTPPathPattern("/v2/example/empty"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.CamelCaseServiceName/Empty", runtime.WithHTTPPathPattern("/v2/example/empty"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_CamelCaseServiceName_Empty_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -3187,7 +3227,7 @@ func RegisterCamelCaseServiceNameHandlerServer(ctx context.Context, mux *runtime
 			return
 		}
 		forward_CamelCaseServiceName_Empty_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.CamelCaseServiceName/Empty"))
 
 	return nil
 }
@@ -3204,11 +3244,12 @@ func RegisterSnakeEnumServiceHandlerServer(ctx context.Context, mux *runtime.Ser
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.SnakeEnumService/SnakeEnum", runtime.WithHTTPPathPattern("/v1/example/snake/{who}/{what}/{where}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.SnakeEnumService/SnakeEnum", runtime.WithHTTPPathPattern("/v1/example/snake/{who}/{what}/{where}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_SnakeEnumService_SnakeEnum_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -3217,7 +3258,7 @@ func RegisterSnakeEnumServiceHandlerServer(ctx context.Context, mux *runtime.Ser
 			return
 		}
 		forward_SnakeEnumService_SnakeEnum_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.SnakeEnumService/SnakeEnum"))
 
 	return nil
 }
@@ -3258,655 +3299,1206 @@ func RegisterABitOfEverythingServiceHandler(ctx context.Context, mux *runtime.Se
 // doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
 // "ABitOfEverythingServiceClient" to call the correct interceptors. This client ignores the HTTP middlewares.
 func RegisterABitOfEverythingServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client ABitOfEverythingServiceClient) error {
+	return RegisterABitOfEverythingServiceHandlerClientWithOptions(ctx, mux, client)
+}
+
+// RegisterABitOfEverythingServiceHandlerClientWithOptions is same as RegisterABitOfEverythingServiceHandlerClient but
+// customizes the handlers of the methods of ABitOfEverythingService with "opts", whose methods are identified by the
+// ABitOfEverythingService_{Method}_HandlerMethod variables.
+func RegisterABitOfEverythingServiceHandlerClientWithOptions(ctx context.Context, mux *runtime.ServeMux, client ABitOfEverythingServiceClient, opts ...runtime.HandlerOption) error {
+	options := runtime.NewHandlerOptions(opts...)
+	client = handlerClient_ABitOfEverythingService{ABitOfEverythingServiceClient: client, options: options}
 	mux.Handle(http.MethodPost, pattern_ABitOfEverythingService_Create_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(ABitOfEverythingService_Create_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Create", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/{float_value}/{double_value}/{int64_value}/separator/{uint64_value}/{int32_value}/{fixed64_value}/{fixed32_value}/{bool_value}/{string_value=strprefix/*}/{uint32_value}/{sfixed32_value}/{sfixed64_value}/{sint32_value}/{sint64_value}/{nonConventionalNameValue}/{enum_value}/{path_enum_value}/{nested_path_enum_value}/{enum_value_annotation}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Create", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/{float_value}/{double_value}/{int64_value}/separator/{uint64_value}/{int32_value}/{fixed64_value}/{fixed32_value}/{bool_value}/{string_value=strprefix/*}/{uint32_value}/{sfixed32_value}/{sfixed64_value}/{sint32_value}/{sint64_value}/{nonConventionalNameValue}/{enum_value}/{path_enum_value}/{nested_path_enum_value}/{enum_value_annotation}"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_ABitOfEverythingService_Create_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_ABitOfEverythingService_Create_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_ABitOfEverythingService_Create_0)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Create"))
 	mux.Handle(http.MethodPost, pattern_ABitOfEverythingService_CreateBody_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(ABitOfEverythingService_CreateBody_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CreateBody", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CreateBody", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_ABitOfEverythingService_CreateBody_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_ABitOfEverythingService_CreateBody_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_ABitOfEverythingService_CreateBody_0)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CreateBody"))
 	mux.Handle(http.MethodPut, pattern_ABitOfEverythingService_UpdateEntity_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(ABitOfEverythingService_UpdateEntity_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/UpdateEntity", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/entity/{id.value}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/UpdateEntity", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/entity/{id.value}"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_ABitOfEverythingService_UpdateEntity_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_ABitOfEverythingService_UpdateEntity_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_ABitOfEverythingService_UpdateEntity_0)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/UpdateEntity"))
 	mux.Handle(http.MethodPost, pattern_ABitOfEverythingService_CreateBook_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(ABitOfEverythingService_CreateBook_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CreateBook", runtime.WithHTTPPathPattern("/v1/{parent=publishers/*}/books"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CreateBook", runtime.WithHTTPPathPattern("/v1/{parent=publishers/*}/books"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_ABitOfEverythingService_CreateBook_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_ABitOfEverythingService_CreateBook_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_ABitOfEverythingService_CreateBook_0)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CreateBook"))
 	mux.Handle(http.MethodPatch, pattern_ABitOfEverythingService_UpdateBook_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(ABitOfEverythingService_UpdateBook_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/UpdateBook", runtime.WithHTTPPathPattern("/v1/{book.name=publishers/*/books/*}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/UpdateBook", runtime.WithHTTPPathPattern("/v1/{book.name=publishers/*/books/*}"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_ABitOfEverythingService_UpdateBook_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_ABitOfEverythingService_UpdateBook_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_ABitOfEverythingService_UpdateBook_0)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/UpdateBook"))
 	mux.Handle(http.MethodGet, pattern_ABitOfEverythingService_Lookup_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(ABitOfEverythingService_Lookup_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Lookup", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/{uuid}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Lookup", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/{uuid}"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_ABitOfEverythingService_Lookup_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_ABitOfEverythingService_Lookup_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_ABitOfEverythingService_Lookup_0)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Lookup"))
 	mux.Handle(http.MethodPost, pattern_ABitOfEverythingService_Custom_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(ABitOfEverythingService_Custom_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Custom", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/{uuid}:custom"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Custom", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/{uuid}:custom"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_ABitOfEverythingService_Custom_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_ABitOfEverythingService_Custom_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_ABitOfEverythingService_Custom_0)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Custom"))
 	mux.Handle(http.MethodGet, pattern_ABitOfEverythingService_Custom_1, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(ABitOfEverythingService_Custom_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Custom", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/custom/{optional_string_value}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Custom", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/custom/{optional_string_value}"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_ABitOfEverythingService_Custom_1(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_ABitOfEverythingService_Custom_1(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_ABitOfEverythingService_Custom_1)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Custom"))
 	mux.Handle(http.MethodPost, pattern_ABitOfEverythingService_DoubleColon_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(ABitOfEverythingService_DoubleColon_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/DoubleColon", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/{uuid}:custom:custom"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/DoubleColon", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/{uuid}:custom:custom"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_ABitOfEverythingService_DoubleColon_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_ABitOfEverythingService_DoubleColon_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_ABitOfEverythingService_DoubleColon_0)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/DoubleColon"))
 	mux.Handle(http.MethodPut, pattern_ABitOfEverythingService_Update_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(ABitOfEverythingService_Update_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Update", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/{uuid}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Update", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/{uuid}"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_ABitOfEverythingService_Update_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_ABitOfEverythingService_Update_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_ABitOfEverythingService_Update_0)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Update"))
 	mux.Handle(http.MethodPut, pattern_ABitOfEverythingService_UpdateV2_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(ABitOfEverythingService_UpdateV2_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/UpdateV2", runtime.WithHTTPPathPattern("/v2/example/a_bit_of_everything/{abe.uuid}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/UpdateV2", runtime.WithHTTPPathPattern("/v2/example/a_bit_of_everything/{abe.uuid}"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_ABitOfEverythingService_UpdateV2_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_ABitOfEverythingService_UpdateV2_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_ABitOfEverythingService_UpdateV2_0)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/UpdateV2"))
 	mux.Handle(http.MethodPatch, pattern_ABitOfEverythingService_UpdateV2_1, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(ABitOfEverythingService_UpdateV2_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/UpdateV2", runtime.WithHTTPPathPattern("/v2/example/a_bit_of_everything/{abe.uuid}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/UpdateV2", runtime.WithHTTPPathPattern("/v2/example/a_bit_of_everything/{abe.uuid}"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_ABitOfEverythingService_UpdateV2_1(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_ABitOfEverythingService_UpdateV2_1(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_ABitOfEverythingService_UpdateV2_1)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/UpdateV2"))
 	mux.Handle(http.MethodPatch, pattern_ABitOfEverythingService_UpdateV2_2, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(ABitOfEverythingService_UpdateV2_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/UpdateV2", runtime.WithHTTPPathPattern("/v2a/example/a_bit_of_everything/{abe.uuid}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/UpdateV2", runtime.WithHTTPPathPattern("/v2a/example/a_bit_of_everything/{abe.uuid}"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_ABitOfEverythingService_UpdateV2_2(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_ABitOfEverythingService_UpdateV2_2(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_ABitOfEverythingService_UpdateV2_2)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/UpdateV2"))
 	mux.Handle(http.MethodPost, pattern_ABitOfEverythingService_CreateNestedBodyOneof_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(ABitOfEverythingService_CreateNestedBodyOneof_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CreateNestedBodyOneof", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/nested_body_oneof"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CreateNestedBodyOneof", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/nested_body_oneof"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_ABitOfEverythingService_CreateNestedBodyOneof_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_ABitOfEverythingService_CreateNestedBodyOneof_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_ABitOfEverythingService_CreateNestedBodyOneof_0)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CreateNestedBodyOneof"))
 	mux.Handle(http.MethodDelete, pattern_ABitOfEverythingService_Delete_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(ABitOfEverythingService_Delete_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Delete", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/{uuid}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Delete", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/{uuid}"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_ABitOfEverythingService_Delete_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_ABitOfEverythingService_Delete_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_ABitOfEverythingService_Delete_0)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Delete"))
 	mux.Handle(http.MethodGet, pattern_ABitOfEverythingService_GetQuery_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(ABitOfEverythingService_GetQuery_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/GetQuery", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/query/{uuid}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/GetQuery", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/query/{uuid}"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_ABitOfEverythingService_GetQuery_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_ABitOfEverythingService_GetQuery_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_ABitOfEverythingService_GetQuery_0)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/GetQuery"))
 	mux.Handle(http.MethodGet, pattern_ABitOfEverythingService_GetRepeatedQuery_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(ABitOfEverythingService_GetRepeatedQuery_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/GetRepeatedQuery", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything_repeated/{path_repeated_float_value}/{path_repeated_double_value}/{path_repeated_int64_value}/{path_repeated_uint64_value}/{path_repeated_int32_value}/{path_repeated_fixed64_value}/{path_repeated_fixed32_value}/{path_repeated_bool_value}/{path_repeated_string_value}/{path_repeated_bytes_value}/{path_repeated_uint32_value}/{path_repeated_enum_value}/{path_repeated_sfixed32_value}/{path_repeated_sfixed64_value}/{path_repeated_sint32_value}/{path_repeated_sint64_value}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/GetRepeatedQuery", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything_repeated/{path_repeated_float_value}/{path_repeated_double_value}/{path_repeated_int64_value}/{path_repeated_uint64_value}/{path_repeated_int32_value}/{path_repeated_fixed64_value}/{path_repeated_fixed32_value}/{path_repeated_bool_value}/{path_repeated_string_value}/{path_repeated_bytes_value}/{path_repeated_uint32_value}/{path_repeated_enum_value}/{path_repeated_sfixed32_value}/{path_repeated_sfixed64_value}/{path_repeated_sint32_value}/{path_repeated_sint64_value}"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_ABitOfEverythingService_GetRepeatedQuery_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_ABitOfEverythingService_GetRepeatedQuery_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_ABitOfEverythingService_GetRepeatedQuery_0)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/GetRepeatedQuery"))
 	mux.Handle(http.MethodGet, pattern_ABitOfEverythingService_Echo_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(ABitOfEverythingService_Echo_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Echo", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/echo/{value}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Echo", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/echo/{value}"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_ABitOfEverythingService_Echo_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_ABitOfEverythingService_Echo_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_ABitOfEverythingService_Echo_0)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Echo"))
 	mux.Handle(http.MethodPost, pattern_ABitOfEverythingService_Echo_1, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(ABitOfEverythingService_Echo_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Echo", runtime.WithHTTPPathPattern("/v2/example/echo"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Echo", runtime.WithHTTPPathPattern("/v2/example/echo"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_ABitOfEverythingService_Echo_1(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_ABitOfEverythingService_Echo_1(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_ABitOfEverythingService_Echo_1)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Echo"))
 	mux.Handle(http.MethodGet, pattern_ABitOfEverythingService_Echo_2, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(ABitOfEverythingService_Echo_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Echo", runtime.WithHTTPPathPattern("/v2/example/echo"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Echo", runtime.WithHTTPPathPattern("/v2/example/echo"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_ABitOfEverythingService_Echo_2(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_ABitOfEverythingService_Echo_2(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_ABitOfEverythingService_Echo_2)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Echo"))
 	mux.Handle(http.MethodPost, pattern_ABitOfEverythingService_DeepPathEcho_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(ABitOfEverythingService_DeepPathEcho_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/DeepPathEcho", runtime.WithHTTPPathPattern("/v1/example/deep_path/{single_nested.name}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/DeepPathEcho", runtime.WithHTTPPathPattern("/v1/example/deep_path/{single_nested.name}"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_ABitOfEverythingService_DeepPathEcho_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_ABitOfEverythingService_DeepPathEcho_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_ABitOfEverythingService_DeepPathEcho_0)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/DeepPathEcho"))
 	mux.Handle(http.MethodGet, pattern_ABitOfEverythingService_Timeout_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(ABitOfEverythingService_Timeout_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Timeout", runtime.WithHTTPPathPattern("/v2/example/timeout"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Timeout", runtime.WithHTTPPathPattern("/v2/example/timeout"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_ABitOfEverythingService_Timeout_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_ABitOfEverythingService_Timeout_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_ABitOfEverythingService_Timeout_0)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Timeout"))
 	mux.Handle(http.MethodGet, pattern_ABitOfEverythingService_ErrorWithDetails_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(ABitOfEverythingService_ErrorWithDetails_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/ErrorWithDetails", runtime.WithHTTPPathPattern("/v2/example/errorwithdetails"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/ErrorWithDetails", runtime.WithHTTPPathPattern("/v2/example/errorwithdetails"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_ABitOfEverythingService_ErrorWithDetails_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_ABitOfEverythingService_ErrorWithDetails_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_ABitOfEverythingService_ErrorWithDetails_0)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/ErrorWithDetails"))
 	mux.Handle(http.MethodPost, pattern_ABitOfEverythingService_GetMessageWithBody_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(ABitOfEverythingService_GetMessageWithBody_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/GetMessageWithBody", runtime.WithHTTPPathPattern("/v2/example/withbody/{id}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/GetMessageWithBody", runtime.WithHTTPPathPattern("/v2/example/withbody/{id}"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_ABitOfEverythingService_GetMessageWithBody_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_ABitOfEverythingService_GetMessageWithBody_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_ABitOfEverythingService_GetMessageWithBody_0)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/GetMessageWithBody"))
 	mux.Handle(http.MethodPost, pattern_ABitOfEverythingService_PostWithEmptyBody_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(ABitOfEverythingService_PostWithEmptyBody_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/PostWithEmptyBody", runtime.WithHTTPPathPattern("/v2/example/postwithemptybody/{name}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/PostWithEmptyBody", runtime.WithHTTPPathPattern("/v2/example/postwithemptybody/{name}"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_ABitOfEverythingService_PostWithEmptyBody_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_ABitOfEverythingService_PostWithEmptyBody_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_ABitOfEverythingService_PostWithEmptyBody_0)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/PostWithEmptyBody"))
 	mux.Handle(http.MethodGet, pattern_ABitOfEverythingService_CheckGetQueryParams_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(ABitOfEverythingService_CheckGetQueryParams_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CheckGetQueryParams", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/params/get/{single_nested.name}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CheckGetQueryParams", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/params/get/{single_nested.name}"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_ABitOfEverythingService_CheckGetQueryParams_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_ABitOfEverythingService_CheckGetQueryParams_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_ABitOfEverythingService_CheckGetQueryParams_0)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CheckGetQueryParams"))
 	mux.Handle(http.MethodGet, pattern_ABitOfEverythingService_CheckNestedEnumGetQueryParams_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(ABitOfEverythingService_CheckNestedEnumGetQueryParams_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CheckNestedEnumGetQueryParams", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/params/get/nested_enum/{single_nested.ok}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CheckNestedEnumGetQueryParams", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/params/get/nested_enum/{single_nested.ok}"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_ABitOfEverythingService_CheckNestedEnumGetQueryParams_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_ABitOfEverythingService_CheckNestedEnumGetQueryParams_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_ABitOfEverythingService_CheckNestedEnumGetQueryParams_0)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CheckNestedEnumGetQueryParams"))
 	mux.Handle(http.MethodPost, pattern_ABitOfEverythingService_CheckPostQueryParams_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(ABitOfEverythingService_CheckPostQueryParams_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CheckPostQueryParams", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/params/post/{string_value}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CheckPostQueryParams", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/params/post/{string_value}"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_ABitOfEverythingService_CheckPostQueryParams_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_ABitOfEverythingService_CheckPostQueryParams_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_ABitOfEverythingService_CheckPostQueryParams_0)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CheckPostQueryParams"))
 	mux.Handle(http.MethodPost, pattern_ABitOfEverythingService_OverwriteRequestContentType_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(ABitOfEverythingService_OverwriteRequestContentType_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/OverwriteRequestContentType", runtime.WithHTTPPathPattern("/v2/example/overwriterequestcontenttype"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/OverwriteRequestContentType", runtime.WithHTTPPathPattern("/v2/example/overwriterequestcontenttype"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_ABitOfEverythingService_OverwriteRequestContentType_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_ABitOfEverythingService_OverwriteRequestContentType_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_ABitOfEverythingService_OverwriteRequestContentType_0)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/OverwriteRequestContentType"))
 	mux.Handle(http.MethodGet, pattern_ABitOfEverythingService_OverwriteResponseContentType_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(ABitOfEverythingService_OverwriteResponseContentType_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/OverwriteResponseContentType", runtime.WithHTTPPathPattern("/v2/example/overwriteresponsecontenttype"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/OverwriteResponseContentType", runtime.WithHTTPPathPattern("/v2/example/overwriteresponsecontenttype"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_ABitOfEverythingService_OverwriteResponseContentType_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_ABitOfEverythingService_OverwriteResponseContentType_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_ABitOfEverythingService_OverwriteResponseContentType_0)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/OverwriteResponseContentType"))
 	mux.Handle(http.MethodGet, pattern_ABitOfEverythingService_CheckExternalPathEnum_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(ABitOfEverythingService_CheckExternalPathEnum_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CheckExternalPathEnum", runtime.WithHTTPPathPattern("/v2/{value}:check"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CheckExternalPathEnum", runtime.WithHTTPPathPattern("/v2/{value}:check"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_ABitOfEverythingService_CheckExternalPathEnum_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_ABitOfEverythingService_CheckExternalPathEnum_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_ABitOfEverythingService_CheckExternalPathEnum_0)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CheckExternalPathEnum"))
 	mux.Handle(http.MethodGet, pattern_ABitOfEverythingService_CheckExternalNestedPathEnum_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(ABitOfEverythingService_CheckExternalNestedPathEnum_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CheckExternalNestedPathEnum", runtime.WithHTTPPathPattern("/v3/{value}:check"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CheckExternalNestedPathEnum", runtime.WithHTTPPathPattern("/v3/{value}:check"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_ABitOfEverythingService_CheckExternalNestedPathEnum_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_ABitOfEverythingService_CheckExternalNestedPathEnum_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_ABitOfEverythingService_CheckExternalNestedPathEnum_0)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CheckExternalNestedPathEnum"))
 	mux.Handle(http.MethodGet, pattern_ABitOfEverythingService_CheckStatus_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(ABitOfEverythingService_CheckStatus_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CheckStatus", runtime.WithHTTPPathPattern("/v1/example/checkStatus"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CheckStatus", runtime.WithHTTPPathPattern("/v1/example/checkStatus"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_ABitOfEverythingService_CheckStatus_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_ABitOfEverythingService_CheckStatus_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_ABitOfEverythingService_CheckStatus_0)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CheckStatus"))
 	mux.Handle(http.MethodHead, pattern_ABitOfEverythingService_Exists_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(ABitOfEverythingService_Exists_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Exists", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/{uuid}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Exists", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/{uuid}"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_ABitOfEverythingService_Exists_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_ABitOfEverythingService_Exists_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_ABitOfEverythingService_Exists_0)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Exists"))
 	mux.Handle(http.MethodOptions, pattern_ABitOfEverythingService_CustomOptionsRequest_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(ABitOfEverythingService_CustomOptionsRequest_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CustomOptionsRequest", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/{uuid}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CustomOptionsRequest", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/{uuid}"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_ABitOfEverythingService_CustomOptionsRequest_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_ABitOfEverythingService_CustomOptionsRequest_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_ABitOfEverythingService_CustomOptionsRequest_0)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CustomOptionsRequest"))
 	mux.Handle(http.MethodTrace, pattern_ABitOfEverythingService_TraceRequest_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(ABitOfEverythingService_TraceRequest_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/TraceRequest", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/{uuid}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/TraceRequest", runtime.WithHTTPPathPattern("/v1/example/a_bit_of_everything/{uuid}"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_ABitOfEverythingService_TraceRequest_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_ABitOfEverythingService_TraceRequest_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_ABitOfEverythingService_TraceRequest_0)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/TraceRequest"))
 	mux.Handle(http.MethodPost, pattern_ABitOfEverythingService_PostOneofEnum_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(ABitOfEverythingService_PostOneofEnum_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/PostOneofEnum", runtime.WithHTTPPathPattern("/v1/example/oneofenum"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/PostOneofEnum", runtime.WithHTTPPathPattern("/v1/example/oneofenum"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_ABitOfEverythingService_PostOneofEnum_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_ABitOfEverythingService_PostOneofEnum_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_ABitOfEverythingService_PostOneofEnum_0)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/PostOneofEnum"))
 	mux.Handle(http.MethodPost, pattern_ABitOfEverythingService_PostRequiredMessageType_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(ABitOfEverythingService_PostRequiredMessageType_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/PostRequiredMessageType", runtime.WithHTTPPathPattern("/v1/example/requiredmessagetype"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/PostRequiredMessageType", runtime.WithHTTPPathPattern("/v1/example/requiredmessagetype"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_ABitOfEverythingService_PostRequiredMessageType_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_ABitOfEverythingService_PostRequiredMessageType_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_ABitOfEverythingService_PostRequiredMessageType_0)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/PostRequiredMessageType"))
 	return nil
 }
 
+// handlerClient_ABitOfEverythingService calls the request mutators of the options given to
+// RegisterABitOfEverythingServiceHandlerClientWithOptions before sending requests.
+type handlerClient_ABitOfEverythingService struct {
+	ABitOfEverythingServiceClient
+	options *runtime.HandlerOptions
+}
+
+func (c handlerClient_ABitOfEverythingService) Create(ctx context.Context, in *ABitOfEverything, opts ...grpc.CallOption) (*ABitOfEverything, error) {
+	if err := c.options.Method(ABitOfEverythingService_Create_HandlerMethod.FullMethod()).MutateRequest(ctx, in); err != nil {
+		return nil, err
+	}
+	return c.ABitOfEverythingServiceClient.Create(ctx, in, opts...)
+}
+
+func (c handlerClient_ABitOfEverythingService) CreateBody(ctx context.Context, in *ABitOfEverything, opts ...grpc.CallOption) (*ABitOfEverything, error) {
+	if err := c.options.Method(ABitOfEverythingService_CreateBody_HandlerMethod.FullMethod()).MutateRequest(ctx, in); err != nil {
+		return nil, err
+	}
+	return c.ABitOfEverythingServiceClient.CreateBody(ctx, in, opts...)
+}
+
+func (c handlerClient_ABitOfEverythingService) UpdateEntity(ctx context.Context, in *UpdateEntityRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	if err := c.options.Method(ABitOfEverythingService_UpdateEntity_HandlerMethod.FullMethod()).MutateRequest(ctx, in); err != nil {
+		return nil, err
+	}
+	return c.ABitOfEverythingServiceClient.UpdateEntity(ctx, in, opts...)
+}
+
+func (c handlerClient_ABitOfEverythingService) CreateBook(ctx context.Context, in *CreateBookRequest, opts ...grpc.CallOption) (*Book, error) {
+	if err := c.options.Method(ABitOfEverythingService_CreateBook_HandlerMethod.FullMethod()).MutateRequest(ctx, in); err != nil {
+		return nil, err
+	}
+	return c.ABitOfEverythingServiceClient.CreateBook(ctx, in, opts...)
+}
+
+func (c handlerClient_ABitOfEverythingService) UpdateBook(ctx context.Context, in *UpdateBookRequest, opts ...grpc.CallOption) (*Book, error) {
+	if err := c.options.Method(ABitOfEverythingService_UpdateBook_HandlerMethod.FullMethod()).MutateRequest(ctx, in); err != nil {
+		return nil, err
+	}
+	return c.ABitOfEverythingServiceClient.UpdateBook(ctx, in, opts...)
+}
+
+func (c handlerClient_ABitOfEverythingService) Lookup(ctx context.Context, in *sub2.IdMessage, opts ...grpc.CallOption) (*ABitOfEverything, error) {
+	if err := c.options.Method(ABitOfEverythingService_Lookup_HandlerMethod.FullMethod()).MutateRequest(ctx, in); err != nil {
+		return nil, err
+	}
+	return c.ABitOfEverythingServiceClient.Lookup(ctx, in, opts...)
+}
+
+func (c handlerClient_ABitOfEverythingService) Custom(ctx context.Context, in *ABitOfEverything, opts ...grpc.CallOption) (*ABitOfEverything, error) {
+	if err := c.options.Method(ABitOfEverythingService_Custom_HandlerMethod.FullMethod()).MutateRequest(ctx, in); err != nil {
+		return nil, err
+	}
+	return c.ABitOfEverythingServiceClient.Custom(ctx, in, opts...)
+}
+
+func (c handlerClient_ABitOfEverythingService) DoubleColon(ctx context.Context, in *ABitOfEverything, opts ...grpc.CallOption) (*ABitOfEverything, error) {
+	if err := c.options.Method(ABitOfEverythingService_DoubleColon_HandlerMethod.FullMethod()).MutateRequest(ctx, in); err != nil {
+		return nil, err
+	}
+	return c.ABitOfEverythingServiceClient.DoubleColon(ctx, in, opts...)
+}
+
+func (c handlerClient_ABitOfEverythingService) Update(ctx context.Context, in *ABitOfEverything, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	if err := c.options.Method(ABitOfEverythingService_Update_HandlerMethod.FullMethod()).MutateRequest(ctx, in); err != nil {
+		return nil, err
+	}
+	return c.ABitOfEverythingServiceClient.Update(ctx, in, opts...)
+}
+
+func (c handlerClient_ABitOfEverythingService) UpdateV2(ctx context.Context, in *UpdateV2Request, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	if err := c.options.Method(ABitOfEverythingService_UpdateV2_HandlerMethod.FullMethod()).MutateRequest(ctx, in); err != nil {
+		return nil, err
+	}
+	return c.ABitOfEverythingServiceClient.UpdateV2(ctx, in, opts...)
+}
+
+func (c handlerClient_ABitOfEverythingService) CreateNestedBodyOneof(ctx context.Context, in *NestedBodyOneofRequest, opts ...grpc.CallOption) (*NestedBodyOneof, error) {
+	if err := c.options.Method(ABitOfEverythingService_CreateNestedBodyOneof_HandlerMethod.FullMethod()).MutateRequest(ctx, in); err != nil {
+		return nil, err
+	}
+	return c.ABitOfEverythingServiceClient.CreateNestedBodyOneof(ctx, in, opts...)
+}
+
+func (c handlerClient_ABitOfEverythingService) Delete(ctx context.Context, in *sub2.IdMessage, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	if err := c.options.Method(ABitOfEverythingService_Delete_HandlerMethod.FullMethod()).MutateRequest(ctx, in); err != nil {
+		return nil, err
+	}
+	return c.ABitOfEverythingServiceClient.Delete(ctx, in, opts...)
+}
+
+func (c handlerClient_ABitOfEverythingService) GetQuery(ctx context.Context, in *ABitOfEverything, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	if err := c.options.Method(ABitOfEverythingService_GetQuery_HandlerMethod.FullMethod()).MutateRequest(ctx, in); err != nil {
+		return nil, err
+	}
+	return c.ABitOfEverythingServiceClient.GetQuery(ctx, in, opts...)
+}
+
+func (c handlerClient_ABitOfEverythingService) GetRepeatedQuery(ctx context.Context, in *ABitOfEverythingRepeated, opts ...grpc.CallOption) (*ABitOfEverythingRepeated, error) {
+	if err := c.options.Method(ABitOfEverythingService_GetRepeatedQuery_HandlerMethod.FullMethod()).MutateRequest(ctx, in); err != nil {
+		return nil, err
+	}
+	return c.ABitOfEverythingServiceClient.GetRepeatedQuery(ctx, in, opts...)
+}
+
+func (c handlerClient_ABitOfEverythingService) Echo(ctx context.Context, in *sub.StringMessage, opts ...grpc.CallOption) (*sub.StringMessage, error) {
+	if err := c.options.Method(ABitOfEverythingService_Echo_HandlerMethod.FullMethod()).MutateRequest(ctx, in); err != nil {
+		return nil, err
+	}
+	return c.ABitOfEverythingServiceClient.Echo(ctx, in, opts...)
+}
+
+func (c handlerClient_ABitOfEverythingService) DeepPathEcho(ctx context.Context, in *ABitOfEverything, opts ...grpc.CallOption) (*ABitOfEverything, error) {
+	if err := c.options.Method(ABitOfEverythingService_DeepPathEcho_HandlerMethod.FullMethod()).MutateRequest(ctx, in); err != nil {
+		return nil, err
+	}
+	return c.ABitOfEverythingServiceClient.DeepPathEcho(ctx, in, opts...)
+}
+
+func (c handlerClient_ABitOfEverythingService) Timeout(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	if err := c.options.Method(ABitOfEverythingService_Timeout_HandlerMethod.FullMethod()).MutateRequest(ctx, in); err != nil {
+		return nil, err
+	}
+	return c.ABitOfEverythingServiceClient.Timeout(ctx, in, opts...)
+}
+
+func (c handlerClient_ABitOfEverythingService) ErrorWithDetails(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	if err := c.options.Method(ABitOfEverythingService_ErrorWithDetails_HandlerMethod.FullMethod()).MutateRequest(ctx, in); err != nil {
+		return nil, err
+	}
+	return c.ABitOfEverythingServiceClient.ErrorWithDetails(ctx, in, opts...)
+}
+
+func (c handlerClient_ABitOfEverythingService) GetMessageWithBody(ctx context.Context, in *MessageWithBody, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	if err := c.options.Method(ABitOfEverythingService_GetMessageWithBody_HandlerMethod.FullMethod()).MutateRequest(ctx, in); err != nil {
+		return nil, err
+	}
+	return c.ABitOfEverythingServiceClient.GetMessageWithBody(ctx, in, opts...)
+}
+
+func (c handlerClient_ABitOfEverythingService) PostWithEmptyBody(ctx context.Context, in *Body, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	if err := c.options.Method(ABitOfEverythingService_PostWithEmptyBody_HandlerMethod.FullMethod()).MutateRequest(ctx, in); err != nil {
+		return nil, err
+	}
+	return c.ABitOfEverythingServiceClient.PostWithEmptyBody(ctx, in, opts...)
+}
+
+func (c handlerClient_ABitOfEverythingService) CheckGetQueryParams(ctx context.Context, in *ABitOfEverything, opts ...grpc.CallOption) (*ABitOfEverything, error) {
+	if err := c.options.Method(ABitOfEverythingService_CheckGetQueryParams_HandlerMethod.FullMethod()).MutateRequest(ctx, in); err != nil {
+		return nil, err
+	}
+	return c.ABitOfEverythingServiceClient.CheckGetQueryParams(ctx, in, opts...)
+}
+
+func (c handlerClient_ABitOfEverythingService) CheckNestedEnumGetQueryParams(ctx context.Context, in *ABitOfEverything, opts ...grpc.CallOption) (*ABitOfEverything, error) {
+	if err := c.options.Method(ABitOfEverythingService_CheckNestedEnumGetQueryParams_HandlerMethod.FullMethod()).MutateRequest(ctx, in); err != nil {
+		return nil, err
+	}
+	return c.ABitOfEverythingServiceClient.CheckNestedEnumGetQueryParams(ctx, in, opts...)
+}
+
+func (c handlerClient_ABitOfEverythingService) CheckPostQueryParams(ctx context.Context, in *ABitOfEverything, opts ...grpc.CallOption) (*ABitOfEverything, error) {
+	if err := c.options.Method(ABitOfEverythingService_CheckPostQueryParams_HandlerMethod.FullMethod()).MutateRequest(ctx, in); err != nil {
+		return nil, err
+	}
+	return c.ABitOfEverythingServiceClient.CheckPostQueryParams(ctx, in, opts...)
+}
+
+func (c handlerClient_ABitOfEverythingService) OverwriteRequestContentType(ctx context.Context, in *Body, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	if err := c.options.Method(ABitOfEverythingService_OverwriteRequestContentType_HandlerMethod.FullMethod()).MutateRequest(ctx, in); err != nil {
+		return nil, err
+	}
+	return c.ABitOfEverythingServiceClient.OverwriteRequestContentType(ctx, in, opts...)
+}
+
+func (c handlerClient_ABitOfEverythingService) OverwriteResponseContentType(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.StringValue, error) {
+	if err := c.options.Method(ABitOfEverythingService_OverwriteResponseContentType_HandlerMethod.FullMethod()).MutateRequest(ctx, in); err != nil {
+		return nil, err
+	}
+	return c.ABitOfEverythingServiceClient.OverwriteResponseContentType(ctx, in, opts...)
+}
+
+func (c handlerClient_ABitOfEverythingService) CheckExternalPathEnum(ctx context.Context, in *pathenum.MessageWithPathEnum, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	if err := c.options.Method(ABitOfEverythingService_CheckExternalPathEnum_HandlerMethod.FullMethod()).MutateRequest(ctx, in); err != nil {
+		return nil, err
+	}
+	return c.ABitOfEverythingServiceClient.CheckExternalPathEnum(ctx, in, opts...)
+}
+
+func (c handlerClient_ABitOfEverythingService) CheckExternalNestedPathEnum(ctx context.Context, in *pathenum.MessageWithNestedPathEnum, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	if err := c.options.Method(ABitOfEverythingService_CheckExternalNestedPathEnum_HandlerMethod.FullMethod()).MutateRequest(ctx, in); err != nil {
+		return nil, err
+	}
+	return c.ABitOfEverythingServiceClient.CheckExternalNestedPathEnum(ctx, in, opts...)
+}
+
+func (c handlerClient_ABitOfEverythingService) CheckStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CheckStatusResponse, error) {
+	if err := c.options.Method(ABitOfEverythingService_CheckStatus_HandlerMethod.FullMethod()).MutateRequest(ctx, in); err != nil {
+		return nil, err
+	}
+	return c.ABitOfEverythingServiceClient.CheckStatus(ctx, in, opts...)
+}
+
+func (c handlerClient_ABitOfEverythingService) Exists(ctx context.Context, in *ABitOfEverything, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	if err := c.options.Method(ABitOfEverythingService_Exists_HandlerMethod.FullMethod()).MutateRequest(ctx, in); err != nil {
+		return nil, err
+	}
+	return c.ABitOfEverythingServiceClient.Exists(ctx, in, opts...)
+}
+
+func (c handlerClient_ABitOfEverythingService) CustomOptionsRequest(ctx context.Context, in *ABitOfEverything, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	if err := c.options.Method(ABitOfEverythingService_CustomOptionsRequest_HandlerMethod.FullMethod()).MutateRequest(ctx, in); err != nil {
+		return nil, err
+	}
+	return c.ABitOfEverythingServiceClient.CustomOptionsRequest(ctx, in, opts...)
+}
+
+func (c handlerClient_ABitOfEverythingService) TraceRequest(ctx context.Context, in *ABitOfEverything, opts ...grpc.CallOption) (*ABitOfEverything, error) {
+	if err := c.options.Method(ABitOfEverythingService_TraceRequest_HandlerMethod.FullMethod()).MutateRequest(ctx, in); err != nil {
+		return nil, err
+	}
+	return c.ABitOfEverythingServiceClient.TraceRequest(ctx, in, opts...)
+}
+
+func (c handlerClient_ABitOfEverythingService) PostOneofEnum(ctx context.Context, in *oneofenum.OneofEnumMessage, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	if err := c.options.Method(ABitOfEverythingService_PostOneofEnum_HandlerMethod.FullMethod()).MutateRequest(ctx, in); err != nil {
+		return nil, err
+	}
+	return c.ABitOfEverythingServiceClient.PostOneofEnum(ctx, in, opts...)
+}
+
+func (c handlerClient_ABitOfEverythingService) PostRequiredMessageType(ctx context.Context, in *RequiredMessageTypeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	if err := c.options.Method(ABitOfEverythingService_PostRequiredMessageType_HandlerMethod.FullMethod()).MutateRequest(ctx, in); err != nil {
+		return nil, err
+	}
+	return c.ABitOfEverythingServiceClient.PostRequiredMessageType(ctx, in, opts...)
+}
+
+// The HandlerMethod variables of the methods of ABitOfEverythingService identify them in the options given to
+// RegisterABitOfEverythingServiceHandlerClientWithOptions.
+var (
+	ABitOfEverythingService_Create_HandlerMethod                        = runtime.NewHandlerMethod[*ABitOfEverything, *ABitOfEverything]("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Create")
+	ABitOfEverythingService_CreateBody_HandlerMethod                    = runtime.NewHandlerMethod[*ABitOfEverything, *ABitOfEverything]("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CreateBody")
+	ABitOfEverythingService_UpdateEntity_HandlerMethod                  = runtime.NewHandlerMethod[*UpdateEntityRequest, *emptypb.Empty]("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/UpdateEntity")
+	ABitOfEverythingService_CreateBook_HandlerMethod                    = runtime.NewHandlerMethod[*CreateBookRequest, *Book]("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CreateBook")
+	ABitOfEverythingService_UpdateBook_HandlerMethod                    = runtime.NewHandlerMethod[*UpdateBookRequest, *Book]("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/UpdateBook")
+	ABitOfEverythingService_Lookup_HandlerMethod                        = runtime.NewHandlerMethod[*sub2.IdMessage, *ABitOfEverything]("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Lookup")
+	ABitOfEverythingService_Custom_HandlerMethod                        = runtime.NewHandlerMethod[*ABitOfEverything, *ABitOfEverything]("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Custom")
+	ABitOfEverythingService_DoubleColon_HandlerMethod                   = runtime.NewHandlerMethod[*ABitOfEverything, *ABitOfEverything]("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/DoubleColon")
+	ABitOfEverythingService_Update_HandlerMethod                        = runtime.NewHandlerMethod[*ABitOfEverything, *emptypb.Empty]("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Update")
+	ABitOfEverythingService_UpdateV2_HandlerMethod                      = runtime.NewHandlerMethod[*UpdateV2Request, *emptypb.Empty]("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/UpdateV2")
+	ABitOfEverythingService_CreateNestedBodyOneof_HandlerMethod         = runtime.NewHandlerMethod[*NestedBodyOneofRequest, *NestedBodyOneof]("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CreateNestedBodyOneof")
+	ABitOfEverythingService_Delete_HandlerMethod                        = runtime.NewHandlerMethod[*sub2.IdMessage, *emptypb.Empty]("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Delete")
+	ABitOfEverythingService_GetQuery_HandlerMethod                      = runtime.NewHandlerMethod[*ABitOfEverything, *emptypb.Empty]("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/GetQuery")
+	ABitOfEverythingService_GetRepeatedQuery_HandlerMethod              = runtime.NewHandlerMethod[*ABitOfEverythingRepeated, *ABitOfEverythingRepeated]("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/GetRepeatedQuery")
+	ABitOfEverythingService_Echo_HandlerMethod                          = runtime.NewHandlerMethod[*sub.StringMessage, *sub.StringMessage]("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Echo")
+	ABitOfEverythingService_DeepPathEcho_HandlerMethod                  = runtime.NewHandlerMethod[*ABitOfEverything, *ABitOfEverything]("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/DeepPathEcho")
+	ABitOfEverythingService_Timeout_HandlerMethod                       = runtime.NewHandlerMethod[*emptypb.Empty, *emptypb.Empty]("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Timeout")
+	ABitOfEverythingService_ErrorWithDetails_HandlerMethod              = runtime.NewHandlerMethod[*emptypb.Empty, *emptypb.Empty]("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/ErrorWithDetails")
+	ABitOfEverythingService_GetMessageWithBody_HandlerMethod            = runtime.NewHandlerMethod[*MessageWithBody, *emptypb.Empty]("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/GetMessageWithBody")
+	ABitOfEverythingService_PostWithEmptyBody_HandlerMethod             = runtime.NewHandlerMethod[*Body, *emptypb.Empty]("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/PostWithEmptyBody")
+	ABitOfEverythingService_CheckGetQueryParams_HandlerMethod           = runtime.NewHandlerMethod[*ABitOfEverything, *ABitOfEverything]("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CheckGetQueryParams")
+	ABitOfEverythingService_CheckNestedEnumGetQueryParams_HandlerMethod = runtime.NewHandlerMethod[*ABitOfEverything, *ABitOfEverything]("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CheckNestedEnumGetQueryParams")
+	ABitOfEverythingService_CheckPostQueryParams_HandlerMethod          = runtime.NewHandlerMethod[*ABitOfEverything, *ABitOfEverything]("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CheckPostQueryParams")
+	ABitOfEverythingService_OverwriteRequestContentType_HandlerMethod   = runtime.NewHandlerMethod[*Body, *emptypb.Empty]("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/OverwriteRequestContentType")
+	ABitOfEverythingService_OverwriteResponseContentType_HandlerMethod  = runtime.NewHandlerMethod[*emptypb.Empty, *wrapperspb.StringValue]("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/OverwriteResponseContentType")
+	ABitOfEverythingService_CheckExternalPathEnum_HandlerMethod         = runtime.NewHandlerMethod[*pathenum.MessageWithPathEnum, *emptypb.Empty]("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CheckExternalPathEnum")
+	ABitOfEverythingService_CheckExternalNestedPathEnum_HandlerMethod   = runtime.NewHandlerMethod[*pathenum.MessageWithNestedPathEnum, *emptypb.Empty]("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CheckExternalNestedPathEnum")
+	ABitOfEverythingService_CheckStatus_HandlerMethod                   = runtime.NewHandlerMethod[*emptypb.Empty, *CheckStatusResponse]("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CheckStatus")
+	ABitOfEverythingService_Exists_HandlerMethod                        = runtime.NewHandlerMethod[*ABitOfEverything, *emptypb.Empty]("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/Exists")
+	ABitOfEverythingService_CustomOptionsRequest_HandlerMethod          = runtime.NewHandlerMethod[*ABitOfEverything, *emptypb.Empty]("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/CustomOptionsRequest")
+	ABitOfEverythingService_TraceRequest_HandlerMethod                  = runtime.NewHandlerMethod[*ABitOfEverything, *ABitOfEverything]("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/TraceRequest")
+	ABitOfEverythingService_PostOneofEnum_HandlerMethod                 = runtime.NewHandlerMethod[*oneofenum.OneofEnumMessage, *emptypb.Empty]("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/PostOneofEnum")
+	ABitOfEverythingService_PostRequiredMessageType_HandlerMethod       = runtime.NewHandlerMethod[*RequiredMessageTypeRequest, *emptypb.Empty]("/grpc.gateway.examples.internal.proto.examplepb.ABitOfEverythingService/PostRequiredMessageType")
+)
+
 var (
 	pattern_ABitOfEverythingService_Create_0                        = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 1, 0, 4, 1, 5, 4, 1, 0, 4, 1, 5, 5, 2, 6, 1, 0, 4, 1, 5, 7, 1, 0, 4, 1, 5, 8, 1, 0, 4, 1, 5, 9, 1, 0, 4, 1, 5, 10, 1, 0, 4, 1, 5, 11, 2, 12, 1, 0, 4, 2, 5, 13, 1, 0, 4, 1, 5, 14, 1, 0, 4, 1, 5, 15, 1, 0, 4, 1, 5, 16, 1, 0, 4, 1, 5, 17, 1, 0, 4, 1, 5, 18, 1, 0, 4, 1, 5, 19, 1, 0, 4, 1, 5, 20, 1, 0, 4, 1, 5, 21, 1, 0, 4, 1, 5, 22, 1, 0, 4, 1, 5, 23}, []string{"v1", "example", "a_bit_of_everything", "float_value", "double_value", "int64_value", "separator", "uint64_value", "int32_value", "fixed64_value", "fixed32_value", "bool_value", "strprefix", "string_value", "uint32_value", "sfixed32_value", "sfixed64_value", "sint32_value", "sint64_value", "nonConventionalNameValue", "enum_value", "path_enum_value", "nested_path_enum_value", "enum_value_annotation"}, ""))
 	pattern_ABitOfEverythingService_CreateBody_0                    = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"v1", "example", "a_bit_of_everything"}, ""))
@@ -4025,26 +4617,62 @@ func RegisterCamelCaseServiceNameHandler(ctx context.Context, mux *runtime.Serve
 // doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
 // "CamelCaseServiceNameClient" to call the correct interceptors. This client ignores the HTTP middlewares.
 func RegisterCamelCaseServiceNameHandlerClient(ctx context.Context, mux *runtime.ServeMux, client CamelCaseServiceNameClient) error {
+	return RegisterCamelCaseServiceNameHandlerClientWithOptions(ctx, mux, client)
+}
+
+// RegisterCamelCaseServiceNameHandlerClientWithOptions is same as RegisterCamelCaseServiceNameHandlerClient but
+// customizes the handlers of the methods of CamelCaseServiceName with "opts", whose methods are identified by the
+// CamelCaseServiceName_{Method}_HandlerMethod variables.
+func RegisterCamelCaseServiceNameHandlerClientWithOptions(ctx context.Context, mux *runtime.ServeMux, client CamelCaseServiceNameClient, opts ...runtime.HandlerOption) error {
+	options := runtime.NewHandlerOptions(opts...)
+	client = handlerClient_CamelCaseServiceName{CamelCaseServiceNameClient: client, options: options}
 	mux.Handle(http.MethodGet, pattern_CamelCaseServiceName_Empty_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(CamelCaseServiceName_Empty_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.CamelCaseServiceName/Empty", runtime.WithHTTPPathPattern("/v2/example/empty"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.CamelCaseServiceName/Empty", runtime.WithHTTPPathPattern("/v2/example/empty"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_CamelCaseServiceName_Empty_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_CamelCaseServiceName_Empty_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_CamelCaseServiceName_Empty_0)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.CamelCaseServiceName/Empty"))
 	return nil
 }
 
+// handlerClient_CamelCaseServiceName calls the request mutators of the options given to
+// RegisterCamelCaseServiceNameHandlerClientWithOptions before sending requests.
+type handlerClient_CamelCaseServiceName struct {
+	CamelCaseServiceNameClient
+	options *runtime.HandlerOptions
+}
+
+func (c handlerClient_CamelCaseServiceName) Empty(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	if err := c.options.Method(CamelCaseServiceName_Empty_HandlerMethod.FullMethod()).MutateRequest(ctx, in); err != nil {
+		return nil, err
+	}
+	return c.CamelCaseServiceNameClient.Empty(ctx, in, opts...)
+}
+
+// The HandlerMethod variables of the methods of CamelCaseServiceName identify them in the options given to
+// RegisterCamelCaseServiceNameHandlerClientWithOptions.
+var (
+	CamelCaseServiceName_Empty_HandlerMethod = runtime.NewHandlerMethod[*emptypb.Empty, *emptypb.Empty]("/grpc.gateway.examples.internal.proto.examplepb.CamelCaseServiceName/Empty")
+)
+
 var (
 	pattern_CamelCaseServiceName_Empty_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"v2", "example", "empty"}, ""))
 )
@@ -4089,26 +4717,62 @@ func RegisterSnakeEnumServiceHandler(ctx context.Context, mux *runtime.ServeMux,
 // doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
 // "SnakeEnumServiceClient" to call the correct interceptors. This client ignores the HTTP middlewares.
 func RegisterSnakeEnumServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client SnakeEnumServiceClient) error {
+	return RegisterSnakeEnumServiceHandlerClientWithOptions(ctx, mux, client)
+}
+
+// RegisterSnakeEnumServiceHandlerClientWithOptions is same as RegisterSnakeEnumServiceHandlerClient but
+// customizes the handlers of the methods of SnakeEnumService with "opts", whose methods are identified by the
+// SnakeEnumService_{Method}_HandlerMethod variables.
+func RegisterSnakeEnumServiceHandlerClientWithOptions(ctx context.Context, mux *runtime.ServeMux, client SnakeEnumServiceClient, opts ...runtime.HandlerOption) error {
+	options := runtime.NewHandlerOptions(opts...)
+	client = handlerClient_SnakeEnumService{SnakeEnumServiceClient: client, options: options}
 	mux.Handle(http.MethodGet, pattern_SnakeEnumService_SnakeEnum_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(SnakeEnumService_SnakeEnum_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.SnakeEnumService/SnakeEnum", runtime.WithHTTPPathPattern("/v1/example/snake/{who}/{what}/{where}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.SnakeEnumService/SnakeEnum", runtime.WithHTTPPathPattern("/v1/example/snake/{who}/{what}/{where}"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_SnakeEnumService_SnakeEnum_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_SnakeEnumService_SnakeEnum_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_SnakeEnumService_SnakeEnum_0)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.SnakeEnumService/SnakeEnum"))
 	return nil
 }
 
+// handlerClient_SnakeEnumService calls the request mutators of the options given to
+// RegisterSnakeEnumServiceHandlerClientWithOptions before sending requests.
+type handlerClient_SnakeEnumService struct {
+	SnakeEnumServiceClient
+	options *runtime.HandlerOptions
+}
+
+func (c handlerClient_SnakeEnumService) SnakeEnum(ctx context.Context, in *SnakeEnumRequest, opts ...grpc.CallOption) (*SnakeEnumResponse, error) {
+	if err := c.options.Method(SnakeEnumService_SnakeEnum_HandlerMethod.FullMethod()).MutateRequest(ctx, in); err != nil {
+		return nil, err
+	}
+	return c.SnakeEnumServiceClient.SnakeEnum(ctx, in, opts...)
+}
+
+// The HandlerMethod variables of the methods of SnakeEnumService identify them in the options given to
+// RegisterSnakeEnumServiceHandlerClientWithOptions.
+var (
+	SnakeEnumService_SnakeEnum_HandlerMethod = runtime.NewHandlerMethod[*SnakeEnumRequest, *SnakeEnumResponse]("/grpc.gateway.examples.internal.proto.examplepb.SnakeEnumService/SnakeEnum")
+)
+
 var (
 	pattern_SnakeEnumService_SnakeEnum_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 1, 0, 4, 1, 5, 4, 1, 0, 4, 1, 5, 5}, []string{"v1", "example", "snake", "who", "what", "where"}, ""))
 )
@@ -117,11 +117,12 @@ func RegisterCamel_CaseServiceHandlerServer(ctx context.Context, mux *runtime.Se
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.Camel_CaseService/GetStatus", runtime.WithHTTPPathPattern("/v1/camel_case/{state}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.Camel_CaseService/GetStatus", runtime.WithHTTPPathPattern("/v1/camel_case/{state}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_Camel_CaseService_GetStatus_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -130,18 +131,19 @@ func RegisterCamel_CaseServiceHandlerServer(ctx context.Context, mux *runtime.Se
 			return
 		}
 		forward_Camel_CaseService_GetStatus_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.Camel_CaseService/GetStatus"))
 	mux.Handle(http.MethodPost, pattern_Camel_CaseService_Post_Book_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.Camel_CaseService/Post_Book", runtime.WithHTTPPathPattern("/v1/camel_case/books"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.Camel_CaseService/Post_Book", runtime.WithHTTPPathPattern("/v1/camel_case/books"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_Camel_CaseService_Post_Book_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -150,7 +152,7 @@ func RegisterCamel_CaseServiceHandlerServer(ctx context.Context, mux *runtime.Se
 			return
 		}
 		forward_Camel_CaseService_Post_Book_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.Camel_CaseService/Post_Book"))
 
 	return nil
 }
@@ -191,43 +193,94 @@ func RegisterCamel_CaseServiceHandler(ctx context.Context, mux *runtime.ServeMux
 // doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
 // "Camel_CaseServiceClient" to call the correct interceptors. This client ignores the HTTP middlewares.
 func RegisterCamel_CaseServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client Camel_CaseServiceClient) error {
+	return RegisterCamel_CaseServiceHandlerClientWithOptions(ctx, mux, client)
+}
+
+// RegisterCamel_CaseServiceHandlerClientWithOptions is same as RegisterCamel_CaseServiceHandlerClient but
+// customizes the handlers of the methods of Camel_CaseService with "opts", whose methods are identified by the
+// Camel_CaseService_{Method}_HandlerMethod variables.
+func RegisterCamel_CaseServiceHandlerClientWithOptions(ctx context.Context, mux *runtime.ServeMux, client Camel_CaseServiceClient, opts ...runtime.HandlerOption) error {
+	options := runtime.NewHandlerOptions(opts...)
+	client = handlerClient_Camel_CaseService{Camel_CaseServiceClient: client, options: options}
 	mux.Handle(http.MethodGet, pattern_Camel_CaseService_GetStatus_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(Camel_CaseService_GetStatus_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.Camel_CaseService/GetStatus", runtime.WithHTTPPathPattern("/v1/camel_case/{state}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.Camel_CaseService/GetStatus", runtime.WithHTTPPathPattern("/v1/camel_case/{state}"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_Camel_CaseService_GetStatus_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Camel_CaseService_GetStatus_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_Camel_CaseService_GetStatus_0)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.Camel_CaseService/GetStatus"))
 	mux.Handle(http.MethodPost, pattern_Camel_CaseService_Post_Book_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(Camel_CaseService_Post_Book_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.Camel_CaseService/Post_Book", runtime.WithHTTPPathPattern("/v1/camel_case/books"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.Camel_CaseService/Post_Book", runtime.WithHTTPPathPattern("/v1/camel_case/books"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_Camel_CaseService_Post_Book_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Camel_CaseService_Post_Book_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_Camel_CaseService_Post_Book_0)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.Camel_CaseService/Post_Book"))
 	return nil
 }
 
+// handlerClient_Camel_CaseService calls the request mutators of the options given to
+// RegisterCamel_CaseServiceHandlerClientWithOptions before sending requests.
+type handlerClient_Camel_CaseService struct {
+	Camel_CaseServiceClient
+	options *runtime.HandlerOptions
+}
+
+func (c handlerClient_Camel_CaseService) GetStatus(ctx context.Context, in *GetStatusRequest, opts ...grpc.CallOption) (*GetStatusResponse, error) {
+	if err := c.options.Method(Camel_CaseService_GetStatus_HandlerMethod.FullMethod()).MutateRequest(ctx, in); err != nil {
+		return nil, err
+	}
+	return c.Camel_CaseServiceClient.GetStatus(ctx, in, opts...)
+}
+
+func (c handlerClient_Camel_CaseService) Post_Book(ctx context.Context, in *PostBookRequest, opts ...grpc.CallOption) (*PostBookResponse, error) {
+	if err := c.options.Method(Camel_CaseService_Post_Book_HandlerMethod.FullMethod()).MutateRequest(ctx, in); err != nil {
+		return nil, err
+	}
+	return c.Camel_CaseServiceClient.Post_Book(ctx, in, opts...)
+}
+
+// The HandlerMethod variables of the methods of Camel_CaseService identify them in the options given to
+// RegisterCamel_CaseServiceHandlerClientWithOptions.
+var (
+	Camel_CaseService_GetStatus_HandlerMethod = runtime.NewHandlerMethod[*GetStatusRequest, *GetStatusResponse]("/grpc.gateway.examples.internal.proto.examplepb.Camel_CaseService/GetStatus")
+	Camel_CaseService_Post_Book_HandlerMethod = runtime.NewHandlerMethod[*PostBookRequest, *PostBookResponse]("/grpc.gateway.examples.internal.proto.examplepb.Camel_CaseService/Post_Book")
+)
+
 var (
 	pattern_Camel_CaseService_GetStatus_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 1, 0, 4, 1, 5, 2}, []string{"v1", "camel_case", "state"}, ""))
 	pattern_Camel_CaseService_Post_Book_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"v1", "camel_case", "books"}, ""))
@@ -774,11 +774,12 @@ func RegisterEchoServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EchoService/Echo", runtime.WithHTTPPathPattern("/v1/example/echo/{id}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EchoService/Echo", runtime.WithHTTPPathPattern("/v1/example/echo/{id}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_EchoService_Echo_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -787,18 +788,19 @@ func RegisterEchoServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux
 			return
 		}
 		forward_EchoService_Echo_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.EchoService/Echo"))
 	mux.Handle(http.MethodGet, pattern_EchoService_Echo_1, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EchoService/Echo", runtime.WithHTTPPathPattern("/v1/example/echo/{id}/{num}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EchoService/Echo", runtime.WithHTTPPathPattern("/v1/example/echo/{id}/{num}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_EchoService_Echo_1(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -807,18 +809,19 @@ func RegisterEchoServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux
 			return
 		}
 		forward_EchoService_Echo_1(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.EchoService/Echo"))
 	mux.Handle(http.MethodGet, pattern_EchoService_Echo_2, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EchoService/Echo", runtime.WithHTTPPathPattern("/v1/example/echo/{id}/{num}/{lang}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EchoService/Echo", runtime.WithHTTPPathPattern("/v1/example/echo/{id}/{num}/{lang}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_EchoService_Echo_2(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -827,18 +830,19 @@ func RegisterEchoServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux
 			return
 		}
 		forward_EchoService_Echo_2(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.EchoService/Echo"))
 	mux.Handle(http.MethodGet, pattern_EchoService_Echo_3, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EchoService/Echo", runtime.WithHTTPPathPattern("/v1/example/echo1/{id}/{line_num}/{status.note}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EchoService/Echo", runtime.WithHTTPPathPattern("/v1/example/echo1/{id}/{line_num}/{status.note}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_EchoService_Echo_3(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -847,18 +851,19 @@ func RegisterEchoServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux
 			return
 		}
 		forward_EchoService_Echo_3(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.EchoService/Echo"))
 	mux.Handle(http.MethodGet, pattern_EchoService_Echo_4, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EchoService/Echo", runtime.WithHTTPPathPattern("/v1/example/echo2/{no.note}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EchoService/Echo", runtime.WithHTTPPathPattern("/v1/example/echo2/{no.note}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_EchoService_Echo_4(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -867,18 +872,19 @@ func RegisterEchoServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux
 			return
 		}
 		forward_EchoService_Echo_4(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.EchoService/Echo"))
 	mux.Handle(http.MethodGet, pattern_EchoService_Echo_5, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EchoService/Echo", runtime.WithHTTPPathPattern("/v1/example/echo/resource/{resource_id}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EchoService/Echo", runtime.WithHTTPPathPattern("/v1/example/echo/resource/{resource_id}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_EchoService_Echo_5(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -887,18 +893,19 @@ func RegisterEchoServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux
 			return
 		}
 		forward_EchoService_Echo_5(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.EchoService/Echo"))
 	mux.Handle(http.MethodGet, pattern_EchoService_Echo_6, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EchoService/Echo", runtime.WithHTTPPathPattern("/v1/example/echo/nested/{n_id.n_id}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EchoService/Echo", runtime.WithHTTPPathPattern("/v1/example/echo/nested/{n_id.n_id}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_EchoService_Echo_6(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -907,18 +914,19 @@ func RegisterEchoServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux
 			return
 		}
 		forward_EchoService_Echo_6(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.EchoService/Echo"))
 	mux.Handle(http.MethodPost, pattern_EchoService_EchoBody_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EchoService/EchoBody", runtime.WithHTTPPathPattern("/v1/example/echo_body"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EchoService/EchoBody", runtime.WithHTTPPathPattern("/v1/example/echo_body"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_EchoService_EchoBody_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -927,18 +935,19 @@ func RegisterEchoServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux
 			return
 		}
 		forward_EchoService_EchoBody_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.EchoService/EchoBody"))
 	mux.Handle(http.MethodPut, pattern_EchoService_EchoBody_1, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EchoService/EchoBody", runtime.WithHTTPPathPattern("/v1/example/echo_body/{id}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EchoService/EchoBody", runtime.WithHTTPPathPattern("/v1/example/echo_body/{id}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_EchoService_EchoBody_1(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -947,18 +956,19 @@ func RegisterEchoServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux
 			return
 		}
 		forward_EchoService_EchoBody_1(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.EchoService/EchoBody"))
 	mux.Handle(http.MethodDelete, pattern_EchoService_EchoDelete_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EchoService/EchoDelete", runtime.WithHTTPPathPattern("/v1/example/echo_delete"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EchoService/EchoDelete", runtime.WithHTTPPathPattern("/v1/example/echo_delete"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_EchoService_EchoDelete_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -967,18 +977,19 @@ func RegisterEchoServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux
 			return
 		}
 		forward_EchoService_EchoDelete_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.EchoService/EchoDelete"))
 	mux.Handle(http.MethodPatch, pattern_EchoService_EchoPatch_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EchoService/EchoPatch", runtime.WithHTTPPathPattern("/v1/example/echo_patch"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EchoService/EchoPatch", runtime.WithHTTPPathPattern("/v1/example/echo_patch"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_EchoService_EchoPatch_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -987,18 +998,19 @@ func RegisterEchoServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux
 			return
 		}
 		forward_EchoService_EchoPatch_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.EchoService/EchoPatch"))
 	mux.Handle(http.MethodGet, pattern_EchoService_EchoUnauthorized_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EchoService/EchoUnauthorized", runtime.WithHTTPPathPattern("/v1/example/echo_unauthorized"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EchoService/EchoUnauthorized", runtime.WithHTTPPathPattern("/v1/example/echo_unauthorized"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_EchoService_EchoUnauthorized_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -1007,18 +1019,19 @@ func RegisterEchoServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux
 			return
 		}
 		forward_EchoService_EchoUnauthorized_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.EchoService/EchoUnauthorized"))
 	mux.Handle(http.MethodPost, pattern_EchoService_EchoStatus_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EchoService/EchoStatus", runtime.WithHTTPPathPattern("/v1/example/echo_status"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EchoService/EchoStatus", runtime.WithHTTPPathPattern("/v1/example/echo_status"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_EchoService_EchoStatus_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -1027,7 +1040,7 @@ func RegisterEchoServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux
 			return
 		}
 		forward_EchoService_EchoStatus_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.EchoService/EchoStatus"))
 
 	return nil
 }
@@ -1068,230 +1081,390 @@ func RegisterEchoServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn
 // doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
 // "EchoServiceClient" to call the correct interceptors. This client ignores the HTTP middlewares.
 func RegisterEchoServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client EchoServiceClient) error {
+	return RegisterEchoServiceHandlerClientWithOptions(ctx, mux, client)
+}
+
+// RegisterEchoServiceHandlerClientWithOptions is same as RegisterEchoServiceHandlerClient but
+// customizes the handlers of the methods of EchoService with "opts", whose methods are identified by the
+// EchoService_{Method}_HandlerMethod variables.
+func RegisterEchoServiceHandlerClientWithOptions(ctx context.Context, mux *runtime.ServeMux, client EchoServiceClient, opts ...runtime.HandlerOption) error {
+	options := runtime.NewHandlerOptions(opts...)
+	client = handlerClient_EchoService{EchoServiceClient: client, options: options}
 	mux.Handle(http.MethodPost, pattern_EchoService_Echo_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(EchoService_Echo_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EchoService/Echo", runtime.WithHTTPPathPattern("/v1/example/echo/{id}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EchoService/Echo", runtime.WithHTTPPathPattern("/v1/example/echo/{id}"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_EchoService_Echo_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_EchoService_Echo_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_EchoService_Echo_0)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.EchoService/Echo"))
 	mux.Handle(http.MethodGet, pattern_EchoService_Echo_1, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(EchoService_Echo_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EchoService/Echo", runtime.WithHTTPPathPattern("/v1/example/echo/{id}/{num}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EchoService/Echo", runtime.WithHTTPPathPattern("/v1/example/echo/{id}/{num}"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_EchoService_Echo_1(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_EchoService_Echo_1(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_EchoService_Echo_1)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.EchoService/Echo"))
 	mux.Handle(http.MethodGet, pattern_EchoService_Echo_2, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(EchoService_Echo_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EchoService/Echo", runtime.WithHTTPPathPattern("/v1/example/echo/{id}/{num}/{lang}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EchoService/Echo", runtime.WithHTTPPathPattern("/v1/example/echo/{id}/{num}/{lang}"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_EchoService_Echo_2(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_EchoService_Echo_2(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_EchoService_Echo_2)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.EchoService/Echo"))
 	mux.Handle(http.MethodGet, pattern_EchoService_Echo_3, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(EchoService_Echo_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EchoService/Echo", runtime.WithHTTPPathPattern("/v1/example/echo1/{id}/{line_num}/{status.note}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EchoService/Echo", runtime.WithHTTPPathPattern("/v1/example/echo1/{id}/{line_num}/{status.note}"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_EchoService_Echo_3(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_EchoService_Echo_3(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_EchoService_Echo_3)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.EchoService/Echo"))
 	mux.Handle(http.MethodGet, pattern_EchoService_Echo_4, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(EchoService_Echo_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EchoService/Echo", runtime.WithHTTPPathPattern("/v1/example/echo2/{no.note}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EchoService/Echo", runtime.WithHTTPPathPattern("/v1/example/echo2/{no.note}"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_EchoService_Echo_4(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_EchoService_Echo_4(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_EchoService_Echo_4)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.EchoService/Echo"))
 	mux.Handle(http.MethodGet, pattern_EchoService_Echo_5, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(EchoService_Echo_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EchoService/Echo", runtime.WithHTTPPathPattern("/v1/example/echo/resource/{resource_id}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EchoService/Echo", runtime.WithHTTPPathPattern("/v1/example/echo/resource/{resource_id}"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_EchoService_Echo_5(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_EchoService_Echo_5(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_EchoService_Echo_5)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.EchoService/Echo"))
 	mux.Handle(http.MethodGet, pattern_EchoService_Echo_6, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(EchoService_Echo_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EchoService/Echo", runtime.WithHTTPPathPattern("/v1/example/echo/nested/{n_id.n_id}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EchoService/Echo", runtime.WithHTTPPathPattern("/v1/example/echo/nested/{n_id.n_id}"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_EchoService_Echo_6(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_EchoService_Echo_6(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_EchoService_Echo_6)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.EchoService/Echo"))
 	mux.Handle(http.MethodPost, pattern_EchoService_EchoBody_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(EchoService_EchoBody_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EchoService/EchoBody", runtime.WithHTTPPathPattern("/v1/example/echo_body"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EchoService/EchoBody", runtime.WithHTTPPathPattern("/v1/example/echo_body"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_EchoService_EchoBody_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_EchoService_EchoBody_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_EchoService_EchoBody_0)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.EchoService/EchoBody"))
 	mux.Handle(http.MethodPut, pattern_EchoService_EchoBody_1, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(EchoService_EchoBody_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EchoService/EchoBody", runtime.WithHTTPPathPattern("/v1/example/echo_body/{id}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EchoService/EchoBody", runtime.WithHTTPPathPattern("/v1/example/echo_body/{id}"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_EchoService_EchoBody_1(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_EchoService_EchoBody_1(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_EchoService_EchoBody_1)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.EchoService/EchoBody"))
 	mux.Handle(http.MethodDelete, pattern_EchoService_EchoDelete_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(EchoService_EchoDelete_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EchoService/EchoDelete", runtime.WithHTTPPathPattern("/v1/example/echo_delete"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EchoService/EchoDelete", runtime.WithHTTPPathPattern("/v1/example/echo_delete"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_EchoService_EchoDelete_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_EchoService_EchoDelete_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_EchoService_EchoDelete_0)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.EchoService/EchoDelete"))
 	mux.Handle(http.MethodPatch, pattern_EchoService_EchoPatch_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(EchoService_EchoPatch_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EchoService/EchoPatch", runtime.WithHTTPPathPattern("/v1/example/echo_patch"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EchoService/EchoPatch", runtime.WithHTTPPathPattern("/v1/example/echo_patch"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_EchoService_EchoPatch_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_EchoService_EchoPatch_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_EchoService_EchoPatch_0)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.EchoService/EchoPatch"))
 	mux.Handle(http.MethodGet, pattern_EchoService_EchoUnauthorized_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(EchoService_EchoUnauthorized_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EchoService/EchoUnauthorized", runtime.WithHTTPPathPattern("/v1/example/echo_unauthorized"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EchoService/EchoUnauthorized", runtime.WithHTTPPathPattern("/v1/example/echo_unauthorized"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_EchoService_EchoUnauthorized_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_EchoService_EchoUnauthorized_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_EchoService_EchoUnauthorized_0)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.EchoService/EchoUnauthorized"))
 	mux.Handle(http.MethodPost, pattern_EchoService_EchoStatus_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(EchoService_EchoStatus_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EchoService/EchoStatus", runtime.WithHTTPPathPattern("/v1/example/echo_status"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EchoService/EchoStatus", runtime.WithHTTPPathPattern("/v1/example/echo_status"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_EchoService_EchoStatus_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_EchoService_EchoStatus_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_EchoService_EchoStatus_0)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.EchoService/EchoStatus"))
 	return nil
 }
 
+// handlerClient_EchoService calls the request mutators of the options given to
+// RegisterEchoServiceHandlerClientWithOptions before sending requests.
+type handlerClient_EchoService struct {
+	EchoServiceClient
+	options *runtime.HandlerOptions
+}
+
+func (c handlerClient_EchoService) Echo(ctx context.Context, in *SimpleMessage, opts ...grpc.CallOption) (*SimpleMessage, error) {
+	if err := c.options.Method(EchoService_Echo_HandlerMethod.FullMethod()).MutateRequest(ctx, in); err != nil {
+		return nil, err
+	}
+	return c.EchoServiceClient.Echo(ctx, in, opts...)
+}
+
+func (c handlerClient_EchoService) EchoBody(ctx context.Context, in *SimpleMessage, opts ...grpc.CallOption) (*SimpleMessage, error) {
+	if err := c.options.Method(EchoService_EchoBody_HandlerMethod.FullMethod()).MutateRequest(ctx, in); err != nil {
+		return nil, err
+	}
+	return c.EchoServiceClient.EchoBody(ctx, in, opts...)
+}
+
+func (c handlerClient_EchoService) EchoDelete(ctx context.Context, in *SimpleMessage, opts ...grpc.CallOption) (*SimpleMessage, error) {
+	if err := c.options.Method(EchoService_EchoDelete_HandlerMethod.FullMethod()).MutateRequest(ctx, in); err != nil {
+		return nil, err
+	}
+	return c.EchoServiceClient.EchoDelete(ctx, in, opts...)
+}
+
+func (c handlerClient_EchoService) EchoPatch(ctx context.Context, in *DynamicMessageUpdate, opts ...grpc.CallOption) (*DynamicMessageUpdate, error) {
+	if err := c.options.Method(EchoService_EchoPatch_HandlerMethod.FullMethod()).MutateRequest(ctx, in); err != nil {
+		return nil, err
+	}
+	return c.EchoServiceClient.EchoPatch(ctx, in, opts...)
+}
+
+func (c handlerClient_EchoService) EchoUnauthorized(ctx context.Context, in *SimpleMessage, opts ...grpc.CallOption) (*SimpleMessage, error) {
+	if err := c.options.Method(EchoService_EchoUnauthorized_HandlerMethod.FullMethod()).MutateRequest(ctx, in); err != nil {
+		return nil, err
+	}
+	return c.EchoServiceClient.EchoUnauthorized(ctx, in, opts...)
+}
+
+func (c handlerClient_EchoService) EchoStatus(ctx context.Context, in *StatusCheckRequest, opts ...grpc.CallOption) (*StatusCheckResponse, error) {
+	if err := c.options.Method(EchoService_EchoStatus_HandlerMethod.FullMethod()).MutateRequest(ctx, in); err != nil {
+		return nil, err
+	}
+	return c.EchoServiceClient.EchoStatus(ctx, in, opts...)
+}
+
+// The HandlerMethod variables of the methods of EchoService identify them in the options given to
+// RegisterEchoServiceHandlerClientWithOptions.
+var (
+	EchoService_Echo_HandlerMethod             = runtime.NewHandlerMethod[*SimpleMessage, *SimpleMessage]("/grpc.gateway.examples.internal.proto.examplepb.EchoService/Echo")
+	EchoService_EchoBody_HandlerMethod         = runtime.NewHandlerMethod[*SimpleMessage, *SimpleMessage]("/grpc.gateway.examples.internal.proto.examplepb.EchoService/EchoBody")
+	EchoService_EchoDelete_HandlerMethod       = runtime.NewHandlerMethod[*SimpleMessage, *SimpleMessage]("/grpc.gateway.examples.internal.proto.examplepb.EchoService/EchoDelete")
+	EchoService_EchoPatch_HandlerMethod        = runtime.NewHandlerMethod[*DynamicMessageUpdate, *DynamicMessageUpdate]("/grpc.gateway.examples.internal.proto.examplepb.EchoService/EchoPatch")
+	EchoService_EchoUnauthorized_HandlerMethod = runtime.NewHandlerMethod[*SimpleMessage, *SimpleMessage]("/grpc.gateway.examples.internal.proto.examplepb.EchoService/EchoUnauthorized")
+	EchoService_EchoStatus_HandlerMethod       = runtime.NewHandlerMethod[*StatusCheckRequest, *StatusCheckResponse]("/grpc.gateway.examples.internal.proto.examplepb.EchoService/EchoStatus")
+)
+
 var (
 	pattern_EchoService_Echo_0             = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3}, []string{"v1", "example", "echo", "id"}, ""))
 	pattern_EchoService_Echo_1             = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 1, 0, 4, 1, 5, 4}, []string{"v1", "example", "echo", "id", "num"}, ""))
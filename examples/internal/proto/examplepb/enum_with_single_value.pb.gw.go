@@ -74,11 +74,12 @@ func RegisterEnumWithSingleValueServiceHandlerServer(ctx context.Context, mux *r
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EnumWithSingleValueService/Echo", runtime.WithHTTPPathPattern("/v1/example/enum-with-single-value/echo"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EnumWithSingleValueService/Echo", runtime.WithHTTPPathPattern("/v1/example/enum-with-single-value/echo"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_EnumWithSingleValueService_Echo_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -87,7 +88,7 @@ func RegisterEnumWithSingleValueServiceHandlerServer(ctx context.Context, mux *r
 			return
 		}
 		forward_EnumWithSingleValueService_Echo_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.EnumWithSingleValueService/Echo"))
 
 	return nil
 }
@@ -128,26 +129,62 @@ func RegisterEnumWithSingleValueServiceHandler(ctx context.Context, mux *runtime
 // doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
 // "EnumWithSingleValueServiceClient" to call the correct interceptors. This client ignores the HTTP middlewares.
 func RegisterEnumWithSingleValueServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client EnumWithSingleValueServiceClient) error {
+	return RegisterEnumWithSingleValueServiceHandlerClientWithOptions(ctx, mux, client)
+}
+
+// RegisterEnumWithSingleValueServiceHandlerClientWithOptions is same as RegisterEnumWithSingleValueServiceHandlerClient but
+// customizes the handlers of the methods of EnumWithSingleValueService with "opts", whose methods are identified by the
+// EnumWithSingleValueService_{Method}_HandlerMethod variables.
+func RegisterEnumWithSingleValueServiceHandlerClientWithOptions(ctx context.Context, mux *runtime.ServeMux, client EnumWithSingleValueServiceClient, opts ...runtime.HandlerOption) error {
+	options := runtime.NewHandlerOptions(opts...)
+	client = handlerClient_EnumWithSingleValueService{EnumWithSingleValueServiceClient: client, options: options}
 	mux.Handle(http.MethodPost, pattern_EnumWithSingleValueService_Echo_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(EnumWithSingleValueService_Echo_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EnumWithSingleValueService/Echo", runtime.WithHTTPPathPattern("/v1/example/enum-with-single-value/echo"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.EnumWithSingleValueService/Echo", runtime.WithHTTPPathPattern("/v1/example/enum-with-single-value/echo"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_EnumWithSingleValueService_Echo_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_EnumWithSingleValueService_Echo_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_EnumWithSingleValueService_Echo_0)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.EnumWithSingleValueService/Echo"))
 	return nil
 }
 
+// handlerClient_EnumWithSingleValueService calls the request mutators of the options given to
+// RegisterEnumWithSingleValueServiceHandlerClientWithOptions before sending requests.
+type handlerClient_EnumWithSingleValueService struct {
+	EnumWithSingleValueServiceClient
+	options *runtime.HandlerOptions
+}
+
+func (c handlerClient_EnumWithSingleValueService) Echo(ctx context.Context, in *EnumWithSingleValueServiceEchoRequest, opts ...grpc.CallOption) (*EnumWithSingleValueServiceEchoResponse, error) {
+	if err := c.options.Method(EnumWithSingleValueService_Echo_HandlerMethod.FullMethod()).MutateRequest(ctx, in); err != nil {
+		return nil, err
+	}
+	return c.EnumWithSingleValueServiceClient.Echo(ctx, in, opts...)
+}
+
+// The HandlerMethod variables of the methods of EnumWithSingleValueService identify them in the options given to
+// RegisterEnumWithSingleValueServiceHandlerClientWithOptions.
+var (
+	EnumWithSingleValueService_Echo_HandlerMethod = runtime.NewHandlerMethod[*EnumWithSingleValueServiceEchoRequest, *EnumWithSingleValueServiceEchoResponse]("/grpc.gateway.examples.internal.proto.examplepb.EnumWithSingleValueService/Echo")
+)
+
 var (
 	pattern_EnumWithSingleValueService_Echo_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"v1", "example", "enum-with-single-value", "echo"}, ""))
 )
@@ -82,11 +82,12 @@ func RegisterProto3FieldSemanticsServiceHandlerServer(ctx context.Context, mux *
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.Proto3FieldSemanticsService/GetFilter", runtime.WithHTTPPathPattern("/v1/filter"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.Proto3FieldSemanticsService/GetFilter", runtime.WithHTTPPathPattern("/v1/filter"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_Proto3FieldSemanticsService_GetFilter_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -95,7 +96,7 @@ func RegisterProto3FieldSemanticsServiceHandlerServer(ctx context.Context, mux *
 			return
 		}
 		forward_Proto3FieldSemanticsService_GetFilter_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.Proto3FieldSemanticsService/GetFilter"))
 
 	return nil
 }
@@ -136,26 +137,62 @@ func RegisterProto3FieldSemanticsServiceHandler(ctx context.Context, mux *runtim
 // doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
 // "Proto3FieldSemanticsServiceClient" to call the correct interceptors. This client ignores the HTTP middlewares.
 func RegisterProto3FieldSemanticsServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client Proto3FieldSemanticsServiceClient) error {
+	return RegisterProto3FieldSemanticsServiceHandlerClientWithOptions(ctx, mux, client)
+}
+
+// RegisterProto3FieldSemanticsServiceHandlerClientWithOptions is same as RegisterProto3FieldSemanticsServiceHandlerClient but
+// customizes the handlers of the methods of Proto3FieldSemanticsService with "opts", whose methods are identified by the
+// Proto3FieldSemanticsService_{Method}_HandlerMethod variables.
+func RegisterProto3FieldSemanticsServiceHandlerClientWithOptions(ctx context.Context, mux *runtime.ServeMux, client Proto3FieldSemanticsServiceClient, opts ...runtime.HandlerOption) error {
+	options := runtime.NewHandlerOptions(opts...)
+	client = handlerClient_Proto3FieldSemanticsService{Proto3FieldSemanticsServiceClient: client, options: options}
 	mux.Handle(http.MethodGet, pattern_Proto3FieldSemanticsService_GetFilter_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(Proto3FieldSemanticsService_GetFilter_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.Proto3FieldSemanticsService/GetFilter", runtime.WithHTTPPathPattern("/v1/filter"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.Proto3FieldSemanticsService/GetFilter", runtime.WithHTTPPathPattern("/v1/filter"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_Proto3FieldSemanticsService_GetFilter_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_Proto3FieldSemanticsService_GetFilter_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_Proto3FieldSemanticsService_GetFilter_0)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.Proto3FieldSemanticsService/GetFilter"))
 	return nil
 }
 
+// handlerClient_Proto3FieldSemanticsService calls the request mutators of the options given to
+// RegisterProto3FieldSemanticsServiceHandlerClientWithOptions before sending requests.
+type handlerClient_Proto3FieldSemanticsService struct {
+	Proto3FieldSemanticsServiceClient
+	options *runtime.HandlerOptions
+}
+
+func (c handlerClient_Proto3FieldSemanticsService) GetFilter(ctx context.Context, in *GetFilterRequest, opts ...grpc.CallOption) (*GetFilterResponse, error) {
+	if err := c.options.Method(Proto3FieldSemanticsService_GetFilter_HandlerMethod.FullMethod()).MutateRequest(ctx, in); err != nil {
+		return nil, err
+	}
+	return c.Proto3FieldSemanticsServiceClient.GetFilter(ctx, in, opts...)
+}
+
+// The HandlerMethod variables of the methods of Proto3FieldSemanticsService identify them in the options given to
+// RegisterProto3FieldSemanticsServiceHandlerClientWithOptions.
+var (
+	Proto3FieldSemanticsService_GetFilter_HandlerMethod = runtime.NewHandlerMethod[*GetFilterRequest, *GetFilterResponse]("/grpc.gateway.examples.internal.proto.examplepb.Proto3FieldSemanticsService/GetFilter")
+)
+
 var (
 	pattern_Proto3FieldSemanticsService_GetFilter_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"v1", "filter"}, ""))
 )
@@ -86,11 +86,12 @@ func RegisterUseAllOfForRefsServiceHandlerServer(ctx context.Context, mux *runti
 		var stream runtime.ServerTransportStream
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.UseAllOfForRefsService/GetExample", runtime.WithHTTPPathPattern("/v1/example/{example_id}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.UseAllOfForRefsService/GetExample", runtime.WithHTTPPathPattern("/v1/example/{example_id}"))
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_UseAllOfForRefsService_GetExample_0(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -99,7 +100,7 @@ func RegisterUseAllOfForRefsServiceHandlerServer(ctx context.Context, mux *runti
 			return
 		}
 		forward_UseAllOfForRefsService_GetExample_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.UseAllOfForRefsService/GetExample"))
 
 	return nil
 }
@@ -140,26 +141,62 @@ func RegisterUseAllOfForRefsServiceHandler(ctx context.Context, mux *runtime.Ser
 // doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
 // "UseAllOfForRefsServiceClient" to call the correct interceptors. This client ignores the HTTP middlewares.
 func RegisterUseAllOfForRefsServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client UseAllOfForRefsServiceClient) error {
+	return RegisterUseAllOfForRefsServiceHandlerClientWithOptions(ctx, mux, client)
+}
+
+// RegisterUseAllOfForRefsServiceHandlerClientWithOptions is same as RegisterUseAllOfForRefsServiceHandlerClient but
+// customizes the handlers of the methods of UseAllOfForRefsService with "opts", whose methods are identified by the
+// UseAllOfForRefsService_{Method}_HandlerMethod variables.
+func RegisterUseAllOfForRefsServiceHandlerClientWithOptions(ctx context.Context, mux *runtime.ServeMux, client UseAllOfForRefsServiceClient, opts ...runtime.HandlerOption) error {
+	options := runtime.NewHandlerOptions(opts...)
+	client = handlerClient_UseAllOfForRefsService{UseAllOfForRefsServiceClient: client, options: options}
 	mux.Handle(http.MethodGet, pattern_UseAllOfForRefsService_GetExample_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
+		methodOptions := options.Method(UseAllOfForRefsService_GetExample_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.UseAllOfForRefsService/GetExample", runtime.WithHTTPPathPattern("/v1/example/{example_id}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/grpc.gateway.examples.internal.proto.examplepb.UseAllOfForRefsService/GetExample", runtime.WithHTTPPathPattern("/v1/example/{example_id}"))
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_UseAllOfForRefsService_GetExample_0(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
-		forward_UseAllOfForRefsService_GetExample_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
-	})
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		methodOptions.ForwardResponseMessage(forward_UseAllOfForRefsService_GetExample_0)(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod("/grpc.gateway.examples.internal.proto.examplepb.UseAllOfForRefsService/GetExample"))
 	return nil
 }
 
+// handlerClient_UseAllOfForRefsService calls the request mutators of the options given to
+// RegisterUseAllOfForRefsServiceHandlerClientWithOptions before sending requests.
+type handlerClient_UseAllOfForRefsService struct {
+	UseAllOfForRefsServiceClient
+	options *runtime.HandlerOptions
+}
+
+func (c handlerClient_UseAllOfForRefsService) GetExample(ctx context.Context, in *GetExampleRequest, opts ...grpc.CallOption) (*GetExampleResponse, error) {
+	if err := c.options.Method(UseAllOfForRefsService_GetExample_HandlerMethod.FullMethod()).MutateRequest(ctx, in); err != nil {
+		return nil, err
+	}
+	return c.UseAllOfForRefsServiceClient.GetExample(ctx, in, opts...)
+}
+
+// The HandlerMethod variables of the methods of UseAllOfForRefsService identify them in the options given to
+// RegisterUseAllOfForRefsServiceHandlerClientWithOptions.
+var (
+	UseAllOfForRefsService_GetExample_HandlerMethod = runtime.NewHandlerMethod[*GetExampleRequest, *GetExampleResponse]("/grpc.gateway.examples.internal.proto.examplepb.UseAllOfForRefsService/GetExample")
+)
+
 var (
 	pattern_UseAllOfForRefsService_GetExample_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 1, 0, 4, 1, 5, 2}, []string{"v1", "example", "example_id"}, ""))
 )
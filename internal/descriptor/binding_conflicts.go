@@ -0,0 +1,73 @@
+package descriptor
+
+import (
+	"fmt"
+	"strings"
+)
+
+// BindingConflict is a pair of bindings with the same HTTP method and verb whose
+// path templates match common paths, so that which handler serves a request
+// depends on the order the handlers are registered in.
+type BindingConflict struct {
+	// First and Second are the conflicting bindings, in the order they are
+	// declared in.
+	First, Second *Binding
+	// FirstCoversSecond is set if the template of First matches all the paths
+	// the template of Second matches, so that First shadows Second if its
+	// handler is tried first.
+	FirstCoversSecond bool
+	// SecondCoversFirst is set if the template of Second matches all the paths
+	// the template of First matches.
+	SecondCoversFirst bool
+}
+
+func (c BindingConflict) String() string {
+	var relation string
+	switch {
+	case c.FirstCoversSecond && c.SecondCoversFirst:
+		relation = "matches the same paths as"
+	case c.FirstCoversSecond:
+		relation = "shadows"
+	case c.SecondCoversFirst:
+		relation = "is shadowed by"
+	default:
+		relation = "overlaps with"
+	}
+	return fmt.Sprintf("%s: %s %s %s at %s", c.First.Position(""), describeBinding(c.First), relation, describeBinding(c.Second), c.Second.Position(""))
+}
+
+// describeBinding returns the HTTP method, the path template and the method of
+// b, e.g. "GET /v1/{name=**} (example.Service.Get)".
+func describeBinding(b *Binding) string {
+	return fmt.Sprintf("%s %s (%s)", b.HTTPMethod, b.PathTmpl.Template, strings.TrimPrefix(b.Method.FQMN(), "."))
+}
+
+// FindBindingConflicts returns the conflicts between the bindings of the
+// methods of all the services of files, including the bindings of a single
+// method.
+func FindBindingConflicts(files []*File) []BindingConflict {
+	var bindings []*Binding
+	for _, f := range files {
+		for _, svc := range f.Services {
+			for _, m := range svc.Methods {
+				bindings = append(bindings, m.Bindings...)
+			}
+		}
+	}
+
+	var conflicts []BindingConflict
+	for i, first := range bindings {
+		for _, second := range bindings[i+1:] {
+			if first.HTTPMethod != second.HTTPMethod || !first.PathTmpl.Overlaps(second.PathTmpl) {
+				continue
+			}
+			conflicts = append(conflicts, BindingConflict{
+				First:             first,
+				Second:            second,
+				FirstCoversSecond: first.PathTmpl.Covers(second.PathTmpl),
+				SecondCoversFirst: second.PathTmpl.Covers(first.PathTmpl),
+			})
+		}
+	}
+	return conflicts
+}
@@ -0,0 +1,109 @@
+package descriptor
+
+import (
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+func TestFindBindingConflicts(t *testing.T) {
+	var files []*descriptorpb.FileDescriptorProto
+	for _, src := range []string{`
+		name: "example/users.proto"
+		package: "example"
+		options < go_package: "github.com/example" >
+		message_type <
+			name: "UserRequest"
+			field < name: "id" number: 1 label: LABEL_OPTIONAL type: TYPE_STRING >
+		>
+		service <
+			name: "UserService"
+			method <
+				name: "GetUser"
+				input_type: ".example.UserRequest"
+				output_type: ".example.UserRequest"
+				options <
+					[google.api.http] <
+						get: "/v1/users/{id}"
+						additional_bindings < post: "/v1/users/{id}" body: "*" >
+					>
+				>
+			>
+			method <
+				name: "GetGroup"
+				input_type: ".example.UserRequest"
+				output_type: ".example.UserRequest"
+				options < [google.api.http] < get: "/v1/{id=*/groups}" > >
+			>
+		>
+		source_code_info <
+			location < path: [6, 0, 2, 0, 4, 72295728] span: [11, 4, 14, 6] >
+			location < path: [6, 0, 2, 1, 4, 72295728] span: [18, 4, 42] >
+		>
+	`, `
+		name: "example/resources.proto"
+		package: "example"
+		dependency: "example/users.proto"
+		options < go_package: "github.com/example" >
+		message_type <
+			name: "ResourceRequest"
+			field < name: "name" number: 1 label: LABEL_OPTIONAL type: TYPE_STRING >
+		>
+		service <
+			name: "ResourceService"
+			method <
+				name: "GetResource"
+				input_type: ".example.ResourceRequest"
+				output_type: ".example.ResourceRequest"
+				options < [google.api.http] < get: "/v1/{name=**}" > >
+			>
+			method <
+				name: "ArchiveResource"
+				input_type: ".example.ResourceRequest"
+				output_type: ".example.ResourceRequest"
+				options < [google.api.http] < get: "/v1/{name=**}:archive" > >
+			>
+		>
+		source_code_info <
+			location < path: [6, 0, 2, 0, 4, 72295728] span: [9, 4, 38] >
+		>
+	`} {
+		var fd descriptorpb.FileDescriptorProto
+		if err := prototext.Unmarshal([]byte(src), &fd); err != nil {
+			t.Fatalf("prototext.Unmarshal(%s, &fd) failed with %v; want success", src, err)
+		}
+		files = append(files, &fd)
+	}
+
+	reg := NewRegistry()
+	if err := reg.Load(&pluginpb.CodeGeneratorRequest{
+		ProtoFile:      files,
+		FileToGenerate: []string{"example/users.proto", "example/resources.proto"},
+	}); err != nil {
+		t.Fatalf("reg.Load(...) failed with %v; want success", err)
+	}
+	var targets []*File
+	for _, name := range []string{"example/users.proto", "example/resources.proto"} {
+		f, err := reg.LookupFile(name)
+		if err != nil {
+			t.Fatalf("reg.LookupFile(%q) failed with %v; want success", name, err)
+		}
+		targets = append(targets, f)
+	}
+
+	var got []string
+	for _, c := range FindBindingConflicts(targets) {
+		got = append(got, c.String())
+	}
+	want := []string{
+		"example/users.proto:12:5: GET /v1/users/{id} (example.UserService.GetUser) overlaps with GET /v1/{id=*/groups} (example.UserService.GetGroup) at example/users.proto:19:5",
+		"example/users.proto:12:5: GET /v1/users/{id} (example.UserService.GetUser) is shadowed by GET /v1/{name=**} (example.ResourceService.GetResource) at example/resources.proto:10:5",
+		"example/users.proto:19:5: GET /v1/{id=*/groups} (example.UserService.GetGroup) is shadowed by GET /v1/{name=**} (example.ResourceService.GetResource) at example/resources.proto:10:5",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("FindBindingConflicts(...) = \n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
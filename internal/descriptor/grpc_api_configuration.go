@@ -2,66 +2,353 @@ package descriptor
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 
-	"github.com/grpc-ecosystem/grpc-gateway/v2/internal/descriptor/apiconfig"
 	"go.yaml.in/yaml/v3"
+	"google.golang.org/genproto/googleapis/api/serviceconfig"
+	"google.golang.org/grpc/grpclog"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
-func loadGrpcAPIServiceFromYAML(yamlFileContents []byte, yamlSourceLogName string) (*apiconfig.GrpcAPIService, error) {
-	var yamlContents interface{}
-	if err := yaml.Unmarshal(yamlFileContents, &yamlContents); err != nil {
+// grpcAPIService is a google.api.Service loaded from a gRPC API Configuration
+// file, along with the positions of its values in the file.
+type grpcAPIService struct {
+	*serviceconfig.Service
+
+	sourceLogName string
+	// positions maps the paths of the values of the file to their positions.
+	// Paths are made of the proto names of the fields and of the indexes of
+	// list elements, e.g. "http.rules[0].selector".
+	positions map[string]yamlPosition
+}
+
+// yamlPosition is the position of a value in a YAML file.
+type yamlPosition struct {
+	line, column int
+}
+
+// errorf returns an error located at the value of path in the file.
+func (s *grpcAPIService) errorf(path string, format string, args ...interface{}) error {
+	return fmt.Errorf("%s: %s", s.locate(path), fmt.Sprintf(format, args...))
+}
+
+// locate returns the position of the value of path in the file, e.g.
+// "service.yaml:12:5", or of its closest ancestor with a known position.
+func (s *grpcAPIService) locate(path string) string {
+	for {
+		if pos, ok := s.positions[path]; ok {
+			return fmt.Sprintf("%s:%d:%d", s.sourceLogName, pos.line, pos.column)
+		}
+		i := strings.LastIndexAny(path, ".[")
+		if i < 0 {
+			return s.sourceLogName
+		}
+		path = path[:i]
+	}
+}
+
+// locator returns a sourceLocator of the values at paths relative to prefix
+// in the file.
+func (s *grpcAPIService) locator(prefix string) sourceLocator {
+	return sourceLocator(s.locate).at(prefix)
+}
+
+func loadGrpcAPIServiceFromYAML(yamlFileContents []byte, yamlSourceLogName string) (*grpcAPIService, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(yamlFileContents, &doc); err != nil {
 		return nil, fmt.Errorf("failed to parse gRPC API Configuration from YAML in %q: %w", yamlSourceLogName, err)
 	}
 
+	v := serviceYAMLValidator{
+		sourceLogName: yamlSourceLogName,
+		positions:     make(map[string]yamlPosition),
+	}
+	if len(doc.Content) > 0 {
+		v.message(doc.Content[0], (&serviceconfig.Service{}).ProtoReflect().Descriptor(), "")
+	}
+	if len(v.errs) > 0 {
+		return nil, fmt.Errorf("invalid gRPC API Configuration in %q:\n%w", yamlSourceLogName, errors.Join(v.errs...))
+	}
+
+	var yamlContents interface{}
+	if err := doc.Decode(&yamlContents); err != nil {
+		return nil, fmt.Errorf("failed to parse gRPC API Configuration from YAML in %q: %w", yamlSourceLogName, err)
+	}
 	jsonContents, err := json.Marshal(yamlContents)
 	if err != nil {
 		return nil, err
 	}
 
-	// As our GrpcAPIService is incomplete, accept unknown fields.
+	// Unknown fields were reported by the validator.
 	unmarshaler := protojson.UnmarshalOptions{
 		DiscardUnknown: true,
 	}
 
-	serviceConfiguration := apiconfig.GrpcAPIService{}
+	serviceConfiguration := serviceconfig.Service{}
 	if err := unmarshaler.Unmarshal(jsonContents, &serviceConfiguration); err != nil {
 		return nil, fmt.Errorf("failed to parse gRPC API Configuration from YAML in %q: %w", yamlSourceLogName, err)
 	}
 
-	return &serviceConfiguration, nil
+	return &grpcAPIService{
+		Service:       &serviceConfiguration,
+		sourceLogName: yamlSourceLogName,
+		positions:     v.positions,
+	}, nil
+}
+
+// serviceYAMLValidator checks that a YAML document matches the JSON mapping of
+// google.api.Service, and records the positions of its values.
+type serviceYAMLValidator struct {
+	sourceLogName string
+	positions     map[string]yamlPosition
+	errs          []error
+}
+
+func (v *serviceYAMLValidator) errorf(n *yaml.Node, format string, args ...interface{}) {
+	v.errs = append(v.errs, fmt.Errorf("%s:%d:%d: %s", v.sourceLogName, n.Line, n.Column, fmt.Sprintf(format, args...)))
+}
+
+func (v *serviceYAMLValidator) message(n *yaml.Node, md protoreflect.MessageDescriptor, path string) {
+	n = resolveYAMLAlias(n)
+	if n.Kind != yaml.MappingNode {
+		v.errorf(n, "%s must be a mapping of the fields of %s", describeYAMLPath(path), md.FullName())
+		return
+	}
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		key, value := n.Content[i], n.Content[i+1]
+		fd := md.Fields().ByTextName(key.Value)
+		if fd == nil {
+			fd = md.Fields().ByJSONName(key.Value)
+		}
+		if fd == nil {
+			if path == "" && key.Value == "type" {
+				if value.Value != "google.api.Service" {
+					v.errorf(value, "type must be \"google.api.Service\", got %q", value.Value)
+				}
+				continue
+			}
+			grpclog.Warningf("%s:%d:%d: unknown field %q of %s is ignored", v.sourceLogName, key.Line, key.Column, key.Value, md.FullName())
+			continue
+		}
+		fieldPath := string(fd.Name())
+		if path != "" {
+			fieldPath = path + "." + fieldPath
+		}
+		v.positions[fieldPath] = yamlPosition{line: key.Line, column: key.Column}
+		v.field(value, fd, fieldPath)
+	}
+}
+
+func (v *serviceYAMLValidator) field(n *yaml.Node, fd protoreflect.FieldDescriptor, path string) {
+	n = resolveYAMLAlias(n)
+	switch {
+	case isYAMLNull(n):
+	case fd.IsMap():
+		if n.Kind != yaml.MappingNode {
+			v.errorf(n, "%s must be a mapping", describeYAMLPath(path))
+			return
+		}
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			key, value := n.Content[i], n.Content[i+1]
+			valuePath := fmt.Sprintf("%s[%s]", path, key.Value)
+			v.positions[valuePath] = yamlPosition{line: key.Line, column: key.Column}
+			v.singular(value, fd.MapValue(), valuePath)
+		}
+	case fd.IsList():
+		if n.Kind != yaml.SequenceNode {
+			v.errorf(n, "%s must be a list", describeYAMLPath(path))
+			return
+		}
+		for i, elem := range n.Content {
+			elemPath := fmt.Sprintf("%s[%d]", path, i)
+			v.positions[elemPath] = yamlPosition{line: elem.Line, column: elem.Column}
+			v.singular(elem, fd, elemPath)
+		}
+	default:
+		v.singular(n, fd, path)
+	}
+}
+
+func (v *serviceYAMLValidator) singular(n *yaml.Node, fd protoreflect.FieldDescriptor, path string) {
+	n = resolveYAMLAlias(n)
+	if isYAMLNull(n) {
+		return
+	}
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		md := fd.Message()
+		if md.FullName().Parent() == "google.protobuf" {
+			switch md.Name() {
+			case "BoolValue", "BytesValue", "DoubleValue", "FloatValue", "Int32Value", "Int64Value", "StringValue", "UInt32Value", "UInt64Value":
+				// Wrappers are mapped to their value.
+				v.singular(n, md.Fields().ByName("value"), path)
+				return
+			case "Any", "Duration", "FieldMask", "ListValue", "Struct", "Timestamp", "Value":
+				// Left to protojson, as their JSON mappings are not the ones
+				// of their fields.
+				return
+			}
+		}
+		v.message(n, md, path)
+	case protoreflect.EnumKind:
+		if n.Kind != yaml.ScalarNode {
+			v.errorf(n, "%s must be a value of %s", describeYAMLPath(path), fd.Enum().FullName())
+			return
+		}
+		if n.Tag == "!!int" || fd.Enum().Values().ByName(protoreflect.Name(n.Value)) != nil {
+			return
+		}
+		v.errorf(n, "%s has an invalid value %q of %s", describeYAMLPath(path), n.Value, fd.Enum().FullName())
+	case protoreflect.BoolKind:
+		if n.Kind != yaml.ScalarNode || n.Tag != "!!bool" {
+			v.errorf(n, "%s must be true or false", describeYAMLPath(path))
+		}
+	case protoreflect.StringKind, protoreflect.BytesKind:
+		if n.Kind != yaml.ScalarNode || n.Tag != "!!str" {
+			v.errorf(n, "%s must be a string", describeYAMLPath(path))
+		}
+	default:
+		if n.Kind != yaml.ScalarNode {
+			v.errorf(n, "%s must be a number", describeYAMLPath(path))
+			return
+		}
+		if n.Tag == "!!int" || n.Tag == "!!float" {
+			return
+		}
+		if _, err := strconv.ParseFloat(n.Value, 64); err != nil {
+			v.errorf(n, "%s must be a number", describeYAMLPath(path))
+		}
+	}
+}
+
+func resolveYAMLAlias(n *yaml.Node) *yaml.Node {
+	for n.Kind == yaml.AliasNode && n.Alias != nil {
+		n = n.Alias
+	}
+	return n
+}
+
+func isYAMLNull(n *yaml.Node) bool {
+	return n.Kind == yaml.ScalarNode && n.Tag == "!!null"
+}
+
+func describeYAMLPath(path string) string {
+	if path == "" {
+		return "the configuration"
+	}
+	return strconv.Quote(path)
 }
 
-func registerHTTPRulesFromGrpcAPIService(registry *Registry, service *apiconfig.GrpcAPIService, sourceLogName string) error {
-	if service.Http == nil {
+func registerHTTPRulesFromGrpcAPIService(registry *Registry, service *grpcAPIService) error {
+	if service.GetHttp() == nil {
 		// Nothing to do
 		return nil
 	}
 
-	for _, rule := range service.Http.GetRules() {
+	for i, rule := range service.GetHttp().GetRules() {
 		selector := "." + strings.Trim(rule.GetSelector(), " ")
 		if strings.ContainsAny(selector, "*, ") {
-			return fmt.Errorf("selector %q in %v must specify a single service method without wildcards", rule.GetSelector(), sourceLogName)
+			return service.errorf(fmt.Sprintf("http.rules[%d].selector", i), "selector %q must specify a single service method without wildcards", rule.GetSelector())
 		}
 
 		registry.AddExternalHTTPRule(selector, rule)
+		registry.externalHTTPRuleLocators[rule] = service.locator(fmt.Sprintf("http.rules[%d]", i))
+	}
+
+	return nil
+}
+
+func registerBackendRulesFromGrpcAPIService(registry *Registry, service *grpcAPIService) error {
+	for i, rule := range service.GetBackend().GetRules() {
+		selector := strings.Trim(rule.GetSelector(), " ")
+		if !isValidRuleSelector(selector) {
+			return service.errorf(fmt.Sprintf("backend.rules[%d].selector", i), "selector %q must be \"*\", a fully qualified method name or a name ending in \".*\"", rule.GetSelector())
+		}
+		deadline := rule.GetDeadline()
+		if deadline < 0 || math.IsNaN(deadline) || math.IsInf(deadline, 0) {
+			return service.errorf(fmt.Sprintf("backend.rules[%d].deadline", i), "deadline %v of backend rule %q must be a non-negative number of seconds", deadline, rule.GetSelector())
+		}
+		if deadline == 0 {
+			continue
+		}
+
+		registry.AddExternalBackendDeadline(selector, time.Duration(math.Round(deadline*float64(time.Second))))
 	}
 
 	return nil
 }
 
+// validateRuleSelectorsOfGrpcAPIService checks the selectors of the
+// documentation, authentication and system parameter rules, which the
+// registry looks up when the generators ask for them.
+func validateRuleSelectorsOfGrpcAPIService(service *grpcAPIService) error {
+	var errs []error
+	check := func(section string, i int, selector string) {
+		if !isValidRuleSelector(strings.Trim(selector, " ")) {
+			errs = append(errs, service.errorf(fmt.Sprintf("%s[%d].selector", section, i), "selector %q must be \"*\", a fully qualified name or a name ending in \".*\"", selector))
+		}
+	}
+	for i, rule := range service.GetDocumentation().GetRules() {
+		check("documentation.rules", i, rule.GetSelector())
+	}
+	for i, rule := range service.GetAuthentication().GetRules() {
+		check("authentication.rules", i, rule.GetSelector())
+	}
+	for i, rule := range service.GetSystemParameters().GetRules() {
+		check("system_parameters.rules", i, rule.GetSelector())
+	}
+	for i, rule := range service.GetUsage().GetRules() {
+		check("usage.rules", i, rule.GetSelector())
+	}
+
+	providers := make(map[string]bool)
+	for i, provider := range service.GetAuthentication().GetProviders() {
+		if provider.GetId() == "" {
+			errs = append(errs, service.errorf(fmt.Sprintf("authentication.providers[%d]", i), "authentication provider must have an id"))
+		}
+		providers[provider.GetId()] = true
+	}
+	for i, rule := range service.GetAuthentication().GetRules() {
+		for j, req := range rule.GetRequirements() {
+			if !providers[req.GetProviderId()] {
+				errs = append(errs, service.errorf(fmt.Sprintf("authentication.rules[%d].requirements[%d].provider_id", i, j), "unknown authentication provider %q", req.GetProviderId()))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// isValidRuleSelector reports whether selector is a valid selector of the
+// rules of a google.api.Service other than HTTP rules. Unlike HTTP rule
+// selectors, they may use a trailing wildcard to match every element of a
+// service or package.
+func isValidRuleSelector(selector string) bool {
+	if selector == "*" {
+		return true
+	}
+	selector = strings.TrimSuffix(selector, ".*")
+	return selector != "" && !strings.ContainsAny(selector, "*, ")
+}
+
 // LoadGrpcAPIServiceFromYAML loads a gRPC API Configuration from the given YAML file
 // and registers the HttpRule descriptions contained in it as externalHTTPRules in
-// the given registry. This must be done before loading the proto file.
+// the given registry. The deadlines of backend rules are registered as well, so
+// that the gateway generator can apply them as default timeouts, and the whole
+// google.api.Service is kept for the generators, see GetServiceConfig.
+// This must be done before loading the proto file.
 //
 // You can learn more about gRPC API Service descriptions from Google's documentation
 // at https://cloud.google.com/endpoints/docs/grpc/grpc-service-config
 //
-// Note that for the purposes of the gateway generator we only consider a subset of all
-// available features google supports in their service descriptions.
+// Errors in the file are reported with the line and column of the YAML key
+// they are about. Unknown keys are ignored with a warning.
 func (r *Registry) LoadGrpcAPIServiceFromYAML(yamlFile string) error {
 	yamlFileContents, err := os.ReadFile(yamlFile)
 	if err != nil {
@@ -73,5 +360,17 @@ func (r *Registry) LoadGrpcAPIServiceFromYAML(yamlFile string) error {
 		return err
 	}
 
-	return registerHTTPRulesFromGrpcAPIService(r, service, yamlFile)
+	if err := registerHTTPRulesFromGrpcAPIService(r, service); err != nil {
+		return err
+	}
+
+	if err := registerBackendRulesFromGrpcAPIService(r, service); err != nil {
+		return err
+	}
+
+	if err := validateRuleSelectorsOfGrpcAPIService(service); err != nil {
+		return err
+	}
+	r.serviceConfig = service.Service
+	return nil
 }
@@ -3,17 +3,16 @@ package descriptor
 import (
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestLoadGrpcAPIServiceFromYAMLInvalidType(t *testing.T) {
-	// Ideally this would fail but for now this test documents that it doesn't
-	service, err := loadGrpcAPIServiceFromYAML([]byte(`type: not.the.right.type`), "invalidtype")
-	if err != nil {
-		t.Fatal(err)
+	_, err := loadGrpcAPIServiceFromYAML([]byte(`type: not.the.right.type`), "invalidtype")
+	if err == nil {
+		t.Fatal("loadGrpcAPIServiceFromYAML() succeeded; want an error")
 	}
-
-	if service == nil {
-		t.Fatal("No service returned")
+	if want := `invalidtype:1:7: type must be "google.api.Service"`; !strings.Contains(err.Error(), want) {
+		t.Errorf("loadGrpcAPIServiceFromYAML() failed with %v; want an error containing %q", err, want)
 	}
 }
 
@@ -186,3 +185,273 @@ http:
 		t.Errorf("first.selector has unexpected delete '%v'", first.GetPost())
 	}
 }
+
+func TestRegisterBackendRulesFromGrpcAPIService(t *testing.T) {
+	service, err := loadGrpcAPIServiceFromYAML([]byte(`
+type: google.api.Service
+config_version: 3
+
+backend:
+ rules:
+ - selector: "*"
+   deadline: 60
+ - selector: grpctest.YourService.*
+   deadline: 10
+ - selector: grpctest.YourService.Echo
+   deadline: 0.25
+ - selector: grpctest.YourService.Ignored
+   deadline: 0
+`), "backend")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reg := NewRegistry()
+	if err := registerBackendRulesFromGrpcAPIService(reg, service); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, spec := range []struct {
+		method string
+		want   time.Duration
+	}{
+		{method: ".grpctest.YourService.Echo", want: 250 * time.Millisecond},
+		{method: ".grpctest.YourService.Ignored", want: 10 * time.Second},
+		{method: ".grpctest.YourService.Other", want: 10 * time.Second},
+		{method: ".grpctest.OtherService.Echo", want: 60 * time.Second},
+	} {
+		got, ok := reg.LookupExternalBackendDeadline(spec.method)
+		if !ok {
+			t.Errorf("reg.LookupExternalBackendDeadline(%q) = _, false; want %v, true", spec.method, spec.want)
+			continue
+		}
+		if got != spec.want {
+			t.Errorf("reg.LookupExternalBackendDeadline(%q) = %v; want %v", spec.method, got, spec.want)
+		}
+	}
+}
+
+func TestRegisterBackendRulesFromGrpcAPIServiceRejectsInvalidRules(t *testing.T) {
+	for _, spec := range []struct {
+		name string
+		yaml string
+		want string
+	}{
+		{
+			name: "invalid selector",
+			yaml: `
+backend:
+ rules:
+ - selector: grpctest.*.Echo
+   deadline: 10
+`,
+			want: `invalidbackend:4:4: selector "grpctest.*.Echo"`,
+		},
+		{
+			name: "negative deadline",
+			yaml: `
+backend:
+ rules:
+ - selector: grpctest.YourService.Echo
+   deadline: -1
+`,
+			want: "invalidbackend:5:4: deadline -1",
+		},
+	} {
+		t.Run(spec.name, func(t *testing.T) {
+			service, err := loadGrpcAPIServiceFromYAML([]byte(spec.yaml), "invalidbackend")
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = registerBackendRulesFromGrpcAPIService(NewRegistry(), service)
+			if err == nil {
+				t.Fatal("registerBackendRulesFromGrpcAPIService() succeeded; want an error")
+			}
+			if !strings.Contains(err.Error(), spec.want) {
+				t.Errorf("registerBackendRulesFromGrpcAPIService() failed with %v; want an error containing %q", err, spec.want)
+			}
+		})
+	}
+}
+
+func TestLoadGrpcAPIServiceFromYAMLRejectsInvalidValues(t *testing.T) {
+	_, err := loadGrpcAPIServiceFromYAML([]byte(`
+type: google.api.Service
+name: example.googleapis.com
+http:
+  rules:
+  - selector: grpctest.YourService.Echo
+    get: [/v1/echo]
+backend:
+  rules:
+  - selector: grpctest.YourService.Echo
+    deadline: ten
+    protocol: h2
+authentication:
+  rules:
+  - selector: grpctest.YourService.Echo
+    allow_without_credential: maybe
+`), "invalidvalues")
+	if err == nil {
+		t.Fatal("loadGrpcAPIServiceFromYAML() succeeded; want an error")
+	}
+	for _, want := range []string{
+		`invalidvalues:7:10: "http.rules[0].get" must be a string`,
+		`invalidvalues:11:15: "backend.rules[0].deadline" must be a number`,
+		`invalidvalues:16:31: "authentication.rules[0].allow_without_credential" must be true or false`,
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("loadGrpcAPIServiceFromYAML() failed with %v; want an error containing %q", err, want)
+		}
+	}
+}
+
+func TestLoadGrpcAPIServiceFromYAMLFullService(t *testing.T) {
+	service, err := loadGrpcAPIServiceFromYAML([]byte(`
+type: google.api.Service
+config_version: 3
+name: library.example.com
+title: Library API
+
+documentation:
+  summary: Manages shelves of books.
+  rules:
+  - selector: grpctest.Library.GetShelf
+    description: Gets a shelf.
+
+authentication:
+  providers:
+  - id: google_id_token
+    issuer: https://accounts.google.com
+    jwks_uri: https://www.googleapis.com/oauth2/v3/certs
+  rules:
+  - selector: "grpctest.Library.*"
+    requirements:
+    - provider_id: google_id_token
+
+system_parameters:
+  rules:
+  - selector: "*"
+    parameters:
+    - name: api_key
+      http_header: X-API-Key
+      url_query_parameter: key
+
+usage:
+  rules:
+  - selector: grpctest.Library.ListShelves
+    allow_unregistered_calls: true
+
+types:
+- name: grpctest.Shelf
+`), "full")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reg := NewRegistry()
+	if err := validateRuleSelectorsOfGrpcAPIService(service); err != nil {
+		t.Fatal(err)
+	}
+	reg.serviceConfig = service.Service
+
+	if got, want := reg.GetServiceConfig().GetTitle(), "Library API"; got != want {
+		t.Errorf("reg.GetServiceConfig().GetTitle() = %q; want %q", got, want)
+	}
+	if got, want := reg.GetServiceConfig().GetDocumentation().GetSummary(), "Manages shelves of books."; got != want {
+		t.Errorf("reg.GetServiceConfig().GetDocumentation().GetSummary() = %q; want %q", got, want)
+	}
+	if got, want := len(reg.GetServiceConfig().GetTypes()), 1; got != want {
+		t.Errorf("len(reg.GetServiceConfig().GetTypes()) = %d; want %d", got, want)
+	}
+	if got, ok := reg.LookupDocumentationRule(".grpctest.Library.GetShelf"); !ok || got != "Gets a shelf." {
+		t.Errorf(`reg.LookupDocumentationRule(".grpctest.Library.GetShelf") = %q, %t; want "Gets a shelf.", true`, got, ok)
+	}
+	if _, ok := reg.LookupDocumentationRule(".grpctest.Library.ListShelves"); ok {
+		t.Errorf(`reg.LookupDocumentationRule(".grpctest.Library.ListShelves") = _, true; want false`)
+	}
+	rule, ok := reg.LookupAuthenticationRule(".grpctest.Library.GetShelf")
+	if !ok || len(rule.GetRequirements()) != 1 || rule.GetRequirements()[0].GetProviderId() != "google_id_token" {
+		t.Errorf(`reg.LookupAuthenticationRule(".grpctest.Library.GetShelf") = %v, %t; want a rule requiring "google_id_token"`, rule, ok)
+	}
+	if _, ok := reg.LookupAuthenticationRule(".grpctest.Other.GetShelf"); ok {
+		t.Errorf(`reg.LookupAuthenticationRule(".grpctest.Other.GetShelf") = _, true; want false`)
+	}
+	params := reg.LookupSystemParameters(".grpctest.Other.GetShelf")
+	if len(params) != 1 || params[0].GetHttpHeader() != "X-API-Key" || params[0].GetUrlQueryParameter() != "key" {
+		t.Errorf(`reg.LookupSystemParameters(".grpctest.Other.GetShelf") = %v; want the api_key parameter`, params)
+	}
+}
+
+func TestValidateRuleSelectorsOfGrpcAPIService(t *testing.T) {
+	service, err := loadGrpcAPIServiceFromYAML([]byte(`
+documentation:
+  rules:
+  - selector: grpctest.*.Echo
+    description: Echoes.
+authentication:
+  providers:
+  - id: google_id_token
+  rules:
+  - selector: grpctest.YourService.Echo
+    requirements:
+    - provider_id: firebase
+`), "invalidselectors")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = validateRuleSelectorsOfGrpcAPIService(service)
+	if err == nil {
+		t.Fatal("validateRuleSelectorsOfGrpcAPIService() succeeded; want an error")
+	}
+	for _, want := range []string{
+		`invalidselectors:4:5: selector "grpctest.*.Echo"`,
+		`invalidselectors:12:7: unknown authentication provider "firebase"`,
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("validateRuleSelectorsOfGrpcAPIService() failed with %v; want an error containing %q", err, want)
+		}
+	}
+}
+
+func TestSelectRule(t *testing.T) {
+	reg := NewRegistry()
+	service, err := loadGrpcAPIServiceFromYAML([]byte(`
+system_parameters:
+  rules:
+  - selector: "*"
+    parameters:
+    - name: any
+  - selector: grpctest.*
+    parameters:
+    - name: package
+  - selector: grpctest.YourService.*
+    parameters:
+    - name: service
+  - selector: grpctest.YourService.Echo
+    parameters:
+    - name: method
+  - selector: grpctest.YourService.*
+    parameters:
+    - name: last_service
+`), "selectors")
+	if err != nil {
+		t.Fatal(err)
+	}
+	reg.serviceConfig = service.Service
+
+	for _, spec := range []struct {
+		method string
+		want   string
+	}{
+		{method: ".grpctest.YourService.Echo", want: "method"},
+		{method: ".grpctest.YourService.Other", want: "last_service"},
+		{method: ".grpctest.OtherService.Echo", want: "package"},
+		{method: ".other.YourService.Echo", want: "any"},
+	} {
+		params := reg.LookupSystemParameters(spec.method)
+		if len(params) != 1 || params[0].GetName() != spec.want {
+			t.Errorf("reg.LookupSystemParameters(%q) = %v; want the %q parameter", spec.method, params, spec.want)
+		}
+	}
+}
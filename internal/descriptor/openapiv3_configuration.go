@@ -0,0 +1,55 @@
+package descriptor
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/internal/descriptor/openapiv3config"
+	"go.yaml.in/yaml/v3"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func loadOpenAPIv3ConfigFromYAML(yamlFileContents []byte, yamlSourceLogName string) (*openapiv3config.OpenAPIv3Config, error) {
+	var yamlContents interface{}
+	if err := yaml.Unmarshal(yamlFileContents, &yamlContents); err != nil {
+		return nil, fmt.Errorf("failed to parse OpenAPI v3 Configuration from YAML in %q: %w", yamlSourceLogName, err)
+	}
+
+	jsonContents, err := json.Marshal(yamlContents)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reject unknown fields because OpenAPIv3Config is only used here
+	unmarshaler := protojson.UnmarshalOptions{
+		DiscardUnknown: false,
+	}
+
+	openapiConfiguration := openapiv3config.OpenAPIv3Config{}
+	if err := unmarshaler.Unmarshal(jsonContents, &openapiConfiguration); err != nil {
+		return nil, fmt.Errorf("failed to parse OpenAPI v3 Configuration from YAML in %q: %w", yamlSourceLogName, err)
+	}
+
+	return &openapiConfiguration, nil
+}
+
+// LoadOpenAPIv3ConfigFromYAML loads an OpenAPI v3 Configuration from the given YAML file
+// and registers the OpenAPI v3 options in the given registry.
+// This must be done after loading the proto file.
+func (r *Registry) LoadOpenAPIv3ConfigFromYAML(yamlFile string) error {
+	yamlFileContents, err := os.ReadFile(yamlFile)
+	if err != nil {
+		return fmt.Errorf("failed to read OpenAPI v3 Configuration description from %q: %w", yamlFile, err)
+	}
+
+	config, err := loadOpenAPIv3ConfigFromYAML(yamlFileContents, yamlFile)
+	if err != nil {
+		return err
+	}
+
+	if err := r.RegisterOpenAPIv3Options(config.GetOpenapiv3Options()); err != nil {
+		return fmt.Errorf("failed to register option in %s: %w", yamlFile, err)
+	}
+	return nil
+}
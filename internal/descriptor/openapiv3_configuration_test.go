@@ -0,0 +1,129 @@
+package descriptor
+
+import (
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/internal/descriptor/openapiv3config"
+	openapiv3options "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv3/options"
+)
+
+func TestLoadOpenAPIv3ConfigFromYAML(t *testing.T) {
+	config, err := loadOpenAPIv3ConfigFromYAML([]byte(`
+openapiv3Options:
+  file:
+  - file: test.proto
+    option:
+      info:
+        title: Test API
+        version: 1.2.3
+  method:
+  - method: example.TestService.Get
+    option:
+      summary: Gets a thing.
+      security:
+      - securityRequirement:
+          bearer: {}
+  field:
+  - field: example.Thing.id
+    option:
+      format: uuid
+`), "openapiv3_options")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	opts := config.GetOpenapiv3Options()
+	if opts == nil {
+		t.Fatal("OpenAPIv3Options is empty")
+	}
+	if numFileOpts := len(opts.File); numFileOpts != 1 {
+		t.Fatalf("expected 1 file option but got %d", numFileOpts)
+	}
+	if got := opts.File[0].GetOption().GetInfo().GetTitle(); got != "Test API" {
+		t.Errorf("expected file option title to be Test API but got %s", got)
+	}
+	if numMethodOpts := len(opts.Method); numMethodOpts != 1 {
+		t.Fatalf("expected 1 method option but got %d", numMethodOpts)
+	}
+	methodOpt := opts.Method[0]
+	if methodOpt.Method != "example.TestService.Get" {
+		t.Errorf("method option has unexpected binding %s", methodOpt.Method)
+	}
+	if _, ok := methodOpt.GetOption().GetSecurity()[0].GetSecurityRequirement()["bearer"]; !ok {
+		t.Errorf("expected method option to require the bearer security scheme")
+	}
+	if numFieldOpts := len(opts.Field); numFieldOpts != 1 {
+		t.Fatalf("expected 1 field option but got %d", numFieldOpts)
+	}
+	if got := opts.Field[0].GetOption().GetFormat(); got != "uuid" {
+		t.Errorf("expected field option format to be uuid but got %s", got)
+	}
+}
+
+func TestLoadOpenAPIv3ConfigFromYAMLUnknownKeys(t *testing.T) {
+	_, err := loadOpenAPIv3ConfigFromYAML([]byte(`
+openapiv3Options:
+  file:
+  - file: test.proto
+    option:
+      schemes:
+      - HTTP
+`), "openapiv3_options")
+	if err == nil {
+		t.Errorf("Expected invalid key error")
+	}
+}
+
+func TestRegisterOpenAPIv3OptionsUnknownElements(t *testing.T) {
+	reg := NewRegistry()
+	loadFile(t, reg, `
+		name: "example.proto"
+		package: "example"
+		options <
+			go_package: "github.com/grpc-ecosystem/grpc-gateway/runtime/internal/example"
+		>
+		message_type <
+			name: "Thing"
+			field <
+				name: "id"
+				number: 1
+				label: LABEL_OPTIONAL
+				type: TYPE_STRING
+			>
+		>
+	`)
+
+	config, err := loadOpenAPIv3ConfigFromYAML([]byte(`
+openapiv3Options:
+  message:
+  - message: example.Thing
+    option:
+      description: A thing.
+  field:
+  - field: example.Thing.id
+    option:
+      format: uuid
+`), "openapiv3_options")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := reg.RegisterOpenAPIv3Options(config.GetOpenapiv3Options()); err != nil {
+		t.Fatalf("reg.RegisterOpenAPIv3Options(...) failed with %v; want success", err)
+	}
+	opt, ok := reg.GetOpenAPIv3FieldOption(".example.Thing.id")
+	if !ok || opt.GetFormat() != "uuid" {
+		t.Errorf("reg.GetOpenAPIv3FieldOption(%q) = %v, %t; want format uuid", ".example.Thing.id", opt, ok)
+	}
+
+	for _, opts := range []*openapiv3config.OpenAPIv3Options{
+		{File: []*openapiv3config.OpenAPIv3FileOption{{File: "missing.proto"}}},
+		{Service: []*openapiv3config.OpenAPIv3ServiceOption{{Service: "example.MissingService"}}},
+		{Method: []*openapiv3config.OpenAPIv3MethodOption{{Method: "example.MissingService.Get"}}},
+		{Message: []*openapiv3config.OpenAPIv3MessageOption{{Message: "example.Missing"}}},
+		{Field: []*openapiv3config.OpenAPIv3FieldOption{{Field: "example.Thing.missing", Option: &openapiv3options.Schema{}}}},
+	} {
+		if err := reg.RegisterOpenAPIv3Options(opts); err == nil {
+			t.Errorf("reg.RegisterOpenAPIv3Options(%v) succeeded; want an error", opts)
+		}
+	}
+}
@@ -1,16 +1,24 @@
 package descriptor
 
 import (
+	"errors"
 	"fmt"
+	"math"
 	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/internal/codegenerator"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/internal/descriptor/openapiconfig"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/internal/descriptor/openapiv3config"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	openapiv3options "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv3/options"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/genproto/googleapis/api/serviceconfig"
+	"google.golang.org/genproto/googleapis/rpc/code"
 	"google.golang.org/grpc/grpclog"
 	"google.golang.org/protobuf/compiler/protogen"
 	"google.golang.org/protobuf/types/descriptorpb"
@@ -46,6 +54,16 @@ type Registry struct {
 	// externalHttpRules is a mapping from fully qualified service method names to additional HttpRules applicable besides the ones found in annotations.
 	externalHTTPRules map[string][]*annotations.HttpRule
 
+	// externalHTTPRuleLocators locates the external HTTP rules loaded from a gRPC API Configuration in its file.
+	externalHTTPRuleLocators map[*annotations.HttpRule]sourceLocator
+
+	// externalBackendDeadlines is a mapping from backend rule selectors to the default deadline of the selected methods.
+	// Selectors are either fully qualified service method names, names ending in ".*" or "*".
+	externalBackendDeadlines map[string]time.Duration
+
+	// serviceConfig is the google.api.Service loaded from the gRPC API Configuration, if any.
+	serviceConfig *serviceconfig.Service
+
 	// allowMerge generation one OpenAPI file out of multiple protos
 	allowMerge bool
 
@@ -104,6 +122,19 @@ type Registry struct {
 	// This is useful for users who have defined custom error handling.
 	disableDefaultErrors bool
 
+	// httpStatusMappings is the mapping from gRPC errors to HTTP statuses that
+	// the gateway was configured with, used to document error responses.
+	httpStatusMappings []HTTPStatusMapping
+
+	// eventStreamResponses documents the responses of server-streaming
+	// methods as server-sent events too.
+	eventStreamResponses bool
+
+	// openAPIv3NamingStrategy is the naming strategy of the component schemas
+	// generated by protoc-gen-openapiv3, "fqn" by default. It takes the same
+	// values as openAPINamingStrategy, plus `package`.
+	openAPIv3NamingStrategy string
+
 	// simpleOperationIDs removes the service prefix from the generated
 	// operationIDs. This risks generating duplicate operationIDs.
 	simpleOperationIDs bool
@@ -132,6 +163,21 @@ type Registry struct {
 	// field name and a period to additional OpenAPI field options
 	fieldOptions map[string]*options.JSONSchema
 
+	// openAPIv3FileOptions is a mapping of file name to additional OpenAPI v3 file options
+	openAPIv3FileOptions map[string]*openapiv3options.Document
+
+	// openAPIv3MethodOptions is a mapping of fully-qualified method name to additional OpenAPI v3 method options
+	openAPIv3MethodOptions map[string]*openapiv3options.Operation
+
+	// openAPIv3MessageOptions is a mapping of fully-qualified message name to additional OpenAPI v3 message options
+	openAPIv3MessageOptions map[string]*openapiv3options.Schema
+
+	// openAPIv3ServiceOptions is a mapping of fully-qualified service name to additional OpenAPI v3 service options
+	openAPIv3ServiceOptions map[string]*openapiv3options.Service
+
+	// openAPIv3FieldOptions is a mapping of fully-qualified field name to additional OpenAPI v3 field options
+	openAPIv3FieldOptions map[string]*openapiv3options.Schema
+
 	// generateUnboundMethods causes the registry to generate proxy methods even for
 	// RPC methods that have no HttpRule annotation.
 	generateUnboundMethods bool
@@ -211,7 +257,10 @@ func NewRegistry() *Registry {
 		pkgMap:                         make(map[string]string),
 		pkgAliases:                     make(map[string]string),
 		externalHTTPRules:              make(map[string][]*annotations.HttpRule),
+		externalHTTPRuleLocators:       make(map[*annotations.HttpRule]sourceLocator),
+		externalBackendDeadlines:       make(map[string]time.Duration),
 		openAPINamingStrategy:          "legacy",
+		openAPIv3NamingStrategy:        "fqn",
 		visibilityRestrictionSelectors: make(map[string]bool),
 		repeatedPathParamSeparator: repeatedFieldSeparator{
 			name: "csv",
@@ -224,6 +273,12 @@ func NewRegistry() *Registry {
 		fieldOptions:   make(map[string]*options.JSONSchema),
 		annotationMap:  make(map[annotationIdentifier]struct{}),
 		recursiveDepth: 1000,
+
+		openAPIv3FileOptions:    make(map[string]*openapiv3options.Document),
+		openAPIv3MethodOptions:  make(map[string]*openapiv3options.Operation),
+		openAPIv3MessageOptions: make(map[string]*openapiv3options.Schema),
+		openAPIv3ServiceOptions: make(map[string]*openapiv3options.Service),
+		openAPIv3FieldOptions:   make(map[string]*openapiv3options.Schema),
 	}
 }
 
@@ -255,17 +310,18 @@ func (r *Registry) load(gen *protogen.Plugin) error {
 		r.loadFile(filePath, gen.FilesByPath[filePath])
 	}
 
+	var errs []error
 	for _, filePath := range filePaths {
 		if !gen.FilesByPath[filePath].Generate {
 			continue
 		}
 		file := r.files[filePath]
 		if err := r.loadServices(file); err != nil {
-			return err
+			errs = append(errs, err)
 		}
 	}
 
-	return nil
+	return errors.Join(errs...)
 }
 
 // loadFile loads messages, enumerations and fields from "file".
@@ -430,6 +486,92 @@ func (r *Registry) AddExternalHTTPRule(qualifiedMethodName string, rule *annotat
 	r.externalHTTPRules[qualifiedMethodName] = append(r.externalHTTPRules[qualifiedMethodName], rule)
 }
 
+// AddExternalBackendDeadline adds a default deadline for the methods matched by the given
+// backend rule selector. The selector is either a fully qualified service method name
+// without the leading dot, a name ending in ".*" or "*".
+func (r *Registry) AddExternalBackendDeadline(selector string, deadline time.Duration) {
+	r.externalBackendDeadlines[selector] = deadline
+}
+
+// LookupExternalBackendDeadline looks up the default deadline for the given fully qualified
+// service method name. An exact selector match takes precedence over wildcard selectors,
+// and longer wildcard selectors take precedence over shorter ones.
+func (r *Registry) LookupExternalBackendDeadline(qualifiedMethodName string) (time.Duration, bool) {
+	name := strings.TrimPrefix(qualifiedMethodName, ".")
+	if d, ok := r.externalBackendDeadlines[name]; ok {
+		return d, true
+	}
+	for prefix := name; prefix != ""; {
+		idx := strings.LastIndex(prefix, ".")
+		if idx < 0 {
+			break
+		}
+		prefix = prefix[:idx]
+		if d, ok := r.externalBackendDeadlines[prefix+".*"]; ok {
+			return d, true
+		}
+	}
+	d, ok := r.externalBackendDeadlines["*"]
+	return d, ok
+}
+
+// GetServiceConfig returns the google.api.Service loaded by LoadGrpcAPIServiceFromYAML,
+// or nil if no gRPC API Configuration was loaded.
+func (r *Registry) GetServiceConfig() *serviceconfig.Service {
+	return r.serviceConfig
+}
+
+// LookupDocumentationRule looks up the description of the element with the given
+// fully qualified name, e.g. a message, a field or a method, in the documentation
+// rules of the gRPC API Configuration. The description replaces the comments of
+// the element.
+func (r *Registry) LookupDocumentationRule(qualifiedName string) (string, bool) {
+	rule, ok := selectRule(r.serviceConfig.GetDocumentation().GetRules(), qualifiedName)
+	return rule.GetDescription(), ok
+}
+
+// LookupAuthenticationRule looks up the authentication rule of the given fully
+// qualified service method name in the gRPC API Configuration.
+func (r *Registry) LookupAuthenticationRule(qualifiedMethodName string) (*serviceconfig.AuthenticationRule, bool) {
+	return selectRule(r.serviceConfig.GetAuthentication().GetRules(), qualifiedMethodName)
+}
+
+// LookupSystemParameters looks up the system parameters of the given fully
+// qualified service method name in the gRPC API Configuration.
+func (r *Registry) LookupSystemParameters(qualifiedMethodName string) []*serviceconfig.SystemParameter {
+	rule, _ := selectRule(r.serviceConfig.GetSystemParameters().GetRules(), qualifiedMethodName)
+	return rule.GetParameters()
+}
+
+// selectRule returns the rule whose selector matches the given fully qualified
+// name. An exact selector match takes precedence over wildcard selectors, and
+// longer wildcard selectors take precedence over shorter ones. Among rules with
+// the same selector, the last one is returned.
+func selectRule[T interface{ GetSelector() string }](rules []T, qualifiedName string) (T, bool) {
+	name := strings.TrimPrefix(qualifiedName, ".")
+	var (
+		selected T
+		ok       bool
+		best     = -1
+	)
+	for _, rule := range rules {
+		selector := strings.Trim(rule.GetSelector(), " ")
+		score := -1
+		switch {
+		case selector == name:
+			score = math.MaxInt
+		case selector == "*":
+			score = 0
+		case strings.HasSuffix(selector, ".*") && strings.HasPrefix(name, strings.TrimSuffix(selector, "*")):
+			score = len(selector)
+		}
+		if score >= 0 && score >= best {
+			selected, ok, best = rule, true, score
+		}
+	}
+	return selected, ok
+}
+
 // UnboundExternalHTTPRules returns the list of External HTTPRules
 // which does not have a matching method in the registry
 func (r *Registry) UnboundExternalHTTPRules() []string {
@@ -710,6 +852,97 @@ func (r *Registry) GetDisableDefaultErrors() bool {
 	return r.disableDefaultErrors
 }
 
+// SetEventStreamResponses sets eventStreamResponses
+func (r *Registry) SetEventStreamResponses(use bool) {
+	r.eventStreamResponses = use
+}
+
+// GetEventStreamResponses returns eventStreamResponses
+func (r *Registry) GetEventStreamResponses() bool {
+	return r.eventStreamResponses
+}
+
+// SetOpenAPIv3NamingStrategy sets openAPIv3NamingStrategy
+func (r *Registry) SetOpenAPIv3NamingStrategy(strategy string) {
+	r.openAPIv3NamingStrategy = strategy
+}
+
+// GetOpenAPIv3NamingStrategy returns openAPIv3NamingStrategy
+func (r *Registry) GetOpenAPIv3NamingStrategy() string {
+	return r.openAPIv3NamingStrategy
+}
+
+// HTTPStatusMapping is an entry of the mapping from gRPC errors to HTTP statuses
+// configured with runtime.WithHTTPStatusMapping.
+type HTTPStatusMapping struct {
+	// Code is the name of the gRPC code, e.g. NOT_FOUND. It is empty if Reason is set.
+	Code string
+	// Reason is the reason of a google.rpc.ErrorInfo detail. It is empty if Code is set.
+	Reason string
+	// HTTPStatus is the HTTP status of the response.
+	HTTPStatus int
+}
+
+// SetHTTPStatusMappings sets httpStatusMappings from code and reason mappings in the
+// format of "<name>:<status>", e.g. "NOT_FOUND:410" or "RESOURCE_DELETED:410".
+func (r *Registry) SetHTTPStatusMappings(codeMappings, reasonMappings []string) error {
+	var mappings []HTTPStatusMapping
+	for _, m := range codeMappings {
+		name, st, err := parseHTTPStatusMapping(m)
+		if err != nil {
+			return err
+		}
+		if _, ok := code.Code_value[name]; !ok {
+			return fmt.Errorf("unknown gRPC code %q in HTTP status mapping %q", name, m)
+		}
+		mappings = append(mappings, HTTPStatusMapping{Code: name, HTTPStatus: st})
+	}
+	for _, m := range reasonMappings {
+		reason, st, err := parseHTTPStatusMapping(m)
+		if err != nil {
+			return err
+		}
+		mappings = append(mappings, HTTPStatusMapping{Reason: reason, HTTPStatus: st})
+	}
+	r.httpStatusMappings = mappings
+	return nil
+}
+
+func parseHTTPStatusMapping(m string) (string, int, error) {
+	name, st, ok := strings.Cut(m, ":")
+	if !ok || name == "" {
+		return "", 0, fmt.Errorf("invalid HTTP status mapping %q: want <name>:<status>", m)
+	}
+	status, err := strconv.Atoi(st)
+	if err != nil || status < 100 || status > 599 {
+		return "", 0, fmt.Errorf("invalid HTTP status in HTTP status mapping %q", m)
+	}
+	return name, status, nil
+}
+
+// GetHTTPStatusMappings returns httpStatusMappings
+func (r *Registry) GetHTTPStatusMappings() []HTTPStatusMapping {
+	return r.httpStatusMappings
+}
+
+// GetHTTPStatusMappingDescriptions returns the description of the error response of
+// each HTTP status in httpStatusMappings, keyed by the HTTP status.
+func (r *Registry) GetHTTPStatusMappingDescriptions() map[string]string {
+	descs := make(map[string]string)
+	for _, m := range r.httpStatusMappings {
+		desc := fmt.Sprintf("Returned when the call fails with code %s.", m.Code)
+		if m.Reason != "" {
+			desc = fmt.Sprintf("Returned when the call fails with an ErrorInfo of reason %s.", m.Reason)
+		}
+		st := strconv.Itoa(m.HTTPStatus)
+		if prev, ok := descs[st]; ok {
+			desc = prev + " " + desc
+		}
+		descs[st] = desc
+	}
+	return descs
+}
+
 // SetSimpleOperationIDs sets simpleOperationIDs
 func (r *Registry) SetSimpleOperationIDs(use bool) {
 	r.simpleOperationIDs = use
@@ -846,6 +1079,102 @@ func (r *Registry) GetOpenAPIFieldOption(qualifiedField string) (*options.JSONSc
 	return opt, ok
 }
 
+// RegisterOpenAPIv3Options registers OpenAPI v3 options
+func (r *Registry) RegisterOpenAPIv3Options(opts *openapiv3config.OpenAPIv3Options) error {
+	if opts == nil {
+		return nil
+	}
+
+	for _, opt := range opts.File {
+		if _, ok := r.files[opt.File]; !ok {
+			return fmt.Errorf("no file %s found", opt.File)
+		}
+		r.openAPIv3FileOptions[opt.File] = opt.Option
+	}
+
+	// build map of all registered methods
+	methods := make(map[string]struct{})
+	services := make(map[string]struct{})
+	for _, f := range r.files {
+		for _, s := range f.Services {
+			services[s.FQSN()] = struct{}{}
+			for _, m := range s.Methods {
+				methods[m.FQMN()] = struct{}{}
+			}
+		}
+	}
+
+	for _, opt := range opts.Method {
+		qualifiedMethod := "." + opt.Method
+		if _, ok := methods[qualifiedMethod]; !ok {
+			return fmt.Errorf("no method %s found", opt.Method)
+		}
+		r.openAPIv3MethodOptions[qualifiedMethod] = opt.Option
+	}
+
+	for _, opt := range opts.Message {
+		qualifiedMessage := "." + opt.Message
+		if _, ok := r.msgs[qualifiedMessage]; !ok {
+			return fmt.Errorf("no message %s found", opt.Message)
+		}
+		r.openAPIv3MessageOptions[qualifiedMessage] = opt.Option
+	}
+
+	for _, opt := range opts.Service {
+		qualifiedService := "." + opt.Service
+		if _, ok := services[qualifiedService]; !ok {
+			return fmt.Errorf("no service %s found", opt.Service)
+		}
+		r.openAPIv3ServiceOptions[qualifiedService] = opt.Option
+	}
+
+	// build map of all registered fields
+	fields := make(map[string]struct{})
+	for _, m := range r.msgs {
+		for _, f := range m.Fields {
+			fields[f.FQFN()] = struct{}{}
+		}
+	}
+	for _, opt := range opts.Field {
+		qualifiedField := "." + opt.Field
+		if _, ok := fields[qualifiedField]; !ok {
+			return fmt.Errorf("no field %s found", opt.Field)
+		}
+		r.openAPIv3FieldOptions[qualifiedField] = opt.Option
+	}
+	return nil
+}
+
+// GetOpenAPIv3FileOption returns a registered OpenAPI v3 option for a file
+func (r *Registry) GetOpenAPIv3FileOption(file string) (*openapiv3options.Document, bool) {
+	opt, ok := r.openAPIv3FileOptions[file]
+	return opt, ok
+}
+
+// GetOpenAPIv3MethodOption returns a registered OpenAPI v3 option for a method
+func (r *Registry) GetOpenAPIv3MethodOption(qualifiedMethod string) (*openapiv3options.Operation, bool) {
+	opt, ok := r.openAPIv3MethodOptions[qualifiedMethod]
+	return opt, ok
+}
+
+// GetOpenAPIv3MessageOption returns a registered OpenAPI v3 option for a message
+func (r *Registry) GetOpenAPIv3MessageOption(qualifiedMessage string) (*openapiv3options.Schema, bool) {
+	opt, ok := r.openAPIv3MessageOptions[qualifiedMessage]
+	return opt, ok
+}
+
+// GetOpenAPIv3ServiceOption returns a registered OpenAPI v3 option for a service
+func (r *Registry) GetOpenAPIv3ServiceOption(qualifiedService string) (*openapiv3options.Service, bool) {
+	opt, ok := r.openAPIv3ServiceOptions[qualifiedService]
+	return opt, ok
+}
+
+// GetOpenAPIv3FieldOption returns a registered OpenAPI v3 option for a field
+func (r *Registry) GetOpenAPIv3FieldOption(qualifiedField string) (*openapiv3options.Schema, bool) {
+	opt, ok := r.openAPIv3FieldOptions[qualifiedField]
+	return opt, ok
+}
+
 func (r *Registry) FieldName(f *Field) string {
 	if r.useJSONNamesForFields {
 		return f.GetJsonName()
@@ -1,6 +1,7 @@
 package descriptor
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/internal/descriptor/openapiconfig"
@@ -716,3 +717,32 @@ func assertStringSlice(t *testing.T, message string, got, want []string) {
 		}
 	}
 }
+
+func TestSetHTTPStatusMappings(t *testing.T) {
+	reg := NewRegistry()
+	if err := reg.SetHTTPStatusMappings([]string{"NOT_FOUND:410"}, []string{"RESOURCE_DELETED:410"}); err != nil {
+		t.Fatalf("reg.SetHTTPStatusMappings() failed with %v; want success", err)
+	}
+	want := []HTTPStatusMapping{
+		{Code: "NOT_FOUND", HTTPStatus: 410},
+		{Reason: "RESOURCE_DELETED", HTTPStatus: 410},
+	}
+	if got := reg.GetHTTPStatusMappings(); !reflect.DeepEqual(got, want) {
+		t.Errorf("reg.GetHTTPStatusMappings() = %v; want %v", got, want)
+	}
+
+	for _, spec := range []struct {
+		codes   []string
+		reasons []string
+	}{
+		{codes: []string{"NOT_A_CODE:400"}},
+		{codes: []string{"NOT_FOUND"}},
+		{codes: []string{"NOT_FOUND:gone"}},
+		{reasons: []string{"RESOURCE_DELETED:1000"}},
+		{reasons: []string{":410"}},
+	} {
+		if err := reg.SetHTTPStatusMappings(spec.codes, spec.reasons); err == nil {
+			t.Errorf("reg.SetHTTPStatusMappings(%q, %q) succeeded; want an error", spec.codes, spec.reasons)
+		}
+	}
+}
@@ -3,24 +3,34 @@ package descriptor
 import (
 	"errors"
 	"fmt"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/internal/httprule"
 	options "google.golang.org/genproto/googleapis/api/annotations"
 	"google.golang.org/grpc/grpclog"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
 	"google.golang.org/protobuf/types/descriptorpb"
 )
 
 // loadServices registers services and their methods from "targetFile" to "r".
 // It must be called after loadFile is called for all files so that loadServices
 // can resolve names of message types and their fields.
+//
+// It reports all the invalid methods of the file, located in the proto file or
+// in the gRPC API Configuration their HTTP rules are declared in.
 func (r *Registry) loadServices(file *File) error {
 	if grpclog.V(1) {
 		grpclog.Infof("Loading services from %s", file.GetName())
 	}
-	var svcs []*Service
-	for _, sd := range file.GetService() {
+	var (
+		svcs []*Service
+		errs []error
+	)
+	for svcIdx, sd := range file.GetService() {
 		if grpclog.V(2) {
 			grpclog.Infof("Registering %s", sd.GetName())
 		}
@@ -29,27 +39,37 @@ func (r *Registry) loadServices(file *File) error {
 			ServiceDescriptorProto: sd,
 			ForcePrefixedName:      r.standalone,
 		}
-		for _, md := range sd.GetMethod() {
+		for methIdx, md := range sd.GetMethod() {
 			if grpclog.V(2) {
 				grpclog.Infof("Processing %s.%s", sd.GetName(), md.GetName())
 			}
+			locate := protoMethodLocator(file, svcIdx, methIdx)
 			opts, err := extractAPIOptions(md)
 			if err != nil {
 				grpclog.Errorf("Failed to extract HttpRule from %s.%s: %v", svc.GetName(), md.GetName(), err)
-				return err
+				errs = append(errs, fmt.Errorf("%s: %w", locate("options"), err))
+				continue
+			}
+			var rules []locatedHTTPRule
+			for _, rule := range r.LookupExternalHTTPRules((&Method{Service: svc, MethodDescriptorProto: md}).FQMN()) {
+				ruleLocate, ok := r.externalHTTPRuleLocators[rule]
+				if !ok {
+					ruleLocate = locate.at(httpOptionPath)
+				}
+				rules = append(rules, locatedHTTPRule{rule: rule, locate: ruleLocate})
 			}
-			optsList := r.LookupExternalHTTPRules((&Method{Service: svc, MethodDescriptorProto: md}).FQMN())
 			if opts != nil {
-				optsList = append(optsList, opts)
+				rules = append(rules, locatedHTTPRule{rule: opts, locate: locate.at(httpOptionPath)})
 			}
-			if len(optsList) == 0 {
+			if len(rules) == 0 {
 				if r.generateUnboundMethods {
 					defaultOpts, err := defaultAPIOptions(svc, md)
 					if err != nil {
 						grpclog.Errorf("Failed to generate default HttpRule from %s.%s: %v", svc.GetName(), md.GetName(), err)
-						return err
+						errs = append(errs, fmt.Errorf("%s: %w", locate(""), err))
+						continue
 					}
-					optsList = append(optsList, defaultOpts)
+					rules = append(rules, locatedHTTPRule{rule: defaultOpts, locate: locate})
 				} else {
 					if grpclog.V(1) {
 						logFn := grpclog.Infof
@@ -60,9 +80,10 @@ func (r *Registry) loadServices(file *File) error {
 					}
 				}
 			}
-			meth, err := r.newMethod(svc, md, optsList)
+			meth, err := r.newMethod(svc, md, rules, locate)
 			if err != nil {
-				return err
+				errs = append(errs, err)
+				continue
 			}
 			svc.Methods = append(svc.Methods, meth)
 			r.meths[meth.FQMN()] = meth
@@ -75,18 +96,31 @@ func (r *Registry) loadServices(file *File) error {
 		}
 		svcs = append(svcs, svc)
 	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
 	file.Services = svcs
 	return nil
 }
 
-func (r *Registry) newMethod(svc *Service, md *descriptorpb.MethodDescriptorProto, optsList []*options.HttpRule) (*Method, error) {
+// locatedHTTPRule is an HTTP rule along with the locator of its source.
+type locatedHTTPRule struct {
+	rule   *options.HttpRule
+	locate sourceLocator
+}
+
+// newMethod returns the method described by md, bound by the given HTTP rules.
+// The errors of all its bindings are reported, located by their sourceLocator,
+// while other errors are located by locate.
+func (r *Registry) newMethod(svc *Service, md *descriptorpb.MethodDescriptorProto, rules []locatedHTTPRule, locate sourceLocator) (*Method, error) {
+	name := fmt.Sprintf("%s.%s", strings.TrimPrefix(svc.FQSN(), "."), md.GetName())
 	requestType, err := r.LookupMsg(svc.File.GetPackage(), md.GetInputType())
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("%s: %s: %w", locate("input_type"), name, err)
 	}
 	responseType, err := r.LookupMsg(svc.File.GetPackage(), md.GetOutputType())
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("%s: %s: %w", locate("output_type"), name, err)
 	}
 	meth := &Method{
 		Service:               svc,
@@ -94,118 +128,126 @@ func (r *Registry) newMethod(svc *Service, md *descriptorpb.MethodDescriptorProt
 		RequestType:           requestType,
 		ResponseType:          responseType,
 	}
+	if deadline, ok := r.LookupExternalBackendDeadline(meth.FQMN()); ok {
+		meth.Deadline = deadline
+	}
+	meth.SystemParameters = r.LookupSystemParameters(meth.FQMN())
 
-	newBinding := func(opts *options.HttpRule, idx int) (*Binding, error) {
+	var errs []error
+	reportf := func(locate sourceLocator, path string, format string, args ...interface{}) {
+		errs = append(errs, fmt.Errorf("%s: %s: %s", locate(path), name, fmt.Sprintf(format, args...)))
+	}
+
+	newBinding := func(opts *options.HttpRule, idx int, locate sourceLocator) *Binding {
 		var (
 			httpMethod   string
 			pathTemplate string
+			patternPath  string
 		)
 		switch {
 		case opts.GetGet() != "":
 			httpMethod = "GET"
 			pathTemplate = opts.GetGet()
+			patternPath = "get"
 			if opts.Body != "" {
-				return nil, fmt.Errorf("must not set request body when http method is GET: %s", md.GetName())
+				reportf(locate, "body", "must not set request body when http method is GET")
 			}
 
 		case opts.GetPut() != "":
 			httpMethod = "PUT"
 			pathTemplate = opts.GetPut()
+			patternPath = "put"
 
 		case opts.GetPost() != "":
 			httpMethod = "POST"
 			pathTemplate = opts.GetPost()
+			patternPath = "post"
 
 		case opts.GetDelete() != "":
 			httpMethod = "DELETE"
 			pathTemplate = opts.GetDelete()
+			patternPath = "delete"
 			if opts.Body != "" && !r.allowDeleteBody {
-				return nil, fmt.Errorf("must not set request body when http method is DELETE except allow_delete_body option is true: %s", md.GetName())
+				reportf(locate, "body", "must not set request body when http method is DELETE except allow_delete_body option is true")
 			}
 
 		case opts.GetPatch() != "":
 			httpMethod = "PATCH"
 			pathTemplate = opts.GetPatch()
+			patternPath = "patch"
 
 		case opts.GetCustom() != nil:
 			custom := opts.GetCustom()
 			httpMethod = custom.Kind
 			pathTemplate = custom.Path
+			patternPath = "custom.path"
 
 		default:
 			if grpclog.V(1) {
 				grpclog.Infof("No pattern specified in google.api.HttpRule: %s", md.GetName())
 			}
-			return nil, nil
-		}
-
-		parsed, err := httprule.Parse(pathTemplate)
-		if err != nil {
-			return nil, err
-		}
-		tmpl := parsed.Compile()
-
-		if md.GetClientStreaming() && len(tmpl.Fields) > 0 {
-			return nil, errors.New("cannot use path parameter in client streaming")
+			return nil
 		}
 
 		b := &Binding{
 			Method:     meth,
 			Index:      idx,
-			PathTmpl:   tmpl,
 			HTTPMethod: httpMethod,
+			locate:     locate,
 		}
 
-		for _, f := range tmpl.Fields {
-			param, err := r.newParam(meth, f)
-			if err != nil {
-				return nil, err
+		parsed, err := httprule.Parse(pathTemplate)
+		if err != nil {
+			reportf(locate, patternPath, "%v", err)
+		} else {
+			b.PathTmpl = parsed.Compile()
+			if md.GetClientStreaming() && len(b.PathTmpl.Fields) > 0 {
+				reportf(locate, patternPath, "cannot use path parameter in client streaming")
+			}
+			for _, f := range b.PathTmpl.Fields {
+				param, err := r.newParam(meth, f)
+				if err != nil {
+					reportf(locate, patternPath, "%v", err)
+					continue
+				}
+				b.PathParams = append(b.PathParams, param)
 			}
-			b.PathParams = append(b.PathParams, param)
 		}
 
 		// TODO(yugui) Handle query params
 
-		b.Body, err = r.newBody(meth, opts.Body)
-		if err != nil {
-			return nil, err
+		if b.Body, err = r.newBody(meth, opts.Body); err != nil {
+			reportf(locate, "body", "%v", err)
 		}
 
-		b.ResponseBody, err = r.newResponse(meth, opts.ResponseBody)
-		if err != nil {
-			return nil, err
+		if b.ResponseBody, err = r.newResponse(meth, opts.ResponseBody); err != nil {
+			reportf(locate, "response_body", "%v", err)
 		}
 
-		return b, nil
+		return b
 	}
 
-	applyOpts := func(opts *options.HttpRule) error {
-		b, err := newBinding(opts, len(meth.Bindings))
-		if err != nil {
-			return err
-		}
-
-		if b != nil {
+	applyOpts := func(opts *options.HttpRule, locate sourceLocator) {
+		if b := newBinding(opts, len(meth.Bindings), locate); b != nil {
 			meth.Bindings = append(meth.Bindings, b)
 		}
-		for _, additional := range opts.GetAdditionalBindings() {
+		for i, additional := range opts.GetAdditionalBindings() {
+			additionalLocate := locate.at(fmt.Sprintf("additional_bindings[%d]", i))
 			if len(additional.AdditionalBindings) > 0 {
-				return fmt.Errorf("additional_binding in additional_binding not allowed: %s.%s", svc.GetName(), meth.GetName())
+				reportf(additionalLocate, "additional_bindings", "additional_binding in additional_binding not allowed")
+				continue
 			}
-			b, err := newBinding(additional, len(meth.Bindings))
-			if err != nil {
-				return err
+			if b := newBinding(additional, len(meth.Bindings), additionalLocate); b != nil {
+				meth.Bindings = append(meth.Bindings, b)
 			}
-			meth.Bindings = append(meth.Bindings, b)
 		}
-
-		return nil
 	}
 
-	for _, opts := range optsList {
-		if err := applyOpts(opts); err != nil {
-			return nil, err
-		}
+	for _, rule := range rules {
+		applyOpts(rule.rule, rule.locate)
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
 	}
 
 	return meth, nil
@@ -266,7 +308,7 @@ func (r *Registry) newParam(meth *Method, path string) (Parameter, error) {
 				grpclog.Infoln("found well known aggregate type:", target)
 			}
 		} else {
-			return Parameter{}, fmt.Errorf("%s.%s: %s is a protobuf message type. Protobuf message types cannot be used as path parameters, use a scalar value type (such as string) instead", meth.Service.GetName(), meth.GetName(), path)
+			return Parameter{}, fmt.Errorf("%s is a protobuf message type. Protobuf message types cannot be used as path parameters, use a scalar value type (such as string) instead", path)
 		}
 	}
 	return Parameter{
@@ -349,3 +391,111 @@ func (r *Registry) resolveFieldPath(msg *Message, path string, isPathParam bool)
 	}
 	return result, nil
 }
+
+// sourceLocator returns the position, e.g. "example.proto:12:5", of the value
+// at path in a method declared in a proto file, or in an HTTP rule declared in
+// a gRPC API Configuration. Paths are made of the proto names of fields, of the
+// full names of extensions in parentheses and of the indexes of list elements,
+// e.g. "options.(google.api.http).additional_bindings[0].body". The most
+// specific known position is returned.
+type sourceLocator func(path string) string
+
+// at returns a sourceLocator of the values at paths relative to prefix.
+func (l sourceLocator) at(prefix string) sourceLocator {
+	return func(path string) string {
+		if path == "" {
+			return l(prefix)
+		}
+		return l(prefix + "." + path)
+	}
+}
+
+// httpOptionPath is the path of the google.api.http option of a method.
+const httpOptionPath = "options.(google.api.http)"
+
+var (
+	fileServiceFieldNumber   = int32((&descriptorpb.FileDescriptorProto{}).ProtoReflect().Descriptor().Fields().ByName("service").Number())
+	serviceMethodFieldNumber = int32((&descriptorpb.ServiceDescriptorProto{}).ProtoReflect().Descriptor().Fields().ByName("method").Number())
+)
+
+// protoMethodLocator returns a sourceLocator of the values of the method at
+// methIdx of the service at svcIdx in file, based on its source code info.
+// Values without a position of their own, e.g. the fields of an option set
+// with an aggregate value, are located at their closest ancestor.
+func protoMethodLocator(file *File, svcIdx, methIdx int) sourceLocator {
+	methodPath := []int32{fileServiceFieldNumber, int32(svcIdx), serviceMethodFieldNumber, int32(methIdx)}
+	md := (&descriptorpb.MethodDescriptorProto{}).ProtoReflect().Descriptor()
+	return func(path string) string {
+		full := append(slices.Clone(methodPath), sourceCodeInfoPath(md, path)...)
+		for n := len(full); n >= len(methodPath); n-- {
+			for _, loc := range file.GetSourceCodeInfo().GetLocation() {
+				if span := loc.GetSpan(); len(span) >= 2 && slices.Equal(loc.GetPath(), full[:n]) {
+					return fmt.Sprintf("%s:%d:%d", file.GetName(), span[0]+1, span[1]+1)
+				}
+			}
+		}
+		return file.GetName()
+	}
+}
+
+// sourceCodeInfoPath converts path in a message of type md into a path of
+// the source code info of a file. Unknown components are ignored.
+func sourceCodeInfoPath(md protoreflect.MessageDescriptor, path string) []int32 {
+	var result []int32
+	for _, c := range splitSourcePath(path) {
+		name, index, hasIndex := strings.Cut(c, "[")
+		var fd protoreflect.FieldDescriptor
+		if strings.HasPrefix(name, "(") {
+			xt, err := protoregistry.GlobalTypes.FindExtensionByName(protoreflect.FullName(strings.Trim(name, "()")))
+			if err != nil {
+				break
+			}
+			fd = xt.TypeDescriptor()
+		} else {
+			fd = md.Fields().ByName(protoreflect.Name(name))
+		}
+		if fd == nil {
+			break
+		}
+		result = append(result, int32(fd.Number()))
+		if hasIndex {
+			i, err := strconv.Atoi(strings.TrimSuffix(index, "]"))
+			if err != nil {
+				break
+			}
+			result = append(result, int32(i))
+		}
+		if fd.Message() == nil {
+			break
+		}
+		md = fd.Message()
+	}
+	return result
+}
+
+// splitSourcePath splits path into its components, keeping the full names of
+// extensions whole.
+func splitSourcePath(path string) []string {
+	if path == "" {
+		return nil
+	}
+	var (
+		components []string
+		depth      int
+		start      int
+	)
+	for i, r := range path {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case '.':
+			if depth == 0 {
+				components = append(components, path[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(components, path[start:])
+}
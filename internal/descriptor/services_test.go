@@ -2,6 +2,7 @@ package descriptor
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/internal/httprule"
@@ -1397,6 +1398,96 @@ func TestCauseErrorWithPathParam(t *testing.T) {
 	}
 }
 
+func TestExtractServicesReportsLocatedErrors(t *testing.T) {
+	src := `
+		name: "path/to/example.proto",
+		package: "example"
+		message_type <
+			name: "StringMessage"
+			field <
+				name: "string"
+				number: 1
+				label: LABEL_OPTIONAL
+				type: TYPE_STRING
+			>
+		>
+		service <
+			name: "ExampleService"
+			method <
+				name: "Echo"
+				input_type: "StringMessage"
+				output_type: "StringMessage"
+				options <
+					[google.api.http] <
+						post: "v1/example/echo"
+						body: "*"
+					>
+				>
+			>
+			method <
+				name: "Get"
+				input_type: "StringMessage"
+				output_type: "StringMessage"
+				options <
+					[google.api.http] <
+						get: "/v1/example/{string}"
+						body: "string"
+						response_body: "missing"
+					>
+				>
+			>
+			method <
+				name: "Configured"
+				input_type: "StringMessage"
+				output_type: "StringMessage"
+			>
+		>
+		source_code_info <
+			location < path: [6, 0, 2, 0, 4, 72295728] span: [10, 4, 13, 6] >
+			location < path: [6, 0, 2, 1] span: [16, 2, 22, 3] >
+			location < path: [6, 0, 2, 1, 4, 72295728, 7] span: [19, 6, 24] >
+		>
+	`
+	var fd descriptorpb.FileDescriptorProto
+	if err := prototext.Unmarshal([]byte(src), &fd); err != nil {
+		t.Fatalf("proto.UnmarshalText(%s, &fd) failed with %v; want success", src, err)
+	}
+	service, err := loadGrpcAPIServiceFromYAML([]byte(`
+type: google.api.Service
+config_version: 3
+http:
+  rules:
+  - selector: example.ExampleService.Configured
+    post: /v1/example/configured/{unknown}
+    body: "*"
+`), "example.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reg := NewRegistry()
+	if err := registerHTTPRulesFromGrpcAPIService(reg, service); err != nil {
+		t.Fatal(err)
+	}
+	reg.loadFile(fd.GetName(), &protogen.File{
+		Proto: &fd,
+	})
+	err = reg.loadServices(reg.files[fd.GetName()])
+	if err == nil {
+		t.Fatalf("loadServices(%q) succeeded; want an error", fd.GetName())
+	}
+	for _, want := range []string{
+		"path/to/example.proto:11:5: example.ExampleService.Echo: no leading / at offset 0: v1/example/echo",
+		"path/to/example.proto:20:7: example.ExampleService.Get: must not set request body when http method is GET",
+		`path/to/example.proto:17:3: example.ExampleService.Get: no field "missing" found in StringMessage`,
+		`example.yaml:7:5: example.ExampleService.Configured: no field "unknown" found in StringMessage`,
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("loadServices(%q) failed with %v; want an error containing %q", fd.GetName(), err, want)
+		}
+	}
+}
+
 func TestOptionalProto3URLPathMappingSuccess(t *testing.T) {
 	src := `
 		name: "path/to/example.proto"
@@ -3,9 +3,11 @@ package descriptor
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/internal/casing"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/internal/httprule"
+	"google.golang.org/genproto/googleapis/api/serviceconfig"
 	"google.golang.org/protobuf/types/descriptorpb"
 	"google.golang.org/protobuf/types/pluginpb"
 )
@@ -209,6 +211,12 @@ type Method struct {
 	// ResponseType is the message type of responses from this method.
 	ResponseType *Message
 	Bindings     []*Binding
+	// Deadline is the default deadline of calls to this method, as configured by
+	// the backend rules of a gRPC API Configuration. It is zero if unset.
+	Deadline time.Duration
+	// SystemParameters are the system parameters of calls to this method, as
+	// configured by the system parameter rules of a gRPC API Configuration.
+	SystemParameters []*serviceconfig.SystemParameter
 }
 
 // FQMN returns a fully qualified rpc method name of this method.
@@ -235,6 +243,19 @@ type Binding struct {
 	Body *Body
 	// ResponseBody describes field in response struct to marshal in HTTP response body.
 	ResponseBody *Body
+
+	locate sourceLocator
+}
+
+// Position returns the position, e.g. "example.proto:12:5", of the value at
+// path in the HTTP rule of the binding, e.g. "body", in the proto file or in the
+// gRPC API Configuration the rule is declared in. An empty path returns the
+// position of the rule itself.
+func (b *Binding) Position(path string) string {
+	if b.locate == nil {
+		return ""
+	}
+	return b.locate(path)
 }
 
 // ExplicitParams returns a list of explicitly bound parameters of "b",
@@ -0,0 +1,95 @@
+package httprule
+
+import (
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+)
+
+// pathSegment is a segment of the paths matched by a template: a literal, a
+// wildcard ("*") matching any segment or a deep wildcard ("**") matching any
+// sequence of segments, including an empty one.
+type pathSegment struct {
+	code utilities.OpCode
+	lit  string
+}
+
+func (s pathSegment) matches(lit string) bool {
+	return s.code != utilities.OpLitPush || s.lit == lit
+}
+
+// pathSegments returns the segments of the paths matched by t.
+func (t Template) pathSegments() []pathSegment {
+	var segments []pathSegment
+	for i := 0; i+1 < len(t.OpCodes); i += 2 {
+		switch code := utilities.OpCode(t.OpCodes[i]); code {
+		case utilities.OpLitPush:
+			segments = append(segments, pathSegment{code: code, lit: t.Pool[t.OpCodes[i+1]]})
+		case utilities.OpPush, utilities.OpPushM:
+			segments = append(segments, pathSegment{code: code})
+		}
+	}
+	return segments
+}
+
+// Overlaps returns whether t and u match a common path, i.e. whether a request
+// could be routed to a handler of either of them. Templates with different
+// verbs never overlap.
+func (t Template) Overlaps(u Template) bool {
+	if t.Verb != u.Verb {
+		return false
+	}
+	return overlaps(t.pathSegments(), u.pathSegments())
+}
+
+func overlaps(a, b []pathSegment) bool {
+	switch {
+	case len(a) == 0 && len(b) == 0:
+		return true
+	case len(a) > 0 && a[0].code == utilities.OpPushM:
+		// The deep wildcard matches either no more segments, or the first
+		// segment of a path b matches.
+		return overlaps(a[1:], b) || (len(b) > 0 && overlaps(a, b[1:]))
+	case len(b) > 0 && b[0].code == utilities.OpPushM:
+		return overlaps(b, a)
+	case len(a) == 0 || len(b) == 0:
+		return false
+	case a[0].code == utilities.OpLitPush && !b[0].matches(a[0].lit):
+		return false
+	default:
+		return overlaps(a[1:], b[1:])
+	}
+}
+
+// Covers returns whether t matches all the paths u matches, so that a handler
+// of t shadows a handler of u if it is tried first.
+func (t Template) Covers(u Template) bool {
+	if t.Verb != u.Verb {
+		return false
+	}
+	return covers(t.pathSegments(), u.pathSegments())
+}
+
+func covers(a, b []pathSegment) bool {
+	switch {
+	case len(b) == 0:
+		for _, s := range a {
+			if s.code != utilities.OpPushM {
+				return false
+			}
+		}
+		return true
+	case len(a) == 0:
+		return false
+	case a[0].code == utilities.OpPushM:
+		// The deep wildcard matches either no more segments, or the first
+		// segment of the paths b matches.
+		return covers(a[1:], b) || covers(a, b[1:])
+	case b[0].code == utilities.OpPushM:
+		return false
+	case b[0].code == utilities.OpPush && a[0].code != utilities.OpPush:
+		return false
+	case !a[0].matches(b[0].lit):
+		return false
+	default:
+		return covers(a[1:], b[1:])
+	}
+}
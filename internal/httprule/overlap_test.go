@@ -0,0 +1,54 @@
+package httprule
+
+import (
+	"testing"
+)
+
+func mustCompile(t *testing.T, tmpl string) Template {
+	t.Helper()
+	c, err := Parse(tmpl)
+	if err != nil {
+		t.Fatalf("Parse(%q) failed with %v; want success", tmpl, err)
+	}
+	return c.Compile()
+}
+
+func TestOverlapsAndCovers(t *testing.T) {
+	for _, spec := range []struct {
+		t, u     string
+		overlaps bool
+		// tCovers and uCovers are whether t covers u and whether u covers t.
+		tCovers, uCovers bool
+	}{
+		{t: "/v1/users", u: "/v1/users", overlaps: true, tCovers: true, uCovers: true},
+		{t: "/v1/users", u: "/v1/groups"},
+		{t: "/v1/{name=**}", u: "/v1/users/{id}", overlaps: true, tCovers: true},
+		{t: "/v1/{name=**}", u: "/v1", overlaps: true, tCovers: true},
+		{t: "/v1/{name=*}", u: "/v1/users/{id}"},
+		{t: "/v1/{id}", u: "/v1/users", overlaps: true, tCovers: true},
+		{t: "/v1/{name=users/*}", u: "/v1/{id}"},
+		{t: "/v1/{name=users/*}", u: "/v1/{id}/profile", overlaps: true},
+		{t: "/v1/{name=*/groups}", u: "/v1/{name=users/*}", overlaps: true},
+		{t: "/v1/{name=**}/items", u: "/v1/users/{id}/items", overlaps: true, tCovers: true},
+		{t: "/v1/{name=**}/items", u: "/v1/users/{id}", overlaps: true},
+		{t: "/v1/{name=**}", u: "/v1/{path=**}", overlaps: true, tCovers: true, uCovers: true},
+		{t: "/v1/{name=**}", u: "/v1/{name=*}/{path=**}", overlaps: true, tCovers: true},
+		{t: "/v1/{name=*}:archive", u: "/v1/users:archive", overlaps: true, tCovers: true},
+		{t: "/v1/{name=*}:archive", u: "/v1/{name=*}:restore"},
+		{t: "/v1/{name=*}:archive", u: "/v1/{name=*}"},
+	} {
+		tt, u := mustCompile(t, spec.t), mustCompile(t, spec.u)
+		if got := tt.Overlaps(u); got != spec.overlaps {
+			t.Errorf("Template(%q).Overlaps(%q) = %v; want %v", spec.t, spec.u, got, spec.overlaps)
+		}
+		if got := u.Overlaps(tt); got != spec.overlaps {
+			t.Errorf("Template(%q).Overlaps(%q) = %v; want %v", spec.u, spec.t, got, spec.overlaps)
+		}
+		if got := tt.Covers(u); got != spec.tCovers {
+			t.Errorf("Template(%q).Covers(%q) = %v; want %v", spec.t, spec.u, got, spec.tCovers)
+		}
+		if got := u.Covers(tt); got != spec.uCovers {
+			t.Errorf("Template(%q).Covers(%q) = %v; want %v", spec.u, spec.t, got, spec.uCovers)
+		}
+	}
+}
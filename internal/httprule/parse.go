@@ -10,10 +10,17 @@ import (
 type InvalidTemplateError struct {
 	tmpl string
 	msg  string
+	// offset is the offset in bytes of the invalid part of tmpl.
+	offset int
 }
 
 func (e InvalidTemplateError) Error() string {
-	return fmt.Sprintf("%s: %s", e.msg, e.tmpl)
+	return fmt.Sprintf("%s at offset %d: %s", e.msg, e.offset, e.tmpl)
+}
+
+// Offset returns the offset in bytes of the invalid part of the template.
+func (e InvalidTemplateError) Offset() int {
+	return e.offset
 }
 
 // Parse parses the string representation of path template
@@ -26,7 +33,8 @@ func Parse(tmpl string) (Compiler, error) {
 	p := parser{tokens: tokens}
 	segs, err := p.topLevelSegments()
 	if err != nil {
-		return template{}, InvalidTemplateError{tmpl: tmpl, msg: err.Error()}
+		// The leading "/" is not part of the tokens.
+		return template{}, InvalidTemplateError{tmpl: tmpl, msg: err.Error(), offset: 1 + p.offset()}
 	}
 
 	return template{
@@ -117,6 +125,18 @@ type parser struct {
 	accepted []string
 }
 
+// offset returns the offset in bytes of the next token, relative to the start
+// of the tokens.
+func (p *parser) offset() int {
+	var n int
+	for _, t := range p.accepted {
+		if t != eof {
+			n += len(t)
+		}
+	}
+	return n
+}
+
 // topLevelSegments is the target of this parser.
 func (p *parser) topLevelSegments() ([]segment, error) {
 	if _, err := p.accept(typeEOF); err == nil {
@@ -144,6 +164,11 @@ func (p *parser) segments() ([]segment, error) {
 		if _, err := p.accept("/"); err != nil {
 			return segs, nil
 		}
+		// accept takes "/" for a wildcard, so reject the empty segment here
+		// for the error to point at it rather than at the token after it.
+		if p.tokens[0] == "/" {
+			return segs, errors.New("empty segment")
+		}
 		s, err := p.segment()
 		if err != nil {
 			return segs, err
@@ -368,6 +368,29 @@ func TestParseError(t *testing.T) {
 	}
 }
 
+func TestParseErrorOffset(t *testing.T) {
+	for _, spec := range []struct {
+		input      string
+		wantOffset int
+	}{
+		{input: "v1/{name}", wantOffset: 0},
+		{input: "/v1//echo", wantOffset: 4},
+		{input: "/v1/{name=shelves/*}/books/{1book}", wantOffset: 28},
+		{input: "/v1/{name=shelves/*/books/*", wantOffset: 27},
+		{input: "/v1/example/{id}/a%GG", wantOffset: 17},
+	} {
+		_, err := Parse(spec.input)
+		var tmplErr InvalidTemplateError
+		if !errors.As(err, &tmplErr) {
+			t.Errorf("Parse(%q) failed with %v; want an InvalidTemplateError", spec.input, err)
+			continue
+		}
+		if got := tmplErr.Offset(); got != spec.wantOffset {
+			t.Errorf("Parse(%q) failed at offset %d; want %d; err = %v", spec.input, got, spec.wantOffset, err)
+		}
+	}
+}
+
 func TestParseSegmentsWithErrors(t *testing.T) {
 	for _, spec := range []struct {
 		tokens []string
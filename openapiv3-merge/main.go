@@ -14,7 +14,7 @@
 // and conflicting tag metadata are errors. `info`, `servers`, `externalDocs`,
 // and unknown top-level fields are taken from the first input; later inputs'
 // values for those fields are ignored. See the package documentation in
-// internal/merge for the full ruleset.
+// internal/openapiv3/merge for the full ruleset.
 package main
 
 import (
@@ -23,7 +23,7 @@ import (
 	"io"
 	"os"
 
-	"github.com/grpc-ecosystem/grpc-gateway/v2/openapiv3-merge/internal/merge"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/internal/openapiv3/merge"
 )
 
 func main() {
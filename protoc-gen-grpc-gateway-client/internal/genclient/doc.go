@@ -0,0 +1,2 @@
+// Package genclient provides a code generator for HTTP clients of grpc gateways.
+package genclient
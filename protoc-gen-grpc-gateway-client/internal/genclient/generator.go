@@ -0,0 +1,121 @@
+package genclient
+
+import (
+	"errors"
+	"fmt"
+	"go/format"
+	"path"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/internal/descriptor"
+	gen "github.com/grpc-ecosystem/grpc-gateway/v2/internal/generator"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+var errNoTargetService = errors.New("no target service defined in the file")
+
+type generator struct {
+	reg         *descriptor.Registry
+	baseImports []descriptor.GoPackage
+	standalone  bool
+}
+
+// New returns a new generator which generates HTTP clients of grpc gateways.
+func New(reg *descriptor.Registry, standalone bool) gen.Generator {
+	var imports []descriptor.GoPackage
+	for _, pkgpath := range []string{
+		"context",
+		"github.com/grpc-ecosystem/grpc-gateway/v2/runtime/httpclient",
+		"google.golang.org/grpc",
+		"google.golang.org/grpc/codes",
+		"google.golang.org/grpc/status",
+	} {
+		pkg := descriptor.GoPackage{
+			Path: pkgpath,
+			Name: path.Base(pkgpath),
+		}
+		if err := reg.ReserveGoPackageAlias(pkg.Name, pkg.Path); err != nil {
+			for i := 0; ; i++ {
+				alias := fmt.Sprintf("%s_%d", pkg.Name, i)
+				if err := reg.ReserveGoPackageAlias(alias, pkg.Path); err != nil {
+					continue
+				}
+				pkg.Alias = alias
+				break
+			}
+		}
+		imports = append(imports, pkg)
+	}
+
+	return &generator{
+		reg:         reg,
+		baseImports: imports,
+		standalone:  standalone,
+	}
+}
+
+func (g *generator) Generate(targets []*descriptor.File) ([]*descriptor.ResponseFile, error) {
+	var files []*descriptor.ResponseFile
+	for _, file := range targets {
+		if grpclog.V(1) {
+			grpclog.Infof("Processing %s", file.GetName())
+		}
+
+		code, err := g.generate(file)
+		if errors.Is(err, errNoTargetService) {
+			if grpclog.V(1) {
+				grpclog.Infof("%s: %v", file.GetName(), err)
+			}
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		formatted, err := format.Source([]byte(code))
+		if err != nil {
+			grpclog.Errorf("%v: %s", err, code)
+			return nil, err
+		}
+		files = append(files, &descriptor.ResponseFile{
+			GoPkg: file.GoPkg,
+			CodeGeneratorResponse_File: &pluginpb.CodeGeneratorResponse_File{
+				Name:    proto.String(file.GeneratedFilenamePrefix + ".pb.gw.client.go"),
+				Content: proto.String(string(formatted)),
+			},
+		})
+	}
+	return files, nil
+}
+
+func (g *generator) generate(file *descriptor.File) (string, error) {
+	pkgSeen := make(map[string]bool)
+	var imports []descriptor.GoPackage
+	for _, pkg := range g.baseImports {
+		pkgSeen[pkg.Path] = true
+		imports = append(imports, pkg)
+	}
+
+	if g.standalone {
+		pkgSeen[file.GoPkg.Path] = true
+		imports = append(imports, file.GoPkg)
+	}
+
+	for _, svc := range file.Services {
+		for _, m := range svc.Methods {
+			for _, msg := range referencedMessages(m) {
+				pkg := msg.File.GoPkg
+				if pkg == file.GoPkg || pkgSeen[pkg.Path] {
+					continue
+				}
+				pkgSeen[pkg.Path] = true
+				imports = append(imports, pkg)
+			}
+		}
+	}
+	params := param{
+		File:    file,
+		Imports: imports,
+	}
+	return applyTemplate(params)
+}
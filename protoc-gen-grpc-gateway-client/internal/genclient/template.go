@@ -0,0 +1,204 @@
+package genclient
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/internal/casing"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/internal/descriptor"
+	"google.golang.org/grpc/grpclog"
+)
+
+type param struct {
+	*descriptor.File
+	Imports  []descriptor.GoPackage
+	Services []*service
+}
+
+type service struct {
+	*descriptor.Service
+	Methods []*method
+}
+
+type method struct {
+	*descriptor.Method
+	// FullMethod is the gRPC method name of the method, e.g.
+	// "/example.v1.EchoService/Echo".
+	FullMethod string
+}
+
+// Unsupported returns the reason why the generated client does not support the
+// method, or an empty string if it does.
+func (m method) Unsupported() string {
+	return unsupportedReason(m.Method)
+}
+
+func unsupportedReason(m *descriptor.Method) string {
+	switch {
+	case len(m.Bindings) == 0:
+		return "has no HTTP binding"
+	case m.GetClientStreaming():
+		return "is client streaming"
+	case m.GetServerStreaming() && m.ResponseType.FQMN() == ".google.api.HttpBody":
+		return "streams google.api.HttpBody messages"
+	}
+	return ""
+}
+
+// referencedMessages returns the messages the generated client method of "m"
+// refers to. Client streaming methods are not supported and have no request
+// parameter, and unsupported server streaming methods return the stream type
+// defined by protoc-gen-go-grpc.
+func referencedMessages(m *descriptor.Method) []*descriptor.Message {
+	switch {
+	case m.GetClientStreaming():
+		return nil
+	case m.GetServerStreaming() && unsupportedReason(m) != "":
+		return []*descriptor.Message{m.RequestType}
+	}
+	return []*descriptor.Message{m.RequestType, m.ResponseType}
+}
+
+// bodyFieldPath returns the field path of the request body of "b", "*" if the
+// request message is the body or an empty string if the request has no body.
+func bodyFieldPath(b *descriptor.Body) string {
+	if b == nil {
+		return ""
+	}
+	if len(b.FieldPath) == 0 {
+		return "*"
+	}
+	return b.FieldPath.String()
+}
+
+// responseBodyFieldPath returns the field path of the response body of "b", or
+// an empty string if the response message is the body.
+func responseBodyFieldPath(b *descriptor.Body) string {
+	if b == nil {
+		return ""
+	}
+	return b.FieldPath.String()
+}
+
+func applyTemplate(p param) (string, error) {
+	for _, svc := range p.File.Services {
+		var methodWithBindingsSeen bool
+		s := &service{Service: svc}
+		for _, meth := range svc.Methods {
+			if grpclog.V(2) {
+				grpclog.Infof("Processing %s.%s", svc.GetName(), meth.GetName())
+			}
+			m := &method{
+				Method:     meth,
+				FullMethod: fmt.Sprintf("/%s/%s", svc.FQSN()[1:], meth.GetName()),
+			}
+			if len(meth.Bindings) != 0 {
+				methodWithBindingsSeen = true
+			}
+			s.Methods = append(s.Methods, m)
+		}
+		if methodWithBindingsSeen {
+			p.Services = append(p.Services, s)
+		}
+	}
+	if len(p.Services) == 0 {
+		return "", errNoTargetService
+	}
+
+	// Rename the services and methods after the gRPC method names are known,
+	// like protoc-gen-go-grpc names the client interfaces and their methods.
+	for _, svc := range p.Services {
+		svcName := casing.Camel(svc.GetName())
+		svc.Name = &svcName
+		for _, meth := range svc.Methods {
+			methName := casing.Camel(meth.GetName())
+			meth.Name = &methName
+		}
+	}
+
+	w := bytes.NewBuffer(nil)
+	if err := headerTemplate.Execute(w, p); err != nil {
+		return "", err
+	}
+	if err := clientTemplate.Execute(w, p); err != nil {
+		return "", err
+	}
+	return w.String(), nil
+}
+
+var (
+	funcMap = template.FuncMap{
+		"bodyFieldPath":         bodyFieldPath,
+		"responseBodyFieldPath": responseBodyFieldPath,
+	}
+
+	headerTemplate = template.Must(template.New("header").Parse(`
+// Code generated by protoc-gen-grpc-gateway-client. DO NOT EDIT.
+// source: {{ .GetName }}
+
+package {{ .GoPkg.Name }}
+import (
+	{{ range $i := .Imports }}{{ if $i.Standard }}{{ $i | printf "%s\n" }}{{ end }}{{ end }}
+
+	{{ range $i := .Imports }}{{ if not $i.Standard }}{{ $i | printf "%s\n" }}{{ end }}{{ end }}
+)
+
+// Suppress "imported and not used" errors
+var (
+	_ codes.Code
+	_ status.Status
+)
+`))
+
+	clientTemplate = template.Must(template.New("client").Funcs(funcMap).Parse(`
+{{ range $svc := .Services }}
+{{ range $m := $svc.Methods }}{{ if $m.Bindings }}
+var bindings_{{ $svc.GetName }}_{{ $m.GetName }} = []httpclient.Binding{
+{{- range $b := $m.Bindings }}
+	{HTTPMethod: {{ $b.HTTPMethod | printf "%q" }}, PathTemplate: {{ $b.PathTmpl.Template | printf "%q" }}
+	{{- with bodyFieldPath $b.Body }}, Body: {{ . | printf "%q" }}{{ end }}
+	{{- with responseBodyFieldPath $b.ResponseBody }}, ResponseBody: {{ . | printf "%q" }}{{ end }}},
+{{- end }}
+}
+{{ end }}{{ end }}
+
+type httpClient_{{ $svc.GetName }} struct {
+	client *httpclient.Client
+}
+
+// New{{ $svc.GetName }}HTTPClient returns an implementation of {{ $svc.InstanceName }}Client which sends
+// the calls as HTTP requests to a grpc gateway through "client", following the HTTP bindings
+// of the methods.
+func New{{ $svc.GetName }}HTTPClient(client *httpclient.Client) {{ $svc.InstanceName }}Client {
+	return &httpClient_{{ $svc.GetName }}{client: client}
+}
+{{ range $m := $svc.Methods }}
+{{ if $m.Unsupported }}
+{{- if $m.GetClientStreaming }}
+func (c *httpClient_{{ $svc.GetName }}) {{ $m.GetName }}(ctx context.Context, opts ...grpc.CallOption) ({{ $svc.InstanceName }}_{{ $m.GetName }}Client, error) {
+{{- else if $m.GetServerStreaming }}
+func (c *httpClient_{{ $svc.GetName }}) {{ $m.GetName }}(ctx context.Context, in *{{ $m.RequestType.GoType $svc.File.GoPkg.Path }}, opts ...grpc.CallOption) ({{ $svc.InstanceName }}_{{ $m.GetName }}Client, error) {
+{{- else }}
+func (c *httpClient_{{ $svc.GetName }}) {{ $m.GetName }}(ctx context.Context, in *{{ $m.RequestType.GoType $svc.File.GoPkg.Path }}, opts ...grpc.CallOption) (*{{ $m.ResponseType.GoType $svc.File.GoPkg.Path }}, error) {
+{{- end }}
+	return nil, status.Error(codes.Unimplemented, "{{ $m.FullMethod }} {{ $m.Unsupported }}, which the HTTP client does not support")
+}
+{{ else if $m.GetServerStreaming }}
+func (c *httpClient_{{ $svc.GetName }}) {{ $m.GetName }}(ctx context.Context, in *{{ $m.RequestType.GoType $svc.File.GoPkg.Path }}, opts ...grpc.CallOption) ({{ $svc.InstanceName }}_{{ $m.GetName }}Client, error) {
+	stream, err := httpclient.NewServerStream[{{ $m.ResponseType.GoType $svc.File.GoPkg.Path }}](ctx, c.client, {{ $m.FullMethod | printf "%q" }}, bindings_{{ $svc.GetName }}_{{ $m.GetName }}, in, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return stream, nil
+}
+{{ else }}
+func (c *httpClient_{{ $svc.GetName }}) {{ $m.GetName }}(ctx context.Context, in *{{ $m.RequestType.GoType $svc.File.GoPkg.Path }}, opts ...grpc.CallOption) (*{{ $m.ResponseType.GoType $svc.File.GoPkg.Path }}, error) {
+	out := new({{ $m.ResponseType.GoType $svc.File.GoPkg.Path }})
+	if err := c.client.Invoke(ctx, {{ $m.FullMethod | printf "%q" }}, bindings_{{ $svc.GetName }}_{{ $m.GetName }}, in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+{{ end }}{{ end }}{{ end }}`))
+)
@@ -0,0 +1,175 @@
+package genclient
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/internal/descriptor"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/internal/httprule"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func crossLinkFixture(f *descriptor.File) *descriptor.File {
+	for _, m := range f.Messages {
+		m.File = f
+	}
+	for _, svc := range f.Services {
+		svc.File = f
+		for _, m := range svc.Methods {
+			m.Service = svc
+			for _, b := range m.Bindings {
+				b.Method = m
+				for _, param := range b.PathParams {
+					param.Method = m
+				}
+			}
+		}
+	}
+	return f
+}
+
+func compilePath(t *testing.T, path string) httprule.Template {
+	parsed, err := httprule.Parse(path)
+	if err != nil {
+		t.Fatalf("httprule.Parse(%q) failed with %v; want success", path, err)
+	}
+	return parsed.Compile()
+}
+
+// newExampleFile returns a file with a service "example_service" whose methods
+// are described by meths, all taking and returning ExampleMessage.
+func newExampleFile(meths ...*descriptor.Method) *descriptor.File {
+	msgdesc := &descriptorpb.DescriptorProto{
+		Name: proto.String("ExampleMessage"),
+		Field: []*descriptorpb.FieldDescriptorProto{
+			{
+				Name:     proto.String("nested"),
+				Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+				Type:     descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+				TypeName: proto.String("ExampleMessage"),
+				Number:   proto.Int32(1),
+			},
+		},
+	}
+	msg := &descriptor.Message{DescriptorProto: msgdesc}
+	svc := &descriptorpb.ServiceDescriptorProto{
+		Name: proto.String("example_service"),
+	}
+	for _, m := range meths {
+		m.InputType = proto.String("ExampleMessage")
+		m.OutputType = proto.String("ExampleMessage")
+		m.RequestType = msg
+		m.ResponseType = msg
+		svc.Method = append(svc.Method, m.MethodDescriptorProto)
+	}
+	file := &descriptor.File{
+		FileDescriptorProto: &descriptorpb.FileDescriptorProto{
+			Name:        proto.String("example.proto"),
+			Package:     proto.String("example"),
+			MessageType: []*descriptorpb.DescriptorProto{msgdesc},
+			Service:     []*descriptorpb.ServiceDescriptorProto{svc},
+		},
+		GoPkg: descriptor.GoPackage{
+			Path: "example.com/path/to/example/example.pb",
+			Name: "example_pb",
+		},
+		Messages: []*descriptor.Message{msg},
+		Services: []*descriptor.Service{
+			{
+				ServiceDescriptorProto: svc,
+				Methods:                meths,
+			},
+		},
+	}
+	return crossLinkFixture(file)
+}
+
+func TestApplyTemplate(t *testing.T) {
+	nestedField := &descriptor.Field{
+		FieldDescriptorProto: &descriptorpb.FieldDescriptorProto{
+			Name: proto.String("nested"),
+		},
+	}
+	file := newExampleFile(
+		&descriptor.Method{
+			MethodDescriptorProto: &descriptorpb.MethodDescriptorProto{Name: proto.String("echo")},
+			Bindings: []*descriptor.Binding{
+				{
+					HTTPMethod: "POST",
+					PathTmpl:   compilePath(t, "/v1/{nested.nested=items/*}:echo"),
+					Body:       &descriptor.Body{FieldPath: descriptor.FieldPath{{Name: "nested", Target: nestedField}}},
+				},
+				{
+					HTTPMethod:   "GET",
+					PathTmpl:     compilePath(t, "/v1/echo"),
+					ResponseBody: &descriptor.Body{FieldPath: descriptor.FieldPath{{Name: "nested", Target: nestedField}}},
+				},
+			},
+		},
+		&descriptor.Method{
+			MethodDescriptorProto: &descriptorpb.MethodDescriptorProto{
+				Name:            proto.String("List"),
+				ServerStreaming: proto.Bool(true),
+			},
+			Bindings: []*descriptor.Binding{
+				{
+					HTTPMethod: "GET",
+					PathTmpl:   compilePath(t, "/v1/items"),
+				},
+			},
+		},
+		&descriptor.Method{
+			MethodDescriptorProto: &descriptorpb.MethodDescriptorProto{
+				Name:            proto.String("Upload"),
+				ClientStreaming: proto.Bool(true),
+			},
+			Bindings: []*descriptor.Binding{
+				{
+					HTTPMethod: "POST",
+					PathTmpl:   compilePath(t, "/v1/items:upload"),
+					Body:       &descriptor.Body{},
+				},
+			},
+		},
+		&descriptor.Method{
+			MethodDescriptorProto: &descriptorpb.MethodDescriptorProto{Name: proto.String("Unbound")},
+		},
+	)
+	got, err := applyTemplate(param{File: file})
+	if err != nil {
+		t.Fatalf("applyTemplate(%#v) failed with %v; want success", file, err)
+	}
+	for _, want := range []string{
+		"package example_pb\n",
+		`{HTTPMethod: "POST", PathTemplate: "/v1/{nested.nested=items/*}:echo", Body: "nested"},`,
+		`{HTTPMethod: "GET", PathTemplate: "/v1/echo", ResponseBody: "nested"},`,
+		`{HTTPMethod: "POST", PathTemplate: "/v1/items:upload", Body: "*"},`,
+		"func NewExampleServiceHTTPClient(client *httpclient.Client) ExampleServiceClient {",
+		"func (c *httpClient_ExampleService) Echo(ctx context.Context, in *ExampleMessage, opts ...grpc.CallOption) (*ExampleMessage, error) {",
+		`c.client.Invoke(ctx, "/example.example_service/echo", bindings_ExampleService_Echo, in, out, opts...)`,
+		"func (c *httpClient_ExampleService) List(ctx context.Context, in *ExampleMessage, opts ...grpc.CallOption) (ExampleService_ListClient, error) {",
+		`httpclient.NewServerStream[ExampleMessage](ctx, c.client, "/example.example_service/List", bindings_ExampleService_List, in, opts...)`,
+		"func (c *httpClient_ExampleService) Upload(ctx context.Context, opts ...grpc.CallOption) (ExampleService_UploadClient, error) {",
+		`status.Error(codes.Unimplemented, "/example.example_service/Upload is client streaming, which the HTTP client does not support")`,
+		"func (c *httpClient_ExampleService) Unbound(ctx context.Context, in *ExampleMessage, opts ...grpc.CallOption) (*ExampleMessage, error) {",
+		`status.Error(codes.Unimplemented, "/example.example_service/Unbound has no HTTP binding, which the HTTP client does not support")`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("applyTemplate(%#v) = %s; want to contain %s", file, got, want)
+		}
+	}
+	if notWant := "bindings_ExampleService_Unbound"; strings.Contains(got, notWant) {
+		t.Errorf("applyTemplate(%#v) = %s; want not to contain %s", file, got, notWant)
+	}
+}
+
+func TestApplyTemplateNoBindings(t *testing.T) {
+	file := newExampleFile(&descriptor.Method{
+		MethodDescriptorProto: &descriptorpb.MethodDescriptorProto{Name: proto.String("Unbound")},
+	})
+	if got, err := applyTemplate(param{File: file}); !errors.Is(err, errNoTargetService) {
+		t.Errorf("applyTemplate(%#v) = %s, %v; want error %v", file, got, err, errNoTargetService)
+	}
+}
@@ -0,0 +1,4 @@
+// Package lint checks that the HTTP bindings of gRPC methods follow the
+// resource-oriented conventions of the AIPs, e.g. that Get methods are bound
+// to GET requests without a body.
+package lint
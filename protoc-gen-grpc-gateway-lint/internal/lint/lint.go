@@ -0,0 +1,76 @@
+package lint
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/internal/descriptor"
+	"google.golang.org/grpc/grpclog"
+)
+
+// Violation is a binding of a method which does not follow a rule.
+type Violation struct {
+	// RuleID identifies the rule, e.g. "aip-131-http-method".
+	RuleID string
+	// Position is the position of the violating value, e.g. "example.proto:12:5".
+	Position string
+	// Method is the fully qualified name of the method, e.g.
+	// "example.v1.LibraryService.GetBook".
+	Method string
+	// Message describes the violation.
+	Message string
+}
+
+func (v Violation) String() string {
+	return fmt.Sprintf("%s: [%s] %s: %s", v.Position, v.RuleID, v.Method, v.Message)
+}
+
+// Linter checks the HTTP bindings of the methods of files.
+type Linter struct {
+	allowDeleteBody bool
+}
+
+// New returns a new Linter. If allowDeleteBody is set, Delete methods may map
+// a request body.
+func New(allowDeleteBody bool) *Linter {
+	return &Linter{allowDeleteBody: allowDeleteBody}
+}
+
+// Lint returns the violations of the bindings of the methods of files, except
+// the ones suppressed by the comments of the files and of the methods.
+// Streaming methods are not checked.
+func (l *Linter) Lint(files []*descriptor.File) []Violation {
+	var violations []Violation
+	for _, file := range files {
+		if grpclog.V(1) {
+			grpclog.Infof("Linting %s", file.GetName())
+		}
+		fileSuppressions := parseSuppressions(fileComments(file)...)
+		for _, svc := range file.Services {
+			for _, meth := range svc.Methods {
+				if meth.GetClientStreaming() || meth.GetServerStreaming() {
+					continue
+				}
+				methSuppressions := parseSuppressions(methodComments(file, svc, meth)...)
+				name := strings.TrimPrefix(meth.FQMN(), ".")
+				for _, b := range meth.Bindings {
+					for _, f := range l.check(b) {
+						if fileSuppressions.disables(f.ruleID) || methSuppressions.disables(f.ruleID) {
+							if grpclog.V(1) {
+								grpclog.Infof("Suppressed %s in %s", f.ruleID, name)
+							}
+							continue
+						}
+						violations = append(violations, Violation{
+							RuleID:   f.ruleID,
+							Position: b.Position(f.path),
+							Method:   name,
+							Message:  f.message,
+						})
+					}
+				}
+			}
+		}
+	}
+	return violations
+}
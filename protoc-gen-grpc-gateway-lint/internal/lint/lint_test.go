@@ -0,0 +1,250 @@
+package lint
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/internal/descriptor"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+const libraryMessages = `
+	name: "example/v1/library.proto"
+	package: "example.v1"
+	syntax: "proto3"
+	options < go_package: "github.com/example/v1" >
+	message_type <
+		name: "Book"
+		field < name: "name" number: 1 label: LABEL_OPTIONAL type: TYPE_STRING json_name: "name" >
+	>
+	message_type <
+		name: "BookRequest"
+		field < name: "name" number: 1 label: LABEL_OPTIONAL type: TYPE_STRING json_name: "name" >
+		field < name: "parent" number: 2 label: LABEL_OPTIONAL type: TYPE_STRING json_name: "parent" >
+		field < name: "book" number: 3 label: LABEL_OPTIONAL type: TYPE_MESSAGE type_name: ".example.v1.Book" json_name: "book" >
+	>
+`
+
+func lint(t *testing.T, src string, allowDeleteBody bool) []string {
+	t.Helper()
+	var fd descriptorpb.FileDescriptorProto
+	if err := prototext.Unmarshal([]byte(libraryMessages+src), &fd); err != nil {
+		t.Fatalf("prototext.Unmarshal(%s, &fd) failed with %v; want success", src, err)
+	}
+	reg := descriptor.NewRegistry()
+	reg.SetAllowDeleteBody(true)
+	if err := reg.Load(&pluginpb.CodeGeneratorRequest{
+		ProtoFile:      []*descriptorpb.FileDescriptorProto{&fd},
+		FileToGenerate: []string{fd.GetName()},
+	}); err != nil {
+		t.Fatalf("reg.Load(...) failed with %v; want success", err)
+	}
+	f, err := reg.LookupFile(fd.GetName())
+	if err != nil {
+		t.Fatalf("reg.LookupFile(%q) failed with %v; want success", fd.GetName(), err)
+	}
+	var got []string
+	for _, v := range New(allowDeleteBody).Lint([]*descriptor.File{f}) {
+		got = append(got, v.String())
+	}
+	return got
+}
+
+func TestLintCompliantMethods(t *testing.T) {
+	got := lint(t, `
+		service <
+			name: "LibraryService"
+			method <
+				name: "GetBook" input_type: ".example.v1.BookRequest" output_type: ".example.v1.Book"
+				options < [google.api.http] < get: "/v1/{name=shelves/*/books/*}" > >
+			>
+			method <
+				name: "ListBooks" input_type: ".example.v1.BookRequest" output_type: ".example.v1.Book"
+				options < [google.api.http] < get: "/v1/{parent=shelves/*}/books" > >
+			>
+			method <
+				name: "CreateBook" input_type: ".example.v1.BookRequest" output_type: ".example.v1.Book"
+				options < [google.api.http] < post: "/v1/{parent=shelves/*}/books" body: "book" > >
+			>
+			method <
+				name: "UpdateBook" input_type: ".example.v1.BookRequest" output_type: ".example.v1.Book"
+				options < [google.api.http] < patch: "/v1/{book.name=shelves/*/books/*}" body: "book" > >
+			>
+			method <
+				name: "DeleteBook" input_type: ".example.v1.BookRequest" output_type: ".example.v1.Book"
+				options < [google.api.http] < delete: "/v1/{name=shelves/*/books/*}" > >
+			>
+			method <
+				name: "ArchiveBook" input_type: ".example.v1.BookRequest" output_type: ".example.v1.Book"
+				options < [google.api.http] < post: "/v1/{name=shelves/*/books/*}:archive" body: "*" > >
+			>
+			method <
+				name: "CreateShelf" input_type: ".example.v1.Book" output_type: ".example.v1.Book"
+				options < [google.api.http] < post: "/v1/shelves" body: "*" > >
+			>
+			method <
+				name: "WatchBooks" input_type: ".example.v1.BookRequest" output_type: ".example.v1.Book" server_streaming: true
+				options < [google.api.http] < get: "/v1/books" > >
+			>
+		>
+	`, false)
+	if len(got) != 0 {
+		t.Errorf("Lint(...) = %q; want no violations", got)
+	}
+}
+
+func TestLintViolations(t *testing.T) {
+	got := lint(t, `
+		service <
+			name: "LibraryService"
+			method <
+				name: "GetBook" input_type: ".example.v1.BookRequest" output_type: ".example.v1.Book"
+				options < [google.api.http] < post: "/v1/books/{parent}" body: "*" > >
+			>
+			method <
+				name: "CreateBook" input_type: ".example.v1.BookRequest" output_type: ".example.v1.Book"
+				options < [google.api.http] < post: "/v1/books" > >
+			>
+			method <
+				name: "UpdateBook" input_type: ".example.v1.BookRequest" output_type: ".example.v1.Book"
+				options < [google.api.http] < patch: "/v1/{name=shelves/*/books/*}" body: "book" > >
+			>
+			method <
+				name: "DeleteBook" input_type: ".example.v1.BookRequest" output_type: ".example.v1.Book"
+				options < [google.api.http] < delete: "/v1/{name=shelves/*/books/*}" body: "book" > >
+			>
+			method <
+				name: "ArchiveBook" input_type: ".example.v1.BookRequest" output_type: ".example.v1.Book"
+				options <
+					[google.api.http] <
+						put: "/v1/{name=shelves/*/books/*}/archive"
+						body: "book"
+						additional_bindings < post: "/v1/{name=shelves/*/books/*}:archive_book" body: "book" >
+					>
+				>
+			>
+		>
+		source_code_info <
+			location < path: [6, 0, 2, 0] span: [20, 2, 22, 3] >
+			location < path: [6, 0, 2, 0, 4, 72295728] span: [21, 4, 56] >
+			location < path: [6, 0, 2, 3, 4, 72295728, 7] span: [31, 6, 18] >
+		>
+	`, false)
+	want := []string{
+		`example/v1/library.proto:22:5: [aip-131-http-method] example.v1.LibraryService.GetBook: Get methods must use the HTTP GET method, not POST`,
+		`example/v1/library.proto:22:5: [aip-131-http-body] example.v1.LibraryService.GetBook: Get methods must not have a request body`,
+		`example/v1/library.proto:22:5: [aip-131-http-uri-name] example.v1.LibraryService.GetBook: Get methods must map the name field to a path variable, e.g. {name=shelves/*/books/*}`,
+		`example/v1/library.proto: [aip-133-http-body] example.v1.LibraryService.CreateBook: Create methods must map the request body, e.g. with body: "*"`,
+		`example/v1/library.proto: [aip-133-http-uri-parent] example.v1.LibraryService.CreateBook: Create methods must map the parent field to a path variable, e.g. {parent=shelves/*}`,
+		`example/v1/library.proto: [aip-134-http-uri-name] example.v1.LibraryService.UpdateBook: Update methods must map the name field of the resource to a path variable, e.g. {book.name=shelves/*/books/*}`,
+		`example/v1/library.proto:32:7: [aip-135-http-body] example.v1.LibraryService.DeleteBook: Delete methods must not have a request body`,
+		`example/v1/library.proto: [aip-136-http-uri-suffix] example.v1.LibraryService.ArchiveBook: custom methods must end their path with a :verb suffix, e.g. /v1/{name=shelves/*/books/*}:archive`,
+		`example/v1/library.proto: [aip-136-http-method] example.v1.LibraryService.ArchiveBook: custom methods must use the HTTP POST or GET method, not PUT`,
+		`example/v1/library.proto: [aip-136-http-uri-suffix] example.v1.LibraryService.ArchiveBook: the verb "archive_book" of custom methods must be in lowerCamelCase`,
+		`example/v1/library.proto: [aip-136-http-body] example.v1.LibraryService.ArchiveBook: custom methods using the HTTP POST method must map the whole request to the request body with body: "*"`,
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Lint(...) = \n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestLintAllowDeleteBody(t *testing.T) {
+	got := lint(t, `
+		service <
+			name: "LibraryService"
+			method <
+				name: "DeleteBook" input_type: ".example.v1.BookRequest" output_type: ".example.v1.Book"
+				options < [google.api.http] < delete: "/v1/{name=shelves/*/books/*}" body: "book" > >
+			>
+		>
+	`, true)
+	if len(got) != 0 {
+		t.Errorf("Lint(...) = %q; want no violations", got)
+	}
+}
+
+func TestLintSuppressions(t *testing.T) {
+	for _, spec := range []struct {
+		name     string
+		comments string
+		want     []string
+	}{
+		{
+			name: "no directive",
+			comments: `
+				location < path: [6, 0, 2, 0] span: [0, 0, 0] leading_comments: " Gets a book.\n" >
+			`,
+			want: []string{
+				"[aip-131-http-method] example.v1.LibraryService.GetBook: Get methods must use the HTTP GET method, not POST",
+				"[aip-131-http-body] example.v1.LibraryService.GetBook: Get methods must not have a request body",
+				"[aip-136-http-uri-suffix] example.v1.LibraryService.Archive: custom methods must end their path with a :verb suffix, e.g. /v1/{name=shelves/*/books/*}:archive",
+			},
+		},
+		{
+			name: "method directive",
+			comments: `
+				location < path: [6, 0, 2, 0] span: [0, 0, 0] leading_comments: " Gets a book.\n (-- grpc-gateway-lint:disable=aip-131-http-method,aip-131-http-body --)\n" >
+			`,
+			want: []string{
+				"[aip-136-http-uri-suffix] example.v1.LibraryService.Archive: custom methods must end their path with a :verb suffix, e.g. /v1/{name=shelves/*/books/*}:archive",
+			},
+		},
+		{
+			name: "method directive with an AIP",
+			comments: `
+				location < path: [6, 0, 2, 0] span: [0, 0, 0] trailing_comments: " grpc-gateway-lint:disable=aip-131\n" >
+			`,
+			want: []string{
+				"[aip-136-http-uri-suffix] example.v1.LibraryService.Archive: custom methods must end their path with a :verb suffix, e.g. /v1/{name=shelves/*/books/*}:archive",
+			},
+		},
+		{
+			name: "method directive without rules",
+			comments: `
+				location < path: [6, 0, 2, 1] span: [0, 0, 0] leading_comments: " grpc-gateway-lint:disable\n" >
+			`,
+			want: []string{
+				"[aip-131-http-method] example.v1.LibraryService.GetBook: Get methods must use the HTTP GET method, not POST",
+				"[aip-131-http-body] example.v1.LibraryService.GetBook: Get methods must not have a request body",
+			},
+		},
+		{
+			name: "file directive",
+			comments: `
+				location < path: [12] span: [0, 0, 0] leading_detached_comments: " grpc-gateway-lint:disable=aip-136-http-uri-suffix\n" >
+				location < path: [2] span: [0, 0, 0] leading_comments: " grpc-gateway-lint:disable=aip-131-http-body\n" >
+			`,
+			want: []string{
+				"[aip-131-http-method] example.v1.LibraryService.GetBook: Get methods must use the HTTP GET method, not POST",
+			},
+		},
+	} {
+		t.Run(spec.name, func(t *testing.T) {
+			got := lint(t, `
+				service <
+					name: "LibraryService"
+					method <
+						name: "GetBook" input_type: ".example.v1.BookRequest" output_type: ".example.v1.Book"
+						options < [google.api.http] < post: "/v1/{name=shelves/*/books/*}" body: "*" > >
+					>
+					method <
+						name: "Archive" input_type: ".example.v1.BookRequest" output_type: ".example.v1.Book"
+						options < [google.api.http] < post: "/v1/{name=shelves/*/books/*}" body: "*" > >
+					>
+				>
+				source_code_info <`+spec.comments+`>
+			`, false)
+			// The positions of the violations depend on the comments.
+			for i, v := range got {
+				_, violation, _ := strings.Cut(v, ": ")
+				got[i] = violation
+			}
+			if strings.Join(got, "\n") != strings.Join(spec.want, "\n") {
+				t.Errorf("Lint(...) = \n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(spec.want, "\n"))
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package lint
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/internal/descriptor"
+)
+
+// finding is a violation of a rule by a binding, located by the path of the
+// violating value in the HTTP rule of the binding.
+type finding struct {
+	ruleID  string
+	path    string
+	message string
+}
+
+// standardMethod describes the binding expected of the standard methods whose
+// names start with prefix, as specified by the AIP with the given number.
+type standardMethod struct {
+	prefix     string
+	aip        int
+	httpMethod string
+	// body is set if the request must be mapped to the request body.
+	body bool
+	// variable is the field of the request which must be mapped to a path
+	// variable, either "name" or "parent".
+	variable string
+}
+
+var standardMethods = []standardMethod{
+	{prefix: "Get", aip: 131, httpMethod: "GET", variable: "name"},
+	{prefix: "List", aip: 132, httpMethod: "GET", variable: "parent"},
+	{prefix: "Create", aip: 133, httpMethod: "POST", body: true, variable: "parent"},
+	{prefix: "Update", aip: 134, httpMethod: "PATCH", body: true, variable: "name"},
+	{prefix: "Delete", aip: 135, httpMethod: "DELETE", variable: "name"},
+}
+
+// lookupStandardMethod returns the standard method the method named name is,
+// or nil if it is a custom method.
+func lookupStandardMethod(name string) *standardMethod {
+	for i, m := range standardMethods {
+		rest, ok := strings.CutPrefix(name, m.prefix)
+		if ok && rest != "" && unicode.IsUpper(rune(rest[0])) {
+			return &standardMethods[i]
+		}
+	}
+	return nil
+}
+
+// customVerbPattern matches the lowerCamelCase verbs of custom methods.
+var customVerbPattern = regexp.MustCompile(`^[a-z][a-zA-Z0-9]*$`)
+
+func (l *Linter) check(b *descriptor.Binding) []finding {
+	if m := lookupStandardMethod(b.Method.GetName()); m != nil {
+		return l.checkStandardMethod(m, b)
+	}
+	return l.checkCustomMethod(b)
+}
+
+// checkStandardMethod checks b against AIP-131 to AIP-135.
+func (l *Linter) checkStandardMethod(m *standardMethod, b *descriptor.Binding) []finding {
+	var findings []finding
+	reportf := func(rule, path, format string, args ...interface{}) {
+		findings = append(findings, finding{
+			ruleID:  fmt.Sprintf("aip-%d-%s", m.aip, rule),
+			path:    path,
+			message: fmt.Sprintf(format, args...),
+		})
+	}
+
+	if b.HTTPMethod != m.httpMethod {
+		reportf("http-method", patternPath(b), "%s methods must use the HTTP %s method, not %s", m.prefix, m.httpMethod, b.HTTPMethod)
+	}
+
+	switch {
+	case m.body && b.Body == nil:
+		reportf("http-body", "", "%s methods must map the request body, e.g. with body: \"*\"", m.prefix)
+	case !m.body && b.Body != nil && !(m.httpMethod == "DELETE" && l.allowDeleteBody):
+		reportf("http-body", "body", "%s methods must not have a request body", m.prefix)
+	}
+
+	switch {
+	case m.variable == "name" && m.prefix == "Update":
+		if !hasPathParam(b, func(p descriptor.FieldPath) bool { return len(p) == 2 && p[1].Name == "name" }) {
+			reportf("http-uri-name", patternPath(b), "%s methods must map the name field of the resource to a path variable, e.g. {book.name=shelves/*/books/*}", m.prefix)
+		}
+	case m.variable == "name":
+		if !hasPathParam(b, func(p descriptor.FieldPath) bool { return p.String() == "name" }) {
+			reportf("http-uri-name", patternPath(b), "%s methods must map the name field to a path variable, e.g. {name=shelves/*/books/*}", m.prefix)
+		}
+	case m.variable == "parent":
+		// Methods of top-level collections have no parent.
+		if hasField(b.Method.RequestType, "parent") && !hasPathParam(b, func(p descriptor.FieldPath) bool { return p.String() == "parent" }) {
+			reportf("http-uri-parent", patternPath(b), "%s methods must map the parent field to a path variable, e.g. {parent=shelves/*}", m.prefix)
+		}
+	}
+	return findings
+}
+
+// checkCustomMethod checks b against AIP-136.
+func (l *Linter) checkCustomMethod(b *descriptor.Binding) []finding {
+	var findings []finding
+	reportf := func(rule, path, format string, args ...interface{}) {
+		findings = append(findings, finding{
+			ruleID:  "aip-136-" + rule,
+			path:    path,
+			message: fmt.Sprintf(format, args...),
+		})
+	}
+
+	switch verb := b.PathTmpl.Verb; {
+	case verb == "":
+		reportf("http-uri-suffix", patternPath(b), "custom methods must end their path with a :verb suffix, e.g. /v1/{name=shelves/*/books/*}:archive")
+	case !customVerbPattern.MatchString(verb):
+		reportf("http-uri-suffix", patternPath(b), "the verb %q of custom methods must be in lowerCamelCase", verb)
+	}
+
+	switch b.HTTPMethod {
+	case "POST":
+		if b.Body == nil || len(b.Body.FieldPath) > 0 {
+			reportf("http-body", "body", "custom methods using the HTTP POST method must map the whole request to the request body with body: \"*\"")
+		}
+	case "GET":
+	default:
+		reportf("http-method", patternPath(b), "custom methods must use the HTTP POST or GET method, not %s", b.HTTPMethod)
+	}
+	return findings
+}
+
+// patternPath returns the path of the field of the HTTP rule of b which sets
+// its HTTP method and its path template.
+func patternPath(b *descriptor.Binding) string {
+	switch b.HTTPMethod {
+	case "GET", "PUT", "POST", "DELETE", "PATCH":
+		return strings.ToLower(b.HTTPMethod)
+	default:
+		return "custom"
+	}
+}
+
+func hasPathParam(b *descriptor.Binding, match func(descriptor.FieldPath) bool) bool {
+	for _, p := range b.PathParams {
+		if match(p.FieldPath) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasField(msg *descriptor.Message, name string) bool {
+	for _, f := range msg.Fields {
+		if f.GetName() == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,84 @@
+package lint
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/internal/descriptor"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// directivePattern matches the directives disabling rules in comments, e.g.
+// "grpc-gateway-lint:disable=aip-131-http-method,aip-136". A directive without
+// rule IDs disables all the rules.
+var directivePattern = regexp.MustCompile(`grpc-gateway-lint:disable(?:=([\w,-]+))?`)
+
+// suppressions are the IDs of the rules disabled by directives. An empty ID
+// disables all the rules.
+type suppressions []string
+
+func parseSuppressions(comments ...string) suppressions {
+	var s suppressions
+	for _, comment := range comments {
+		for _, match := range directivePattern.FindAllStringSubmatch(comment, -1) {
+			if match[1] == "" {
+				s = append(s, "")
+				continue
+			}
+			for _, id := range strings.Split(match[1], ",") {
+				if id != "" {
+					s = append(s, id)
+				}
+			}
+		}
+	}
+	return s
+}
+
+// disables returns whether ruleID is disabled, either by its ID or by a prefix
+// of its ID, e.g. "aip-131" for "aip-131-http-method".
+func (s suppressions) disables(ruleID string) bool {
+	for _, id := range s {
+		if id == "" || id == ruleID || strings.HasPrefix(ruleID, id+"-") {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	fileSyntaxFieldNumber    = int32((&descriptorpb.FileDescriptorProto{}).ProtoReflect().Descriptor().Fields().ByName("syntax").Number())
+	filePackageFieldNumber   = int32((&descriptorpb.FileDescriptorProto{}).ProtoReflect().Descriptor().Fields().ByName("package").Number())
+	fileServiceFieldNumber   = int32((&descriptorpb.FileDescriptorProto{}).ProtoReflect().Descriptor().Fields().ByName("service").Number())
+	serviceMethodFieldNumber = int32((&descriptorpb.ServiceDescriptorProto{}).ProtoReflect().Descriptor().Fields().ByName("method").Number())
+)
+
+// fileComments returns the comments of the syntax and package statements of
+// file, where the directives applying to the whole file are written.
+func fileComments(file *descriptor.File) []string {
+	var comments []string
+	for _, loc := range file.GetSourceCodeInfo().GetLocation() {
+		if slices.Equal(loc.GetPath(), []int32{fileSyntaxFieldNumber}) || slices.Equal(loc.GetPath(), []int32{filePackageFieldNumber}) {
+			comments = append(comments, loc.GetLeadingComments())
+			comments = append(comments, loc.GetLeadingDetachedComments()...)
+		}
+	}
+	return comments
+}
+
+// methodComments returns the leading and trailing comments of meth.
+func methodComments(file *descriptor.File, svc *descriptor.Service, meth *descriptor.Method) []string {
+	svcIdx := slices.Index(file.GetService(), svc.ServiceDescriptorProto)
+	methIdx := slices.Index(svc.GetMethod(), meth.MethodDescriptorProto)
+	if svcIdx < 0 || methIdx < 0 {
+		return nil
+	}
+	path := []int32{fileServiceFieldNumber, int32(svcIdx), serviceMethodFieldNumber, int32(methIdx)}
+	for _, loc := range file.GetSourceCodeInfo().GetLocation() {
+		if slices.Equal(loc.GetPath(), path) {
+			return []string{loc.GetLeadingComments(), loc.GetTrailingComments()}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+// Command protoc-gen-grpc-gateway-lint is a plugin for Google protocol buffer
+// compiler to check that the HTTP bindings of gRPC methods follow the
+// resource-oriented conventions of the AIPs. It generates no files, and fails
+// with the violations it finds.
+// You rarely need to run this program directly. Instead, put this program
+// into your $PATH with a name "protoc-gen-grpc-gateway-lint" and run
+//
+//	protoc --grpc-gateway-lint_out=output_directory path/to/input.proto
+//
+// See README.md for more details.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"runtime/debug"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/internal/codegenerator"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/internal/descriptor"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-grpc-gateway-lint/internal/lint"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+var (
+	allowDeleteBody      = flag.Bool("allow_delete_body", false, "unless set, HTTP DELETE methods may not have a body")
+	grpcAPIConfiguration = flag.String("grpc_api_configuration", "", "path to gRPC API Configuration in YAML format")
+	warnOnly             = flag.Bool("warn_only", false, "print violations to stderr instead of failing")
+	versionFlag          = flag.Bool("version", false, "print the current version")
+)
+
+// Variables set by goreleaser at build time
+var (
+	version = "dev"
+	commit  = "unknown"
+	date    = "unknown"
+)
+
+func main() {
+	flag.Parse()
+
+	if *versionFlag {
+		if commit == "unknown" {
+			buildInfo, ok := debug.ReadBuildInfo()
+			if ok {
+				version = buildInfo.Main.Version
+				for _, setting := range buildInfo.Settings {
+					if setting.Key == "vcs.revision" {
+						commit = setting.Value
+					}
+					if setting.Key == "vcs.time" {
+						date = setting.Value
+					}
+				}
+			}
+		}
+		fmt.Printf("Version %v, commit %v, built at %v\n", version, commit, date)
+		os.Exit(0)
+	}
+
+	protogen.Options{
+		ParamFunc: flag.CommandLine.Set,
+	}.Run(func(gen *protogen.Plugin) error {
+		reg := descriptor.NewRegistry()
+
+		if err := applyFlags(reg); err != nil {
+			return err
+		}
+
+		codegenerator.SetSupportedFeaturesOnPluginGen(gen)
+
+		if grpclog.V(1) {
+			grpclog.Infof("Parsing code generator request")
+		}
+
+		if err := reg.LoadFromPlugin(gen); err != nil {
+			return err
+		}
+
+		unboundHTTPRules := reg.UnboundExternalHTTPRules()
+		if len(unboundHTTPRules) != 0 {
+			return fmt.Errorf("HTTP rules without a matching selector: %s", strings.Join(unboundHTTPRules, ", "))
+		}
+
+		targets := make([]*descriptor.File, 0, len(gen.Request.FileToGenerate))
+		for _, target := range gen.Request.FileToGenerate {
+			f, err := reg.LookupFile(target)
+			if err != nil {
+				return err
+			}
+			targets = append(targets, f)
+		}
+
+		violations := lint.New(*allowDeleteBody).Lint(targets)
+		if *warnOnly {
+			for _, v := range violations {
+				fmt.Fprintln(os.Stderr, v)
+			}
+			return nil
+		}
+		errs := make([]error, 0, len(violations))
+		for _, v := range violations {
+			errs = append(errs, errors.New(v.String()))
+		}
+		return errors.Join(errs...)
+	})
+}
+
+func applyFlags(reg *descriptor.Registry) error {
+	if *grpcAPIConfiguration != "" {
+		if err := reg.LoadGrpcAPIServiceFromYAML(*grpcAPIConfiguration); err != nil {
+			return err
+		}
+	}
+	// Bodies of DELETE methods are reported by the linter instead, so that
+	// they can be suppressed like the other violations.
+	reg.SetAllowDeleteBody(true)
+	return nil
+}
@@ -0,0 +1,3 @@
+// Package gents provides a code generator for TypeScript clients of grpc
+// gateways, which call the gateways with fetch.
+package gents
@@ -0,0 +1,94 @@
+package gents
+
+import (
+	_ "embed"
+	"errors"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/internal/descriptor"
+	gen "github.com/grpc-ecosystem/grpc-gateway/v2/internal/generator"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+// RuntimeFile is the name of the runtime module the generated files import,
+// which is generated at the root of the output directory.
+const RuntimeFile = "grpc-gateway-fetch.ts"
+
+// runtimeSource is the source of the runtime module, which is the only copy
+// maintained in the repository: the copies next to generated clients, e.g. in
+// examples/internal/clients/ts, are generated from it with runtimeHeader.
+//
+//go:embed grpc-gateway-fetch.ts
+var runtimeSource string
+
+// runtimeHeader is prepended to runtimeSource in the generated runtime module.
+const runtimeHeader = "// Code generated by protoc-gen-grpc-gateway-ts. DO NOT EDIT.\n//\n"
+
+var errNoTargetTypes = errors.New("no messages, enums or services with HTTP bindings defined in the file")
+
+type generator struct {
+	reg             *descriptor.Registry
+	useProtoNames   bool
+	importExtension string
+}
+
+// New returns a new generator which generates TypeScript clients of grpc
+// gateways. If useProtoNames is set, the generated types use the proto names of
+// fields instead of their JSON names, like a gateway whose marshaler sets
+// protojson.MarshalOptions.UseProtoNames. importExtension is appended to the
+// paths of the modules imported by the generated files, e.g. ".js" for
+// projects resolving ES modules like Node.js does.
+func New(reg *descriptor.Registry, useProtoNames bool, importExtension string) gen.Generator {
+	return &generator{
+		reg:             reg,
+		useProtoNames:   useProtoNames,
+		importExtension: importExtension,
+	}
+}
+
+func (g *generator) Generate(targets []*descriptor.File) ([]*descriptor.ResponseFile, error) {
+	var files []*descriptor.ResponseFile
+	var usesRuntime bool
+	for _, file := range targets {
+		if grpclog.V(1) {
+			grpclog.Infof("Processing %s", file.GetName())
+		}
+
+		f := newTSFile(g, file)
+		code, err := applyTemplate(f)
+		if errors.Is(err, errNoTargetTypes) {
+			if grpclog.V(1) {
+				grpclog.Infof("%s: %v", file.GetName(), err)
+			}
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		usesRuntime = usesRuntime || f.usesRuntime
+		files = append(files, &descriptor.ResponseFile{
+			GoPkg: file.GoPkg,
+			CodeGeneratorResponse_File: &pluginpb.CodeGeneratorResponse_File{
+				Name:    proto.String(outputName(file)),
+				Content: proto.String(code),
+			},
+		})
+	}
+	if usesRuntime {
+		files = append(files, &descriptor.ResponseFile{
+			CodeGeneratorResponse_File: &pluginpb.CodeGeneratorResponse_File{
+				Name:    proto.String(RuntimeFile),
+				Content: proto.String(runtimeHeader + runtimeSource),
+			},
+		})
+	}
+	return files, nil
+}
+
+// outputName returns the name of the TypeScript file generated for file, e.g.
+// "dir/foo.pb.gw.ts" for "dir/foo.proto".
+func outputName(file *descriptor.File) string {
+	return strings.TrimSuffix(file.GetName(), ".proto") + ".pb.gw.ts"
+}
@@ -0,0 +1,344 @@
+// Runtime of the TypeScript clients generated by protoc-gen-grpc-gateway-ts,
+// which send the calls of the generated functions to a gateway with fetch.
+
+/* eslint-disable */
+
+/** Options of a call. */
+export interface CallOptions {
+  /**
+   * URL of the gateway, e.g. "https://api.example.com" or
+   * "http://localhost:8080/prefix". The requests are sent to paths relative to
+   * the current origin if it is unset.
+   */
+  baseURL?: string;
+  /** Headers of the request, e.g. "Authorization" or "Grpc-Metadata-*" headers. */
+  headers?: HeadersInit;
+  /** Signal aborting the call. */
+  signal?: AbortSignal;
+  /** Implementation of fetch sending the request. Defaults to the global fetch. */
+  fetch?: typeof fetch;
+}
+
+/** Any is the JSON form of a google.protobuf.Any. */
+export interface Any {
+  "@type": string;
+  [key: string]: unknown;
+}
+
+/** Status is the JSON form of a google.rpc.Status, as returned by the gateway. */
+export interface Status {
+  code?: number;
+  message?: string;
+  details?: Any[];
+}
+
+/** Code holds the gRPC status codes. */
+export const Code = {
+  OK: 0,
+  CANCELLED: 1,
+  UNKNOWN: 2,
+  INVALID_ARGUMENT: 3,
+  DEADLINE_EXCEEDED: 4,
+  NOT_FOUND: 5,
+  ALREADY_EXISTS: 6,
+  PERMISSION_DENIED: 7,
+  RESOURCE_EXHAUSTED: 8,
+  FAILED_PRECONDITION: 9,
+  ABORTED: 10,
+  OUT_OF_RANGE: 11,
+  UNIMPLEMENTED: 12,
+  INTERNAL: 13,
+  UNAVAILABLE: 14,
+  DATA_LOSS: 15,
+  UNAUTHENTICATED: 16,
+} as const;
+
+/**
+ * GatewayError is the error of a call the gateway failed, or of a request
+ * which could not be built.
+ */
+export class GatewayError extends Error {
+  /** The gRPC status code of the error. */
+  readonly code: number;
+  /** The details of the google.rpc.Status returned by the gateway. */
+  readonly details: Any[];
+  /** The HTTP status code of the response, or 0 if no request was sent. */
+  readonly httpStatus: number;
+
+  constructor(status: Status, httpStatus: number) {
+    super(status.message ?? "");
+    this.name = "GatewayError";
+    this.code = status.code ?? Code.UNKNOWN;
+    this.details = status.details ?? [];
+    this.httpStatus = httpStatus;
+  }
+}
+
+/** Request is the HTTP request of a call, as built by the generated functions. */
+export interface Request {
+  method: string;
+  path: string;
+  query?: URLSearchParams;
+  /** The value sent as the JSON body of the request, if it is defined. */
+  body?: unknown;
+}
+
+/**
+ * pathSegment returns the value of the path parameter field bound to a
+ * single path segment, like {name} or {name=*}. Repeated values are joined
+ * with commas.
+ */
+export function pathSegment(value: unknown, field: string): string {
+  return pathValues(value, field).map(encodeURIComponent).join(",");
+}
+
+/**
+ * pathSegments returns the value of the path parameter field bound to
+ * several path segments, like {name=shelves/*} or {name=**}, whose slashes
+ * are kept.
+ */
+export function pathSegments(value: unknown, field: string): string {
+  return pathValues(value, field)
+    .map((v) => v.split("/").map(encodeURIComponent).join("/"))
+    .join(",");
+}
+
+function pathValues(value: unknown, field: string): string[] {
+  const values = (Array.isArray(value) ? value : [value])
+    .filter((v) => v !== undefined && v !== null)
+    .map(String);
+  if (values.length === 0 || (values.length === 1 && values[0] === "")) {
+    throw new GatewayError(
+      { code: Code.INVALID_ARGUMENT, message: `path parameter ${field} is not set` },
+      0,
+    );
+  }
+  return values;
+}
+
+/**
+ * query returns the query parameters of the fields of msg, in the format of
+ * the default query parser of the gateway. The fields at the paths in exclude
+ * are skipped, as they are sent in the path or the body. The fields at the
+ * paths in maps are map fields, sent as "field[key]=value", and the fields at
+ * the paths in json are sent as JSON, like google.protobuf.Struct fields.
+ */
+export function query(msg: object, exclude: string[], maps: string[], json: string[]): URLSearchParams {
+  const params = new URLSearchParams();
+  addQuery(params, "", msg, new Set(exclude), new Set(maps), new Set(json));
+  return params;
+}
+
+function addQuery(
+  params: URLSearchParams,
+  prefix: string,
+  msg: object,
+  exclude: Set<string>,
+  maps: Set<string>,
+  json: Set<string>,
+): void {
+  for (const [key, value] of Object.entries(msg)) {
+    const path = prefix + key;
+    if (value === undefined || value === null || exclude.has(path)) {
+      continue;
+    }
+    if (json.has(path)) {
+      params.append(path, JSON.stringify(value));
+    } else if (maps.has(path) && isObject(value)) {
+      for (const [k, v] of Object.entries(value)) {
+        params.append(`${path}[${k}]`, queryValue(v, path));
+      }
+    } else if (Array.isArray(value)) {
+      for (const v of value) {
+        params.append(path, queryValue(v, path));
+      }
+    } else if (isObject(value)) {
+      addQuery(params, path + ".", value, exclude, maps, json);
+    } else {
+      params.append(path, String(value));
+    }
+  }
+}
+
+function queryValue(value: unknown, path: string): string {
+  if (typeof value === "object" && value !== null) {
+    throw new GatewayError(
+      { code: Code.INVALID_ARGUMENT, message: `field ${path} cannot be sent as a query parameter` },
+      0,
+    );
+  }
+  return String(value);
+}
+
+/**
+ * unary sends the request of a unary call and returns the response message.
+ * responseBody is the path of the response field sent as the body of the
+ * response, if the binding has a response_body.
+ */
+export async function unary<T>(req: Request, opts?: CallOptions, responseBody?: string[]): Promise<T> {
+  const resp = await send(req, opts);
+  const text = await resp.text();
+  return wrap(text === "" ? {} : JSON.parse(text), responseBody) as T;
+}
+
+/**
+ * raw sends the request of a call returning a google.api.HttpBody and returns
+ * the response, whose body is the data of the HttpBody. The chunks of server
+ * streaming calls are separated by newlines.
+ */
+export function raw(req: Request, opts?: CallOptions): Promise<Response> {
+  return send(req, opts);
+}
+
+/**
+ * serverStream sends the request of a server streaming call and yields the
+ * messages of the stream. It throws a GatewayError if the stream ends with an
+ * error. Returning from the iteration early cancels the call.
+ */
+export async function* serverStream<T>(
+  req: Request,
+  opts?: CallOptions,
+  responseBody?: string[],
+): AsyncGenerator<T, void, undefined> {
+  const resp = await send(req, opts);
+  for await (const line of lines(resp)) {
+    const chunk: unknown = JSON.parse(line);
+    if (!isObject(chunk)) {
+      throw new GatewayError({ code: Code.INTERNAL, message: `invalid stream chunk: ${line}` }, resp.status);
+    }
+    if (chunk.error !== undefined) {
+      throw new GatewayError(
+        asStatus(chunk.error) ?? { code: Code.UNKNOWN, message: JSON.stringify(chunk.error) },
+        resp.status,
+      );
+    }
+    yield wrap(chunk.result ?? {}, responseBody) as T;
+  }
+}
+
+async function send(req: Request, opts: CallOptions | undefined): Promise<Response> {
+  const headers = new Headers(opts?.headers);
+  let body: string | undefined;
+  if (req.body !== undefined) {
+    body = JSON.stringify(req.body);
+    if (!headers.has("Content-Type")) {
+      headers.set("Content-Type", "application/json");
+    }
+  }
+  let url = (opts?.baseURL ?? "").replace(/\/+$/, "") + req.path;
+  const search = req.query?.toString() ?? "";
+  if (search !== "") {
+    url += "?" + search;
+  }
+  const f = opts?.fetch ?? fetch;
+  const resp = await f(url, { method: req.method, headers, body, signal: opts?.signal });
+  if (!resp.ok) {
+    throw await responseError(resp);
+  }
+  return resp;
+}
+
+/**
+ * responseError returns the error of a failed call, sent as a
+ * google.rpc.Status by the gateway, or as an error chunk if the call is server
+ * streaming. If the body is neither, the error is derived from the HTTP status
+ * code of the response.
+ */
+async function responseError(resp: Response): Promise<GatewayError> {
+  const text = await resp.text();
+  let status: Status | undefined;
+  try {
+    const parsed: unknown = JSON.parse(text);
+    status = asStatus(isObject(parsed) && parsed.error !== undefined ? parsed.error : parsed);
+  } catch {
+    // The body is not JSON, e.g. if a proxy failed the request.
+  }
+  if (status === undefined) {
+    status = { code: codeFromHTTPStatus(resp.status), message: text.trim() || resp.statusText };
+  }
+  return new GatewayError(status, resp.status);
+}
+
+/**
+ * codeFromHTTPStatus maps HTTP status codes to gRPC codes as specified in
+ * https://github.com/grpc/grpc/blob/master/doc/http-grpc-status-mapping.md.
+ */
+function codeFromHTTPStatus(status: number): number {
+  switch (status) {
+    case 400:
+      return Code.INTERNAL;
+    case 401:
+      return Code.UNAUTHENTICATED;
+    case 403:
+      return Code.PERMISSION_DENIED;
+    case 404:
+      return Code.UNIMPLEMENTED;
+    case 429:
+    case 502:
+    case 503:
+    case 504:
+      return Code.UNAVAILABLE;
+    default:
+      return Code.UNKNOWN;
+  }
+}
+
+function asStatus(value: unknown): Status | undefined {
+  if (!isObject(value) || typeof value.code !== "number" || value.code === Code.OK) {
+    return undefined;
+  }
+  return {
+    code: value.code,
+    message: typeof value.message === "string" ? value.message : undefined,
+    details: Array.isArray(value.details) ? (value.details as Any[]) : undefined,
+  };
+}
+
+/** lines yields the newline-delimited chunks of the body of resp. */
+async function* lines(resp: Response): AsyncGenerator<string, void, undefined> {
+  if (resp.body === null) {
+    return;
+  }
+  const reader = resp.body.getReader();
+  const decoder = new TextDecoder();
+  let buffered = "";
+  try {
+    for (;;) {
+      const { done, value } = await reader.read();
+      if (done) {
+        break;
+      }
+      buffered += decoder.decode(value, { stream: true });
+      let i = buffered.indexOf("\n");
+      while (i >= 0) {
+        const line = buffered.slice(0, i).trim();
+        buffered = buffered.slice(i + 1);
+        if (line !== "") {
+          yield line;
+        }
+        i = buffered.indexOf("\n");
+      }
+    }
+    const line = (buffered + decoder.decode()).trim();
+    if (line !== "") {
+      yield line;
+    }
+  } finally {
+    reader.cancel().catch(() => undefined);
+  }
+}
+
+/** wrap wraps value, the value of the field at path, into its message. */
+function wrap(value: unknown, path: string[] | undefined): unknown {
+  if (path === undefined) {
+    return value;
+  }
+  for (let i = path.length - 1; i >= 0; i--) {
+    value = { [path[i]]: value };
+  }
+  return value;
+}
+
+function isObject(value: unknown): value is Record<string, unknown> {
+  return typeof value === "object" && value !== null && !Array.isArray(value);
+}
@@ -0,0 +1,328 @@
+package gents
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/internal/descriptor"
+	"google.golang.org/grpc/grpclog"
+)
+
+type param struct {
+	Source      string
+	Runtime     string
+	UsesRuntime bool
+	Imports     []tsImport
+	Enums       []tsEnum
+	Messages    []tsMessage
+	Functions   []tsFunction
+}
+
+type tsEnum struct {
+	Name   string
+	Values []string
+}
+
+type tsMessage struct {
+	Name   string
+	Fields []tsField
+}
+
+type tsField struct {
+	Name string
+	Type string
+}
+
+// functionKind is the kind of call a generated function makes, named after
+// the function of the runtime module sending it.
+type functionKind string
+
+const (
+	unaryFunction  functionKind = "unary"
+	streamFunction functionKind = "serverStream"
+	rawFunction    functionKind = "raw"
+)
+
+type tsFunction struct {
+	// Name is the name of the function, e.g. "EchoService_Echo_0".
+	Name string
+	// Doc describes the method and the binding the function calls.
+	Doc          string
+	Kind         functionKind
+	RequestType  string
+	ResponseType string
+	HTTPMethod   string
+	// Path is the body of the template literal of the request path.
+	Path string
+	// Query is set if the fields of the request which are not bound to the
+	// path or the body are sent as query parameters.
+	Query bool
+	// QueryExclude, QueryMaps and QueryJSON are the arguments of gw.query.
+	QueryExclude []string
+	QueryMaps    []string
+	QueryJSON    []string
+	// Body is the expression of the request body, or empty if the request has
+	// no body.
+	Body string
+	// ResponseBody is the path of the response field sent as the response
+	// body, or empty if the response message is the body.
+	ResponseBody []string
+}
+
+func applyTemplate(f *tsFile) (string, error) {
+	p := param{
+		Source:  f.file.GetName(),
+		Runtime: f.importPath(RuntimeFile),
+	}
+	for _, enum := range f.file.Enums {
+		e := tsEnum{Name: localName(enum.Outers, enum.GetName())}
+		for _, v := range enum.GetValue() {
+			e.Values = append(e.Values, strconv.Quote(v.GetName()))
+		}
+		p.Enums = append(p.Enums, e)
+	}
+	for _, msg := range f.file.Messages {
+		if msg.GetOptions().GetMapEntry() {
+			continue
+		}
+		m := tsMessage{Name: localName(msg.Outers, msg.GetName())}
+		for _, field := range msg.Fields {
+			t, err := f.fieldType(field)
+			if err != nil {
+				return "", err
+			}
+			m.Fields = append(m.Fields, tsField{
+				Name: propertyName(f.fieldName(field)),
+				Type: t,
+			})
+		}
+		p.Messages = append(p.Messages, m)
+	}
+	for _, svc := range f.file.Services {
+		for _, meth := range svc.Methods {
+			if grpclog.V(2) {
+				grpclog.Infof("Processing %s.%s", svc.GetName(), meth.GetName())
+			}
+			if meth.GetClientStreaming() {
+				if len(meth.Bindings) != 0 {
+					grpclog.Warningf("%s.%s is client streaming, which the TypeScript client does not support", svc.GetName(), meth.GetName())
+				}
+				continue
+			}
+			for _, b := range meth.Bindings {
+				fn, err := f.function(svc, meth, b)
+				if err != nil {
+					return "", err
+				}
+				if fn != nil {
+					p.Functions = append(p.Functions, *fn)
+				}
+			}
+		}
+	}
+	if len(p.Enums) == 0 && len(p.Messages) == 0 && len(p.Functions) == 0 {
+		return "", errNoTargetTypes
+	}
+	if len(p.Functions) != 0 {
+		f.usesRuntime = true
+	}
+	p.UsesRuntime = f.usesRuntime
+	p.Imports = f.imports
+
+	w := bytes.NewBuffer(nil)
+	if err := fileTemplate.Execute(w, p); err != nil {
+		return "", err
+	}
+	return w.String(), nil
+}
+
+// function returns the function calling meth with the binding b, or nil if
+// the binding cannot be called by the generated client.
+func (f *tsFile) function(svc *descriptor.Service, meth *descriptor.Method, b *descriptor.Binding) (*tsFunction, error) {
+	reqType, err := f.messageType(meth.RequestType.FQMN())
+	if err != nil {
+		return nil, err
+	}
+	wellKnownRequest := wellKnownType(meth.RequestType.FQMN()) != ""
+	if wellKnownRequest && len(b.PathParams) != 0 {
+		grpclog.Warningf("%s.%s binds fields of the well-known type %s to the path, which the TypeScript client does not support",
+			svc.GetName(), meth.GetName(), meth.RequestType.FQMN())
+		return nil, nil
+	}
+
+	fn := &tsFunction{
+		Name:        fmt.Sprintf("%s_%s_%d", svc.GetName(), meth.GetName(), b.Index),
+		Doc:         fmt.Sprintf("%s.%s: %s %s", svc.GetName(), meth.GetName(), b.HTTPMethod, b.PathTmpl.Template),
+		Kind:        unaryFunction,
+		RequestType: reqType,
+		HTTPMethod:  b.HTTPMethod,
+	}
+	switch {
+	case meth.ResponseType.FQMN() == ".google.api.HttpBody" && (b.ResponseBody == nil || len(b.ResponseBody.FieldPath) == 0):
+		fn.Kind = rawFunction
+	case meth.GetServerStreaming():
+		fn.Kind = streamFunction
+	}
+	if fn.Kind != rawFunction {
+		if fn.ResponseType, err = f.messageType(meth.ResponseType.FQMN()); err != nil {
+			return nil, err
+		}
+	}
+
+	if fn.Path, err = f.pathExpr(b); err != nil {
+		return nil, fmt.Errorf("%s.%s: %w", svc.GetName(), meth.GetName(), err)
+	}
+	for _, param := range b.PathParams {
+		fn.QueryExclude = append(fn.QueryExclude, f.fieldPath(param.FieldPath))
+	}
+	switch {
+	case b.Body == nil:
+	case len(b.Body.FieldPath) == 0:
+		fn.Body = "req"
+	default:
+		fn.Body = f.fieldExpr("req", b.Body.FieldPath)
+		fn.QueryExclude = append(fn.QueryExclude, f.fieldPath(b.Body.FieldPath))
+	}
+	// Messages of well-known types have no fields to send as query
+	// parameters, but google.protobuf.Empty requests are still objects.
+	if (!wellKnownRequest || meth.RequestType.FQMN() == ".google.protobuf.Empty") &&
+		(b.Body == nil || len(b.Body.FieldPath) != 0) {
+		fn.Query = true
+		if fn.QueryMaps, fn.QueryJSON, err = f.queryHints(meth.RequestType); err != nil {
+			return nil, err
+		}
+	}
+	if b.ResponseBody != nil {
+		for _, c := range b.ResponseBody.FieldPath {
+			fn.ResponseBody = append(fn.ResponseBody, f.fieldName(c.Target))
+		}
+	}
+	return fn, nil
+}
+
+// fieldPath returns the path of the protojson property of the field at p,
+// e.g. "book.name".
+func (f *tsFile) fieldPath(p descriptor.FieldPath) string {
+	names := make([]string, 0, len(p))
+	for _, c := range p {
+		names = append(names, f.fieldName(c.Target))
+	}
+	return strings.Join(names, ".")
+}
+
+// fieldExpr returns the expression of the value of the field at p of msgExpr,
+// e.g. "req.book?.name".
+func (f *tsFile) fieldExpr(msgExpr string, p descriptor.FieldPath) string {
+	expr := msgExpr
+	for i, c := range p {
+		expr = propertyAccess(expr, f.fieldName(c.Target), i > 0)
+	}
+	return expr
+}
+
+// pathExpr returns the body of the template literal of the path of b, e.g.
+// "/v1/${gw.pathSegments(req.name, "name")}" for "/v1/{name=shelves/*}".
+func (f *tsFile) pathExpr(b *descriptor.Binding) (string, error) {
+	tmpl := b.PathTmpl.Template
+	var w strings.Builder
+	for i := 0; i < len(tmpl); i++ {
+		switch c := tmpl[i]; c {
+		case '{':
+			end := strings.IndexByte(tmpl[i:], '}')
+			if end < 0 {
+				return "", fmt.Errorf("unterminated variable in path template %q", tmpl)
+			}
+			name, pattern, _ := strings.Cut(tmpl[i+1:i+end], "=")
+			i += end
+			var param *descriptor.Parameter
+			for j := range b.PathParams {
+				if b.PathParams[j].FieldPath.String() == name {
+					param = &b.PathParams[j]
+					break
+				}
+			}
+			if param == nil {
+				return "", fmt.Errorf("no path parameter for variable %q of path template %q", name, tmpl)
+			}
+			helper := "pathSegment"
+			if pattern != "" && pattern != "*" {
+				helper = "pathSegments"
+			}
+			fmt.Fprintf(&w, "${gw.%s(%s, %s)}", helper, f.fieldExpr("req", param.FieldPath), strconv.Quote(f.fieldPath(param.FieldPath)))
+		case '`', '\\':
+			w.WriteByte('\\')
+			w.WriteByte(c)
+		case '$':
+			if i+1 < len(tmpl) && tmpl[i+1] == '{' {
+				w.WriteByte('\\')
+			}
+			w.WriteByte(c)
+		default:
+			w.WriteByte(c)
+		}
+	}
+	return w.String(), nil
+}
+
+// stringArray returns the TypeScript array literal of the strings ss.
+func stringArray(ss []string) string {
+	quoted := make([]string, 0, len(ss))
+	for _, s := range ss {
+		quoted = append(quoted, strconv.Quote(s))
+	}
+	return "[" + strings.Join(quoted, ", ") + "]"
+}
+
+// docComment escapes s for use in a JSDoc comment.
+func docComment(s string) string {
+	return strings.ReplaceAll(s, "*/", "*\\/")
+}
+
+var (
+	funcMap = template.FuncMap{
+		"stringArray": stringArray,
+		"docComment":  docComment,
+	}
+
+	fileTemplate = template.Must(template.New("file").Funcs(funcMap).Parse(`// Code generated by protoc-gen-grpc-gateway-ts. DO NOT EDIT.
+// source: {{ .Source }}
+
+/* eslint-disable */
+{{ if .UsesRuntime }}import * as gw from "{{ .Runtime }}";
+{{ end }}{{ range .Imports }}import type * as {{ .Alias }} from "{{ .Path }}";
+{{ end }}{{ range .Enums }}
+export type {{ .Name }} ={{ range .Values }}
+  | {{ . }}{{ end }};
+{{ end }}{{ range .Messages }}
+export interface {{ .Name }} {{ "{" }}{{ range .Fields }}
+  {{ .Name }}?: {{ .Type }};{{ end }}{{ if .Fields }}
+{{ end }}}
+{{ end }}{{ range .Functions }}
+/**
+ * {{ docComment .Doc }}
+ */
+{{ if eq .Kind "serverStream" -}}
+export async function* {{ .Name }}(req: {{ .RequestType }}, opts?: gw.CallOptions): AsyncGenerator<{{ .ResponseType }}, void, undefined> {
+  yield* gw.serverStream<{{ .ResponseType }}>(
+{{- else if eq .Kind "raw" -}}
+export async function {{ .Name }}(req: {{ .RequestType }}, opts?: gw.CallOptions): Promise<Response> {
+  return gw.raw(
+{{- else -}}
+export async function {{ .Name }}(req: {{ .RequestType }}, opts?: gw.CallOptions): Promise<{{ .ResponseType }}> {
+  return gw.unary<{{ .ResponseType }}>(
+{{- end }}
+    {
+      method: "{{ .HTTPMethod }}",
+      path: ` + "`{{ .Path }}`" + `,{{ if .Query }}
+      query: gw.query(req, {{ stringArray .QueryExclude }}, {{ stringArray .QueryMaps }}, {{ stringArray .QueryJSON }}),{{ end }}{{ if .Body }}
+      body: {{ .Body }},{{ end }}
+    },
+    opts,{{ if .ResponseBody }}
+    {{ stringArray .ResponseBody }},{{ end }}
+  );
+}
+{{ end }}`))
+)
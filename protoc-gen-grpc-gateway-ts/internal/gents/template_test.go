@@ -0,0 +1,292 @@
+package gents
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/internal/descriptor"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+func field(name, jsonName string, number int32, typ descriptorpb.FieldDescriptorProto_Type, typeName string) *descriptorpb.FieldDescriptorProto {
+	f := &descriptorpb.FieldDescriptorProto{
+		Name:     proto.String(name),
+		JsonName: proto.String(jsonName),
+		Number:   proto.Int32(number),
+		Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+		Type:     typ.Enum(),
+	}
+	if typeName != "" {
+		f.TypeName = proto.String(typeName)
+	}
+	return f
+}
+
+func repeated(f *descriptorpb.FieldDescriptorProto) *descriptorpb.FieldDescriptorProto {
+	f.Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+	return f
+}
+
+func method(name, in, out string, serverStreaming bool, rule *annotations.HttpRule) *descriptorpb.MethodDescriptorProto {
+	opts := &descriptorpb.MethodOptions{}
+	proto.SetExtension(opts, annotations.E_Http, rule)
+	return &descriptorpb.MethodDescriptorProto{
+		Name:            proto.String(name),
+		InputType:       proto.String(in),
+		OutputType:      proto.String(out),
+		ServerStreaming: proto.Bool(serverStreaming),
+		Options:         opts,
+	}
+}
+
+// newLibraryRegistry returns a registry loaded with "example/v1/library.proto",
+// which defines a library service, and "example/common/author.proto", which
+// defines the Author message library.proto imports.
+func newLibraryRegistry(t *testing.T) *descriptor.Registry {
+	t.Helper()
+	author := &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("example/common/author.proto"),
+		Package: proto.String("example.common"),
+		Syntax:  proto.String("proto3"),
+		Options: &descriptorpb.FileOptions{GoPackage: proto.String("github.com/example/common")},
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name:  proto.String("Author"),
+			Field: []*descriptorpb.FieldDescriptorProto{field("display_name", "displayName", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING, "")},
+		}},
+	}
+	library := &descriptorpb.FileDescriptorProto{
+		Name:       proto.String("example/v1/library.proto"),
+		Package:    proto.String("example.v1"),
+		Syntax:     proto.String("proto3"),
+		Dependency: []string{"google/protobuf/timestamp.proto", "google/protobuf/struct.proto", "example/common/author.proto"},
+		Options:    &descriptorpb.FileOptions{GoPackage: proto.String("github.com/example/v1")},
+		EnumType: []*descriptorpb.EnumDescriptorProto{{
+			Name: proto.String("Genre"),
+			Value: []*descriptorpb.EnumValueDescriptorProto{
+				{Name: proto.String("GENRE_UNSPECIFIED"), Number: proto.Int32(0)},
+				{Name: proto.String("FICTION"), Number: proto.Int32(1)},
+			},
+		}},
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name: proto.String("Book"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("name", "name", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING, ""),
+					field("page_count", "pageCount", 2, descriptorpb.FieldDescriptorProto_TYPE_INT64, ""),
+					field("rating", "rating", 3, descriptorpb.FieldDescriptorProto_TYPE_FLOAT, ""),
+					field("genre", "genre", 4, descriptorpb.FieldDescriptorProto_TYPE_ENUM, ".example.v1.Genre"),
+					repeated(field("chapters", "chapters", 5, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, ".example.v1.Book.Chapter")),
+					repeated(field("labels", "labels", 6, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, ".example.v1.Book.LabelsEntry")),
+					field("create_time", "createTime", 7, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, ".google.protobuf.Timestamp"),
+					field("metadata", "metadata", 8, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, ".google.protobuf.Struct"),
+					field("author", "author", 9, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, ".example.common.Author"),
+					field("in_print", "custom-json", 10, descriptorpb.FieldDescriptorProto_TYPE_BOOL, ""),
+				},
+				NestedType: []*descriptorpb.DescriptorProto{
+					{
+						Name:  proto.String("Chapter"),
+						Field: []*descriptorpb.FieldDescriptorProto{field("title", "title", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING, "")},
+					},
+					{
+						Name: proto.String("LabelsEntry"),
+						Field: []*descriptorpb.FieldDescriptorProto{
+							field("key", "key", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING, ""),
+							field("value", "value", 2, descriptorpb.FieldDescriptorProto_TYPE_STRING, ""),
+						},
+						Options: &descriptorpb.MessageOptions{MapEntry: proto.Bool(true)},
+					},
+				},
+			},
+			{
+				Name: proto.String("UpdateBookRequest"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("book", "book", 1, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, ".example.v1.Book"),
+					field("validate_only", "validateOnly", 2, descriptorpb.FieldDescriptorProto_TYPE_BOOL, ""),
+				},
+			},
+		},
+		Service: []*descriptorpb.ServiceDescriptorProto{{
+			Name: proto.String("LibraryService"),
+			Method: []*descriptorpb.MethodDescriptorProto{
+				method("GetBook", ".example.v1.Book", ".example.v1.Book", false, &annotations.HttpRule{
+					Pattern: &annotations.HttpRule_Get{Get: "/v1/{name=shelves/*/books/*}"},
+					AdditionalBindings: []*annotations.HttpRule{{
+						Pattern: &annotations.HttpRule_Get{Get: "/v1/books/{name}:get"},
+					}},
+				}),
+				method("UpdateBook", ".example.v1.UpdateBookRequest", ".example.v1.Book", false, &annotations.HttpRule{
+					Pattern:      &annotations.HttpRule_Patch{Patch: "/v1/{book.name=shelves/*/books/*}"},
+					Body:         "book",
+					ResponseBody: "author",
+				}),
+				method("CreateBook", ".example.v1.Book", ".example.v1.Book", false, &annotations.HttpRule{
+					Pattern: &annotations.HttpRule_Post{Post: "/v1/books"},
+					Body:    "*",
+				}),
+				method("ListBooks", ".example.v1.UpdateBookRequest", ".example.v1.Book", true, &annotations.HttpRule{
+					Pattern: &annotations.HttpRule_Get{Get: "/v1/books"},
+				}),
+			},
+		}},
+	}
+
+	reg := descriptor.NewRegistry()
+	if err := reg.Load(&pluginpb.CodeGeneratorRequest{
+		ProtoFile: []*descriptorpb.FileDescriptorProto{
+			protodesc.ToFileDescriptorProto(timestamppb.File_google_protobuf_timestamp_proto),
+			protodesc.ToFileDescriptorProto(structpb.File_google_protobuf_struct_proto),
+			author,
+			library,
+		},
+		FileToGenerate: []string{"example/common/author.proto", "example/v1/library.proto"},
+	}); err != nil {
+		t.Fatalf("reg.Load(...) failed with %v; want success", err)
+	}
+	return reg
+}
+
+func generate(t *testing.T, reg *descriptor.Registry, useProtoNames bool) map[string]string {
+	t.Helper()
+	var targets []*descriptor.File
+	for _, name := range []string{"example/common/author.proto", "example/v1/library.proto"} {
+		f, err := reg.LookupFile(name)
+		if err != nil {
+			t.Fatalf("reg.LookupFile(%q) failed with %v; want success", name, err)
+		}
+		targets = append(targets, f)
+	}
+	files, err := New(reg, useProtoNames, "").Generate(targets)
+	if err != nil {
+		t.Fatalf("Generate(...) failed with %v; want success", err)
+	}
+	out := make(map[string]string)
+	for _, f := range files {
+		out[f.GetName()] = f.GetContent()
+	}
+	return out
+}
+
+func TestGenerateFiles(t *testing.T) {
+	files := generate(t, newLibraryRegistry(t), false)
+	for _, name := range []string{"example/common/author.pb.gw.ts", "example/v1/library.pb.gw.ts", RuntimeFile} {
+		if _, ok := files[name]; !ok {
+			t.Errorf("Generate(...) did not generate %q; got %d files", name, len(files))
+		}
+	}
+	if got := files[RuntimeFile]; got != runtimeHeader+runtimeSource {
+		t.Errorf("Generate(...) generated a runtime module different from its source")
+	}
+	if got, want := files["example/common/author.pb.gw.ts"], "import * as gw"; strings.Contains(got, want) {
+		t.Errorf("author.pb.gw.ts = %s; should not import the runtime module", got)
+	}
+}
+
+// TestGenerateExampleRuntime checks that the runtime module of the example
+// clients is the generated one, i.e. that the examples were regenerated with
+// make proto after the runtime changed.
+func TestGenerateExampleRuntime(t *testing.T) {
+	const example = "../../../examples/internal/clients/ts/grpc-gateway-fetch.ts"
+	got, err := os.ReadFile(example)
+	if err != nil {
+		t.Fatalf("os.ReadFile(%q) failed with %v; want success", example, err)
+	}
+	if want := generate(t, newLibraryRegistry(t), false)[RuntimeFile]; string(got) != want {
+		t.Errorf("%s differs from the generated runtime module; run make proto", example)
+	}
+}
+
+func TestGenerateTypes(t *testing.T) {
+	got := generate(t, newLibraryRegistry(t), false)["example/v1/library.pb.gw.ts"]
+	for _, want := range []string{
+		`import * as gw from "../../grpc-gateway-fetch";`,
+		`import type * as author_pb from "../common/author.pb.gw";`,
+		"export type Genre =\n  | \"GENRE_UNSPECIFIED\"\n  | \"FICTION\";",
+		"export interface Book {",
+		"  name?: string;",
+		"  pageCount?: string;",
+		"  rating?: number;",
+		"  genre?: Genre;",
+		"  chapters?: Book_Chapter[];",
+		"  labels?: { [key: string]: string };",
+		"  createTime?: string;",
+		"  metadata?: { [key: string]: unknown };",
+		"  author?: author_pb.Author;",
+		`  "custom-json"?: boolean;`,
+		"export interface Book_Chapter {",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("library.pb.gw.ts = %s; want it to contain %q", got, want)
+		}
+	}
+	if strings.Contains(got, "LabelsEntry") {
+		t.Errorf("library.pb.gw.ts = %s; should not declare map entries", got)
+	}
+}
+
+func TestGenerateFunctions(t *testing.T) {
+	got := generate(t, newLibraryRegistry(t), false)["example/v1/library.pb.gw.ts"]
+	for _, want := range []string{
+		// Multi-segment path variables keep their slashes. The comment
+		// terminator in the path template is escaped.
+		" * LibraryService.GetBook: GET /v1/{name=shelves/*\\/books/*}",
+		"export async function LibraryService_GetBook_0(req: Book, opts?: gw.CallOptions): Promise<Book> {",
+		"path: `/v1/${gw.pathSegments(req.name, \"name\")}`,",
+		`query: gw.query(req, ["name"], ["labels"], ["metadata"]),`,
+		// Additional bindings and single segment variables.
+		"export async function LibraryService_GetBook_1(",
+		"path: `/v1/books/${gw.pathSegment(req.name, \"name\")}:get`,",
+		// Body fields and response bodies.
+		"export async function LibraryService_UpdateBook_0(req: UpdateBookRequest, opts?: gw.CallOptions): Promise<Book> {",
+		"path: `/v1/${gw.pathSegments(req.book?.name, \"book.name\")}`,",
+		`query: gw.query(req, ["book.name", "book"], ["book.labels"], ["book.metadata"]),`,
+		"body: req.book,",
+		`["author"],`,
+		// Whole message bodies have no query parameters.
+		"body: req,",
+		// Server streaming methods.
+		"export async function* LibraryService_ListBooks_0(req: UpdateBookRequest, opts?: gw.CallOptions): AsyncGenerator<Book, void, undefined> {",
+		"yield* gw.serverStream<Book>(",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("library.pb.gw.ts = %s; want it to contain %q", got, want)
+		}
+	}
+	_, createBook, ok := strings.Cut(got, "LibraryService_CreateBook_0")
+	createBook, _, _ = strings.Cut(createBook, "/**")
+	if !ok || strings.Contains(createBook, "gw.query") {
+		t.Errorf("library.pb.gw.ts = %s; CreateBook should not send query parameters", got)
+	}
+}
+
+func TestGenerateUseProtoNames(t *testing.T) {
+	got := generate(t, newLibraryRegistry(t), true)["example/v1/library.pb.gw.ts"]
+	for _, want := range []string{
+		"  page_count?: string;",
+		"  in_print?: boolean;",
+		`query: gw.query(req, ["book.name", "book"], ["book.labels"], ["book.metadata"]),`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("library.pb.gw.ts = %s; want it to contain %q", got, want)
+		}
+	}
+}
+
+func TestPathExprEscapesTemplateLiterals(t *testing.T) {
+	f := &tsFile{}
+	b := &descriptor.Binding{}
+	b.PathTmpl.Template = "/v1/`quoted`/$\\"
+	got, err := f.pathExpr(b)
+	if err != nil {
+		t.Fatalf("pathExpr(%q) failed with %v; want success", b.PathTmpl.Template, err)
+	}
+	if want := "/v1/\\`quoted\\`/$\\\\"; got != want {
+		t.Errorf("pathExpr(%q) = %q; want %q", b.PathTmpl.Template, got, want)
+	}
+}
@@ -0,0 +1,331 @@
+package gents
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/internal/descriptor"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// wellKnownType returns the TypeScript type of the protojson form of the
+// well-known type typeName, matching the schemas protoc-gen-openapiv3 emits for
+// them, or an empty string if typeName is not a well-known type.
+func wellKnownType(typeName string) string {
+	switch typeName {
+	case ".google.protobuf.Timestamp", ".google.protobuf.Duration", ".google.protobuf.FieldMask":
+		return "string"
+	case ".google.protobuf.StringValue", ".google.protobuf.BytesValue":
+		return "string"
+	case ".google.protobuf.Int32Value", ".google.protobuf.UInt32Value",
+		".google.protobuf.FloatValue", ".google.protobuf.DoubleValue":
+		return "number"
+	case ".google.protobuf.Int64Value", ".google.protobuf.UInt64Value":
+		return "string"
+	case ".google.protobuf.BoolValue":
+		return "boolean"
+	case ".google.protobuf.Empty":
+		return "Record<string, never>"
+	case ".google.protobuf.Struct":
+		return "{ [key: string]: unknown }"
+	case ".google.protobuf.Value":
+		return "unknown"
+	case ".google.protobuf.ListValue":
+		return "unknown[]"
+	case ".google.protobuf.NullValue":
+		return "null"
+	case ".google.protobuf.Any":
+		return "gw.Any"
+	}
+	return ""
+}
+
+// jsonEncodedType reports whether the query parameters of fields of type
+// typeName are JSON encoded.
+func jsonEncodedType(typeName string) bool {
+	switch typeName {
+	case ".google.protobuf.Struct", ".google.protobuf.Value", ".google.protobuf.ListValue":
+		return true
+	}
+	return false
+}
+
+// scalarType returns the TypeScript type of the protojson form of scalar
+// fields of type t. 64-bit integers are strings, as protojson encodes them.
+func scalarType(t descriptorpb.FieldDescriptorProto_Type) string {
+	switch t {
+	case descriptorpb.FieldDescriptorProto_TYPE_DOUBLE,
+		descriptorpb.FieldDescriptorProto_TYPE_FLOAT,
+		descriptorpb.FieldDescriptorProto_TYPE_INT32,
+		descriptorpb.FieldDescriptorProto_TYPE_UINT32,
+		descriptorpb.FieldDescriptorProto_TYPE_SINT32,
+		descriptorpb.FieldDescriptorProto_TYPE_FIXED32,
+		descriptorpb.FieldDescriptorProto_TYPE_SFIXED32:
+		return "number"
+	case descriptorpb.FieldDescriptorProto_TYPE_BOOL:
+		return "boolean"
+	default:
+		// 64-bit integers, strings and base64 encoded bytes.
+		return "string"
+	}
+}
+
+var (
+	identifierPattern  = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+	nonIdentifierChars = regexp.MustCompile(`[^A-Za-z0-9_$]`)
+)
+
+// propertyName returns name as a TypeScript property name, quoted if it is not
+// an identifier.
+func propertyName(name string) string {
+	if identifierPattern.MatchString(name) {
+		return name
+	}
+	return strconv.Quote(name)
+}
+
+// propertyAccess returns the expression accessing the property name of expr.
+func propertyAccess(expr, name string, optional bool) string {
+	if identifierPattern.MatchString(name) {
+		if optional {
+			return expr + "?." + name
+		}
+		return expr + "." + name
+	}
+	if optional {
+		return expr + "?.[" + strconv.Quote(name) + "]"
+	}
+	return expr + "[" + strconv.Quote(name) + "]"
+}
+
+// localName returns the name of the TypeScript type of a message or enum in
+// the file it is defined in, e.g. "Outer_Inner".
+func localName(outers []string, name string) string {
+	return strings.Join(append(append([]string(nil), outers...), name), "_")
+}
+
+// tsFile holds the state of the generation of the TypeScript file of a proto
+// file, i.e. the modules it imports.
+type tsFile struct {
+	g       *generator
+	file    *descriptor.File
+	imports []tsImport
+	aliases map[string]string
+	// usesRuntime is set if the file refers to the runtime module.
+	usesRuntime bool
+}
+
+type tsImport struct {
+	Alias string
+	Path  string
+}
+
+func newTSFile(g *generator, file *descriptor.File) *tsFile {
+	return &tsFile{
+		g:       g,
+		file:    file,
+		aliases: make(map[string]string),
+	}
+}
+
+// importPath returns the path of the module generated at name, without its
+// ".ts" extension, relative to the file being generated.
+func (f *tsFile) importPath(name string) string {
+	rel, err := filepath.Rel(filepath.Dir(filepath.FromSlash(outputName(f.file))), filepath.FromSlash(name))
+	if err != nil {
+		// Both paths are relative to the output directory.
+		panic(err)
+	}
+	rel = filepath.ToSlash(rel)
+	if !strings.HasPrefix(rel, "../") {
+		rel = "./" + rel
+	}
+	return strings.TrimSuffix(rel, ".ts") + f.g.importExtension
+}
+
+// importAlias returns the alias of the import of the TypeScript file of the
+// proto file other, adding the import if needed.
+func (f *tsFile) importAlias(other *descriptor.File) string {
+	if alias, ok := f.aliases[other.GetName()]; ok {
+		return alias
+	}
+	// Name the import after the proto file, prefixed with its directory if
+	// another import has the same name, e.g. "sub2_message_pb".
+	name := strings.TrimSuffix(other.GetName(), ".proto")
+	base := nonIdentifierChars.ReplaceAllString(path.Base(name), "_") + "_pb"
+	if dir := path.Base(path.Dir(name)); f.aliasUsed(base) && dir != "." {
+		base = nonIdentifierChars.ReplaceAllString(dir, "_") + "_" + base
+	}
+	alias := base
+	for i := 1; f.aliasUsed(alias); i++ {
+		alias = fmt.Sprintf("%s%d", base, i)
+	}
+	f.aliases[other.GetName()] = alias
+	f.imports = append(f.imports, tsImport{Alias: alias, Path: f.importPath(outputName(other))})
+	return alias
+}
+
+func (f *tsFile) aliasUsed(alias string) bool {
+	if alias == "gw" {
+		return true
+	}
+	for _, imp := range f.imports {
+		if imp.Alias == alias {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldName returns the name of the property of field in the protojson form
+// of its message.
+func (f *tsFile) fieldName(field *descriptor.Field) string {
+	if f.g.useProtoNames || field.JsonName == nil {
+		return field.GetName()
+	}
+	return field.GetJsonName()
+}
+
+// messageType returns the TypeScript type of the message typeName.
+func (f *tsFile) messageType(typeName string) (string, error) {
+	if t := wellKnownType(typeName); t != "" {
+		if strings.HasPrefix(t, "gw.") {
+			f.usesRuntime = true
+		}
+		return t, nil
+	}
+	msg, err := f.g.reg.LookupMsg("", typeName)
+	if err != nil {
+		return "", err
+	}
+	name := localName(msg.Outers, msg.GetName())
+	if msg.File == f.file {
+		return name, nil
+	}
+	return f.importAlias(msg.File) + "." + name, nil
+}
+
+// enumType returns the TypeScript type of the enum typeName.
+func (f *tsFile) enumType(typeName string) (string, error) {
+	if t := wellKnownType(typeName); t != "" {
+		return t, nil
+	}
+	enum, err := f.g.reg.LookupEnum("", typeName)
+	if err != nil {
+		return "", err
+	}
+	name := localName(enum.Outers, enum.GetName())
+	if enum.File == f.file {
+		return name, nil
+	}
+	return f.importAlias(enum.File) + "." + name, nil
+}
+
+// mapEntry returns the map entry message of field, or nil if field is not a
+// map field.
+func (f *tsFile) mapEntry(field *descriptor.Field) (*descriptor.Message, error) {
+	if field.GetType() != descriptorpb.FieldDescriptorProto_TYPE_MESSAGE ||
+		field.GetLabel() != descriptorpb.FieldDescriptorProto_LABEL_REPEATED {
+		return nil, nil
+	}
+	msg, err := f.g.reg.LookupMsg("", field.GetTypeName())
+	if err != nil {
+		return nil, err
+	}
+	if !msg.GetOptions().GetMapEntry() {
+		return nil, nil
+	}
+	return msg, nil
+}
+
+// fieldType returns the TypeScript type of the protojson form of field.
+func (f *tsFile) fieldType(field *descriptor.Field) (string, error) {
+	entry, err := f.mapEntry(field)
+	if err != nil {
+		return "", err
+	}
+	if entry != nil {
+		for _, v := range entry.Fields {
+			if v.GetNumber() == 2 {
+				t, err := f.singularType(v)
+				if err != nil {
+					return "", err
+				}
+				return fmt.Sprintf("{ [key: string]: %s }", t), nil
+			}
+		}
+		return "", fmt.Errorf("map entry %s has no value field", entry.FQMN())
+	}
+
+	t, err := f.singularType(field)
+	if err != nil {
+		return "", err
+	}
+	if field.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED {
+		if identifierPattern.MatchString(strings.ReplaceAll(t, ".", "_")) {
+			return t + "[]", nil
+		}
+		return "Array<" + t + ">", nil
+	}
+	return t, nil
+}
+
+// singularType returns the TypeScript type of a single value of field.
+func (f *tsFile) singularType(field *descriptor.Field) (string, error) {
+	switch field.GetType() {
+	case descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, descriptorpb.FieldDescriptorProto_TYPE_GROUP:
+		return f.messageType(field.GetTypeName())
+	case descriptorpb.FieldDescriptorProto_TYPE_ENUM:
+		return f.enumType(field.GetTypeName())
+	}
+	return scalarType(field.GetType()), nil
+}
+
+// queryHints returns the paths of the map fields and of the JSON encoded
+// fields which may be sent as query parameters of requests of msg.
+func (f *tsFile) queryHints(msg *descriptor.Message) (maps, json []string, err error) {
+	seen := map[string]bool{msg.FQMN(): true}
+	var walk func(msg *descriptor.Message, prefix string) error
+	walk = func(msg *descriptor.Message, prefix string) error {
+		for _, field := range msg.Fields {
+			if field.GetType() != descriptorpb.FieldDescriptorProto_TYPE_MESSAGE {
+				continue
+			}
+			path := prefix + f.fieldName(field)
+			entry, err := f.mapEntry(field)
+			if err != nil {
+				return err
+			}
+			switch {
+			case entry != nil:
+				maps = append(maps, path)
+				continue
+			case jsonEncodedType(field.GetTypeName()):
+				json = append(json, path)
+				continue
+			case wellKnownType(field.GetTypeName()) != "",
+				field.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED,
+				seen[field.GetTypeName()]:
+				continue
+			}
+			child, err := f.g.reg.LookupMsg("", field.GetTypeName())
+			if err != nil {
+				return err
+			}
+			seen[field.GetTypeName()] = true
+			if err := walk(child, path+"."); err != nil {
+				return err
+			}
+			delete(seen, field.GetTypeName())
+		}
+		return nil
+	}
+	if err := walk(msg, ""); err != nil {
+		return nil, nil, err
+	}
+	return maps, json, nil
+}
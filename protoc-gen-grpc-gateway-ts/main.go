@@ -0,0 +1,134 @@
+// Command protoc-gen-grpc-gateway-ts is a plugin for Google protocol buffer
+// compiler to generate TypeScript clients of grpc gateways, made of the types
+// of the JSON messages of the gateway and of functions calling its HTTP
+// bindings with fetch.
+// You rarely need to run this program directly. Instead, put this program
+// into your $PATH with a name "protoc-gen-grpc-gateway-ts" and run
+//
+//	protoc --grpc-gateway-ts_out=output_directory path/to/input.proto
+//
+// See README.md for more details.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"runtime/debug"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/internal/codegenerator"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/internal/descriptor"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-grpc-gateway-ts/internal/gents"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+var (
+	allowDeleteBody        = flag.Bool("allow_delete_body", false, "unless set, HTTP DELETE methods may not have a body")
+	grpcAPIConfiguration   = flag.String("grpc_api_configuration", "", "path to gRPC API Configuration in YAML format")
+	useProtoNames          = flag.Bool("use_proto_names", false, "use the proto names of fields instead of their JSON names, like a gateway marshaling with protojson.MarshalOptions.UseProtoNames")
+	importExtension        = flag.String("import_extension", "", "extension appended to the paths of imported modules, e.g. \".js\" for ES modules resolved like Node.js does")
+	versionFlag            = flag.Bool("version", false, "print the current version")
+	warnOnUnboundMethods   = flag.Bool("warn_on_unbound_methods", false, "emit a warning message if an RPC method has no HttpRule annotation")
+	generateUnboundMethods = flag.Bool("generate_unbound_methods", false, "generate functions calling the default bindings of RPC methods that have no HttpRule annotation")
+)
+
+// Variables set by goreleaser at build time
+var (
+	version = "dev"
+	commit  = "unknown"
+	date    = "unknown"
+)
+
+func main() {
+	flag.Parse()
+
+	if *versionFlag {
+		if commit == "unknown" {
+			buildInfo, ok := debug.ReadBuildInfo()
+			if ok {
+				version = buildInfo.Main.Version
+				for _, setting := range buildInfo.Settings {
+					if setting.Key == "vcs.revision" {
+						commit = setting.Value
+					}
+					if setting.Key == "vcs.time" {
+						date = setting.Value
+					}
+				}
+			}
+		}
+		fmt.Printf("Version %v, commit %v, built at %v\n", version, commit, date)
+		os.Exit(0)
+	}
+
+	protogen.Options{
+		ParamFunc: flag.CommandLine.Set,
+	}.Run(func(gen *protogen.Plugin) error {
+		reg := descriptor.NewRegistry()
+
+		if err := applyFlags(reg); err != nil {
+			return err
+		}
+
+		codegenerator.SetSupportedFeaturesOnPluginGen(gen)
+
+		generator := gents.New(reg, *useProtoNames, *importExtension)
+
+		if grpclog.V(1) {
+			grpclog.Infof("Parsing code generator request")
+		}
+
+		if err := reg.LoadFromPlugin(gen); err != nil {
+			return err
+		}
+
+		unboundHTTPRules := reg.UnboundExternalHTTPRules()
+		if len(unboundHTTPRules) != 0 {
+			return fmt.Errorf("HTTP rules without a matching selector: %s", strings.Join(unboundHTTPRules, ", "))
+		}
+
+		targets := make([]*descriptor.File, 0, len(gen.Request.FileToGenerate))
+		for _, target := range gen.Request.FileToGenerate {
+			f, err := reg.LookupFile(target)
+			if err != nil {
+				return err
+			}
+			targets = append(targets, f)
+		}
+
+		files, err := generator.Generate(targets)
+		for _, f := range files {
+			if grpclog.V(1) {
+				grpclog.Infof("NewGeneratedFile %q in %s", f.GetName(), f.GoPkg)
+			}
+
+			genFile := gen.NewGeneratedFile(f.GetName(), protogen.GoImportPath(f.GoPkg.Path))
+			if _, err := genFile.Write([]byte(f.GetContent())); err != nil {
+				return err
+			}
+		}
+
+		if grpclog.V(1) {
+			grpclog.Info("Processed code generator request")
+		}
+
+		return err
+	})
+}
+
+func applyFlags(reg *descriptor.Registry) error {
+	if *grpcAPIConfiguration != "" {
+		if err := reg.LoadGrpcAPIServiceFromYAML(*grpcAPIConfiguration); err != nil {
+			return err
+		}
+	}
+	if *warnOnUnboundMethods && *generateUnboundMethods {
+		grpclog.Warningf("Option warn_on_unbound_methods has no effect when generate_unbound_methods is used.")
+	}
+	reg.SetAllowDeleteBody(*allowDeleteBody)
+	reg.SetWarnOnUnboundMethods(*warnOnUnboundMethods)
+	reg.SetGenerateUnboundMethods(*generateUnboundMethods)
+	return nil
+}
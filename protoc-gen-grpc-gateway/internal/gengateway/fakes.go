@@ -0,0 +1,166 @@
+package gengateway
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/internal/descriptor"
+)
+
+// fakesParam is the input of fakesTemplate.
+type fakesParam struct {
+	*descriptor.File
+	Imports            []descriptor.GoPackage
+	Services           []*descriptor.Service
+	RegisterFuncSuffix string
+}
+
+// fakesBuildTag is the build tag the fakes are generated behind, so that they
+// are only compiled in the builds of the tests using them, e.g. with
+// go test -tags grpcgateway_fakes.
+const fakesBuildTag = "grpcgateway_fakes"
+
+// fakeImportPaths are the paths of the base imports used by the fakes.
+var fakeImportPaths = map[string]bool{
+	"context":  true,
+	"net/http": true,
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime": true,
+	"google.golang.org/grpc/codes":                      true,
+	"google.golang.org/grpc/status":                     true,
+}
+
+// isFakeMethod returns whether m is implemented by the fake servers, i.e.
+// whether it is a unary method served by the Register{Service}HandlerServer
+// functions.
+func isFakeMethod(m *descriptor.Method) bool {
+	return len(m.Bindings) > 0 && !m.GetClientStreaming() && !m.GetServerStreaming()
+}
+
+// generateFakes returns the code of the fake servers, the test ServeMux
+// constructors and the request builders of the services of file, which has
+// been processed by generate.
+func (g *generator) generateFakes(file *descriptor.File) (string, error) {
+	var imports []descriptor.GoPackage
+	for _, pkg := range g.baseImports {
+		if fakeImportPaths[pkg.Path] {
+			imports = append(imports, pkg)
+		}
+	}
+	if g.standalone {
+		imports = append(imports, file.GoPkg)
+	}
+
+	var services []*descriptor.Service
+	pkgSeen := make(map[string]bool)
+	addImport := func(pkg descriptor.GoPackage) {
+		if pkg == file.GoPkg || pkgSeen[pkg.Path] {
+			return
+		}
+		pkgSeen[pkg.Path] = true
+		imports = append(imports, pkg)
+	}
+	for _, svc := range file.Services {
+		var bound bool
+		for _, m := range svc.Methods {
+			if len(m.Bindings) == 0 {
+				continue
+			}
+			bound = true
+			addImport(m.RequestType.File.GoPkg)
+			if isFakeMethod(m) {
+				addImport(m.ResponseType.File.GoPkg)
+			}
+		}
+		if bound {
+			services = append(services, svc)
+		}
+	}
+
+	w := bytes.NewBuffer(nil)
+	if err := fakesTemplate.Execute(w, fakesParam{
+		File:               file,
+		Imports:            imports,
+		Services:           services,
+		RegisterFuncSuffix: g.registerFuncSuffix,
+	}); err != nil {
+		return "", err
+	}
+	return w.String(), nil
+}
+
+// unimplementedServerName returns the name of the Unimplemented{Service}Server
+// type generated by protoc-gen-go-grpc, with package prefix if needed.
+func unimplementedServerName(s *descriptor.Service) string {
+	name := "Unimplemented" + s.GetName() + "Server"
+	if !s.ForcePrefixedName {
+		return name
+	}
+	return fmt.Sprintf("%s.%s", s.File.Pkg(), name)
+}
+
+var fakesTemplate = template.Must(template.New("fakes").Funcs(template.FuncMap{
+	"isFakeMethod":            isFakeMethod,
+	"routeExpr":               routeExpr,
+	"unimplementedServerName": unimplementedServerName,
+}).Parse(`
+// Code generated by protoc-gen-grpc-gateway. DO NOT EDIT.
+// source: {{ .GetName }}
+
+//go:build ` + fakesBuildTag + `
+
+package {{ .GoPkg.Name }}
+import (
+	{{ range $i := .Imports }}{{ if $i.Standard }}{{ $i | printf "%s\n" }}{{ end }}{{ end }}
+
+	{{ range $i := .Imports }}{{ if not $i.Standard }}{{ $i | printf "%s\n" }}{{ end }}{{ end }}
+)
+
+// Suppress "imported and not used" errors
+var (
+	_ codes.Code
+	_ status.Status
+)
+{{ range $svc := .Services }}
+// Fake{{ $svc.GetName }}Server is a programmable fake of {{ $svc.InstanceName }}Server to test the HTTP bindings of
+// {{ $svc.GetName }} in process, e.g. with New{{ $svc.GetName }}{{ $.RegisterFuncSuffix }}TestMux. Each method calls the function
+// of the field of the same name suffixed with Func, and fails with codes.Unimplemented if it is nil.
+// The streaming methods are not implemented, as Register{{ $svc.GetName }}{{ $.RegisterFuncSuffix }}Server does not serve them.
+type Fake{{ $svc.GetName }}Server struct {
+	{{ unimplementedServerName $svc }}
+	{{- range $m := $svc.Methods }}
+	{{- if isFakeMethod $m }}
+	{{ $m.GetName }}Func func(ctx context.Context, req *{{ $m.RequestType.GoType $m.Service.File.GoPkg.Path }}) (*{{ $m.ResponseType.GoType $m.Service.File.GoPkg.Path }}, error)
+	{{- end }}
+	{{- end }}
+}
+{{ range $m := $svc.Methods }}
+{{- if isFakeMethod $m }}
+// {{ $m.GetName }} calls {{ $m.GetName }}Func.
+func (s *Fake{{ $svc.GetName }}Server) {{ $m.GetName }}(ctx context.Context, req *{{ $m.RequestType.GoType $m.Service.File.GoPkg.Path }}) (*{{ $m.ResponseType.GoType $m.Service.File.GoPkg.Path }}, error) {
+	if s.{{ $m.GetName }}Func == nil {
+		return nil, status.Error(codes.Unimplemented, "method {{ $m.GetName }} not implemented")
+	}
+	return s.{{ $m.GetName }}Func(ctx, req)
+}
+{{ end }}
+{{- end }}
+// New{{ $svc.GetName }}{{ $.RegisterFuncSuffix }}TestMux returns a ServeMux created with "opts" serving the HTTP bindings of
+// {{ $svc.GetName }} with "server" in process, e.g. a Fake{{ $svc.GetName }}Server.
+func New{{ $svc.GetName }}{{ $.RegisterFuncSuffix }}TestMux(server {{ $svc.InstanceName }}Server, opts ...runtime.ServeMuxOption) (*runtime.ServeMux, error) {
+	mux := runtime.NewServeMux(opts...)
+	if err := Register{{ $svc.GetName }}{{ $.RegisterFuncSuffix }}Server(context.Background(), mux, server); err != nil {
+		return nil, err
+	}
+	return mux, nil
+}
+{{ range $m := $svc.Methods }}
+{{- range $b := $m.Bindings }}
+// New{{ $svc.GetName }}_{{ $m.GetName }}_{{ $b.Index }}_Request returns an HTTP request calling {{ $m.GetName }} with "req" through its
+// {{ $b.HTTPMethod }} {{ $b.PathTmpl.Template }} binding.
+func New{{ $svc.GetName }}_{{ $m.GetName }}_{{ $b.Index }}_Request(ctx context.Context, req *{{ $m.RequestType.GoType $m.Service.File.GoPkg.Path }}) (*http.Request, error) {
+	return runtime.NewRouteRequest(ctx, {{ routeExpr $b }}, req)
+}
+{{ end }}
+{{- end }}
+{{- end }}`))
@@ -4,7 +4,9 @@ import (
 	"errors"
 	"fmt"
 	"go/format"
+	"net/http"
 	"path"
+	"strings"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/internal/descriptor"
 	gen "github.com/grpc-ecosystem/grpc-gateway/v2/internal/generator"
@@ -23,11 +25,43 @@ type generator struct {
 	allowPatchFeature  bool
 	standalone         bool
 	useOpaqueAPI       bool
+	routeDescriptors   bool
+	routeManifest      bool
+	connectProtocol    bool
+	fakes              bool
+}
+
+// Options configures the code generated by the generator returned by New.
+type Options struct {
+	// UseRequestContext makes the generated handlers use the context of the
+	// HTTP request.
+	UseRequestContext bool
+	// RegisterFuncSuffix is the suffix of the names of the generated
+	// registration functions, e.g. "Handler".
+	RegisterFuncSuffix string
+	// AllowPatchFeature populates the update mask of PATCH requests with the
+	// fields set in their body.
+	AllowPatchFeature bool
+	// Standalone generates the files in their own package, importing the
+	// package of the messages and services.
+	Standalone bool
+	// UseOpaqueAPI generates code using the opaque API of the messages.
+	UseOpaqueAPI bool
+	// RouteDescriptors declares the route descriptors of the HTTP bindings in
+	// the generated files.
+	RouteDescriptors bool
+	// RouteManifest generates a JSON manifest of the routes of each file.
+	RouteManifest bool
+	// ConnectProtocol serves the Connect protocol calls of the methods with
+	// HTTP bindings.
+	ConnectProtocol bool
+	// Fakes generates fake servers and HTTP request builders for testing the
+	// HTTP bindings of each file.
+	Fakes bool
 }
 
 // New returns a new generator which generates grpc gateway files.
-func New(reg *descriptor.Registry, useRequestContext bool, registerFuncSuffix string,
-	allowPatchFeature, standalone bool, useOpaqueAPI bool) gen.Generator {
+func New(reg *descriptor.Registry, opts Options) gen.Generator {
 	var imports []descriptor.GoPackage
 	for _, pkgpath := range []string{
 		"context",
@@ -63,11 +97,15 @@ func New(reg *descriptor.Registry, useRequestContext bool, registerFuncSuffix st
 	return &generator{
 		reg:                reg,
 		baseImports:        imports,
-		useRequestContext:  useRequestContext,
-		registerFuncSuffix: registerFuncSuffix,
-		allowPatchFeature:  allowPatchFeature,
-		standalone:         standalone,
-		useOpaqueAPI:       useOpaqueAPI,
+		useRequestContext:  opts.UseRequestContext,
+		registerFuncSuffix: opts.RegisterFuncSuffix,
+		allowPatchFeature:  opts.AllowPatchFeature,
+		standalone:         opts.Standalone,
+		useOpaqueAPI:       opts.UseOpaqueAPI,
+		routeDescriptors:   opts.RouteDescriptors,
+		routeManifest:      opts.RouteManifest,
+		connectProtocol:    opts.ConnectProtocol,
+		fakes:              opts.Fakes,
 	}
 }
 
@@ -100,11 +138,47 @@ func (g *generator) Generate(targets []*descriptor.File) ([]*descriptor.Response
 				Content: proto.String(string(formatted)),
 			},
 		})
+		if g.fakes {
+			code, err := g.generateFakes(file)
+			if err != nil {
+				return nil, err
+			}
+			formatted, err := format.Source([]byte(code))
+			if err != nil {
+				grpclog.Errorf("%v: %s", err, code)
+				return nil, err
+			}
+			files = append(files, &descriptor.ResponseFile{
+				GoPkg: file.GoPkg,
+				CodeGeneratorResponse_File: &pluginpb.CodeGeneratorResponse_File{
+					Name:    proto.String(file.GeneratedFilenamePrefix + ".pb.gw.fake.go"),
+					Content: proto.String(string(formatted)),
+				},
+			})
+		}
+		if g.routeManifest {
+			manifest, err := generateRouteManifest(file)
+			if err != nil {
+				return nil, err
+			}
+			files = append(files, &descriptor.ResponseFile{
+				GoPkg: file.GoPkg,
+				CodeGeneratorResponse_File: &pluginpb.CodeGeneratorResponse_File{
+					Name:    proto.String(file.GeneratedFilenamePrefix + ".pb.gw.routes.json"),
+					Content: proto.String(string(manifest)),
+				},
+			})
+		}
 	}
 	return files, nil
 }
 
 func (g *generator) generate(file *descriptor.File) (string, error) {
+	if g.connectProtocol {
+		if err := checkConnectRoutes(file); err != nil {
+			return "", err
+		}
+	}
 	pkgSeen := make(map[string]bool)
 	var imports []descriptor.GoPackage
 	for _, pkg := range g.baseImports {
@@ -118,9 +192,11 @@ func (g *generator) generate(file *descriptor.File) (string, error) {
 
 	for _, svc := range file.Services {
 		for _, m := range svc.Methods {
+			imports = append(imports, g.addDeadlineImports(m, pkgSeen)...)
 			imports = append(imports, g.addEnumPathParamImports(file, m, pkgSeen)...)
 			imports = append(imports, g.addBodyFieldImports(file, m, pkgSeen)...)
 			imports = append(imports, g.addResponseTypeImports(file, m, pkgSeen)...)
+			imports = append(imports, g.addHandlerMethodImports(file, m, pkgSeen)...)
 			pkg := m.RequestType.File.GoPkg
 			if len(m.Bindings) == 0 ||
 				pkg == file.GoPkg || pkgSeen[pkg.Path] {
@@ -137,6 +213,8 @@ func (g *generator) generate(file *descriptor.File) (string, error) {
 		RegisterFuncSuffix: g.registerFuncSuffix,
 		AllowPatchFeature:  g.allowPatchFeature,
 		UseOpaqueAPI:       g.useOpaqueAPI,
+		RouteDescriptors:   g.routeDescriptors,
+		ConnectProtocol:    g.connectProtocol,
 	}
 	if g.reg != nil {
 		params.OmitPackageDoc = g.reg.GetOmitPackageDoc()
@@ -144,6 +222,57 @@ func (g *generator) generate(file *descriptor.File) (string, error) {
 	return applyTemplate(params, g.reg)
 }
 
+// checkConnectRoutes returns an error if an HTTP binding of a method of file
+// is shadowed by the Connect route of the method, which takes precedence over
+// the bindings with the same HTTP method and path. Only the bindings the
+// Connect route serves the same way are allowed: the POST bindings of the whole
+// request and response messages, like the default bindings of unbound methods.
+func checkConnectRoutes(file *descriptor.File) error {
+	for _, svc := range file.Services {
+		for _, m := range connectMethods(svc) {
+			path := fmt.Sprintf("/%s/%s", strings.TrimPrefix(svc.FQSN(), "."), m.GetName())
+			for _, b := range m.Bindings {
+				if b.PathTmpl.Template != path {
+					continue
+				}
+				switch b.HTTPMethod {
+				case http.MethodPost:
+					if b.Body != nil && len(b.Body.FieldPath) == 0 && b.ResponseBody == nil && len(b.PathParams) == 0 {
+						continue
+					}
+				case http.MethodGet:
+					if !noSideEffects(m) {
+						continue
+					}
+				default:
+					continue
+				}
+				return fmt.Errorf("%s: the HTTP binding %s %s is shadowed by the Connect route of the method", strings.TrimPrefix(m.FQMN(), "."), b.HTTPMethod, path)
+			}
+		}
+	}
+	return nil
+}
+
+// addDeadlineImports adds the "time" package if the method has a default deadline.
+func (g *generator) addDeadlineImports(m *descriptor.Method, pkgSeen map[string]bool) []descriptor.GoPackage {
+	if m.Deadline == 0 || len(m.Bindings) == 0 || pkgSeen["time"] {
+		return nil
+	}
+	pkg := descriptor.GoPackage{Path: "time", Name: "time"}
+	if err := g.reg.ReserveGoPackageAlias(pkg.Name, pkg.Path); err != nil {
+		for i := 0; ; i++ {
+			alias := fmt.Sprintf("%s_%d", pkg.Name, i)
+			if err := g.reg.ReserveGoPackageAlias(alias, pkg.Path); err == nil {
+				pkg.Alias = alias
+				break
+			}
+		}
+	}
+	pkgSeen[pkg.Path] = true
+	return []descriptor.GoPackage{pkg}
+}
+
 // addEnumPathParamImports handles adding import of enum path parameter go packages
 func (g *generator) addEnumPathParamImports(file *descriptor.File, m *descriptor.Method, pkgSeen map[string]bool) []descriptor.GoPackage {
 	var imports []descriptor.GoPackage
@@ -181,6 +310,20 @@ func (g *generator) addResponseTypeImports(file *descriptor.File, m *descriptor.
 	return imports
 }
 
+// addHandlerMethodImports adds the response type's Go package for methods with
+// bindings, whose HandlerMethod variables refer to it.
+func (g *generator) addHandlerMethodImports(file *descriptor.File, m *descriptor.Method, pkgSeen map[string]bool) []descriptor.GoPackage {
+	if len(m.Bindings) == 0 {
+		return nil
+	}
+	pkg := m.ResponseType.File.GoPkg
+	if pkg == file.GoPkg || pkgSeen[pkg.Path] {
+		return nil
+	}
+	pkgSeen[pkg.Path] = true
+	return []descriptor.GoPackage{pkg}
+}
+
 // addBodyFieldImports ensures nested body message types pull in their Go packages.
 func (g *generator) addBodyFieldImports(
 	file *descriptor.File,
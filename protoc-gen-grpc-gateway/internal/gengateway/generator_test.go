@@ -1,12 +1,20 @@
 package gengateway
 
 import (
+	"encoding/json"
 	"fmt"
+	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/internal/descriptor"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/internal/httprule"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/genproto/googleapis/api/serviceconfig"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/descriptorpb"
 	"google.golang.org/protobuf/types/pluginpb"
@@ -188,7 +196,7 @@ func TestGenerateResponseBodyImportsResponseTypePackage(t *testing.T) {
 				m.Bindings = []*descriptor.Binding{binding}
 				crossLinkFixture(file)
 
-				g := New(reg, false, "Handler", false, false, useOpaqueAPI)
+				g := New(reg, Options{RegisterFuncSuffix: "Handler", UseOpaqueAPI: useOpaqueAPI})
 				result, err := g.Generate([]*descriptor.File{file})
 				if err != nil {
 					t.Fatalf("failed to generate stubs: %v", err)
@@ -197,7 +205,10 @@ func TestGenerateResponseBodyImportsResponseTypePackage(t *testing.T) {
 					t.Fatalf("expected to generate one file, got: %d", len(result))
 				}
 
-				got := strings.Contains(result[0].GetContent(), strconv.Quote(responsePkg))
+				// The HandlerMethod variables import the response type package
+				// too, see TestGenerateHandlerMethodImportsResponseTypePackage.
+				imports := g.(*generator).addResponseTypeImports(file, m, map[string]bool{})
+				got := slices.ContainsFunc(imports, func(pkg descriptor.GoPackage) bool { return pkg.Path == responsePkg })
 				if got != tc.wantImport {
 					t.Errorf("import of %q present = %t, want %t\n%s", responsePkg, got, tc.wantImport, result[0].GetContent())
 				}
@@ -206,6 +217,32 @@ func TestGenerateResponseBodyImportsResponseTypePackage(t *testing.T) {
 	}
 }
 
+func TestGenerateHandlerMethodImportsResponseTypePackage(t *testing.T) {
+	const responsePkg = "example.com/sub"
+
+	reg, file := buildResponseBodyImportTestFile(t)
+	m := file.Services[0].Methods[0]
+	m.Bindings = []*descriptor.Binding{{HTTPMethod: "GET"}}
+	crossLinkFixture(file)
+
+	g := New(reg, Options{RegisterFuncSuffix: "Handler"})
+	result, err := g.Generate([]*descriptor.File{file})
+	if err != nil {
+		t.Fatalf("failed to generate stubs: %v", err)
+	}
+	if len(result) != 1 {
+		t.Fatalf("expected to generate one file, got: %d", len(result))
+	}
+
+	content := result[0].GetContent()
+	if !strings.Contains(content, strconv.Quote(responsePkg)) {
+		t.Errorf("expected the import of %q for the HandlerMethod variable in:\n%s", responsePkg, content)
+	}
+	if want := "_HandlerMethod = runtime.NewHandlerMethod["; !strings.Contains(content, want) {
+		t.Errorf("expected %q in:\n%s", want, content)
+	}
+}
+
 func buildResponseBodyImportTestFile(t *testing.T) (*descriptor.Registry, *descriptor.File) {
 	t.Helper()
 
@@ -355,3 +392,355 @@ func buildBodyImportTestFile(t *testing.T) (*descriptor.Registry, *descriptor.Fi
 	}
 	return reg, crossLinkFixture(file)
 }
+
+func TestGenerateDefaultTimeout(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		deadline   time.Duration
+		timeTaken  bool
+		want       string
+		wantImport string
+	}{
+		{name: "Unset"},
+		{name: "Seconds", deadline: 30 * time.Second, want: "runtime.WithDefaultTimeout(30*time.Second)", wantImport: strconv.Quote("time")},
+		{name: "Milliseconds", deadline: 2500 * time.Millisecond, want: "runtime.WithDefaultTimeout(2500*time.Millisecond)", wantImport: strconv.Quote("time")},
+		{name: "AliasedTime", deadline: 30 * time.Second, timeTaken: true, want: "runtime.WithDefaultTimeout(30*time_0.Second)", wantImport: `time_0 "time"`},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			file := newExampleFileDescriptorWithGoPkg(&descriptor.GoPackage{
+				Path: "example.com/path/to/example",
+				Name: "example_pb",
+			}, "path/to/example")
+			file.Services[0].Methods[0].Deadline = tc.deadline
+			crossLinkFixture(file)
+
+			reg := descriptor.NewRegistry()
+			if tc.timeTaken {
+				if err := reg.ReserveGoPackageAlias("time", "example.com/path/to/time"); err != nil {
+					t.Fatal(err)
+				}
+			}
+			g := New(reg, Options{RegisterFuncSuffix: "Handler"})
+			result, err := g.Generate([]*descriptor.File{file})
+			if err != nil {
+				t.Fatalf("failed to generate stubs: %v", err)
+			}
+			content := result[0].GetContent()
+			if tc.want == "" {
+				if strings.Contains(content, "WithDefaultTimeout") || strings.Contains(content, strconv.Quote("time")) {
+					t.Errorf("expected no default timeout, got:\n%s", content)
+				}
+				return
+			}
+			if got := strings.Count(content, tc.want); got != 2 {
+				t.Errorf("expected %q in both the client and the server handler, found %d times in:\n%s", tc.want, got, content)
+			}
+			if !strings.Contains(content, tc.wantImport) {
+				t.Errorf("expected the time package to be imported as %s, got:\n%s", tc.wantImport, content)
+			}
+		})
+	}
+}
+
+func TestGenerateSystemParameters(t *testing.T) {
+	file := newExampleFileDescriptorWithGoPkg(&descriptor.GoPackage{
+		Path: "example.com/path/to/example",
+		Name: "example_pb",
+	}, "path/to/example")
+	file.Services[0].Methods[0].SystemParameters = []*serviceconfig.SystemParameter{
+		{Name: "api_key", HttpHeader: "X-API-Key", UrlQueryParameter: "key"},
+	}
+	crossLinkFixture(file)
+
+	g := New(descriptor.NewRegistry(), Options{RegisterFuncSuffix: "Handler"})
+	result, err := g.Generate([]*descriptor.File{file})
+	if err != nil {
+		t.Fatalf("failed to generate stubs: %v", err)
+	}
+	content := result[0].GetContent()
+	want := `runtime.WithSystemParameters(runtime.SystemParameter{Name: "api_key", HTTPHeader: "X-API-Key", URLQueryParameter: "key"})`
+	if got := strings.Count(content, want); got != 2 {
+		t.Errorf("expected %q in both the client and the server handler, found %d times in:\n%s", want, got, content)
+	}
+}
+
+func TestGenerateRoutes(t *testing.T) {
+	reg, file := buildRoutesTestFile(t)
+
+	g := New(reg, Options{RegisterFuncSuffix: "Handler", RouteDescriptors: true, RouteManifest: true})
+	result, err := g.Generate([]*descriptor.File{file})
+	if err != nil {
+		t.Fatalf("failed to generate stubs: %v", err)
+	}
+	if len(result) != 2 {
+		t.Fatalf("expected to generate two files, got: %d", len(result))
+	}
+
+	content := result[0].GetContent()
+	for _, want := range []string{
+		`runtime.Route{HTTPMethod: "POST", PathTemplate: "/v1/things", RPCMethod: "/example.routes.RouteService/Create", Body: "*", ResponseBody: "", Streaming: runtime.StreamingKindUnary}`,
+		`runtime.Route{HTTPMethod: "GET", PathTemplate: "/v1/{name=things/*}:watch", RPCMethod: "/example.routes.RouteService/Watch", Body: "", ResponseBody: "name", Streaming: runtime.StreamingKindServerStreaming}`,
+		"var RouteService_Routes = []runtime.Route{\n\tRouteService_Create_0_Route,\n\tRouteService_Watch_0_Route,\n}",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("expected %q in:\n%s", want, content)
+		}
+	}
+
+	if got, want := result[1].GetName(), "example.com/routes/routes.pb.gw.routes.json"; got != want {
+		t.Fatalf("invalid name %q, expected %q", got, want)
+	}
+	var manifest routeManifest
+	if err := json.Unmarshal([]byte(result[1].GetContent()), &manifest); err != nil {
+		t.Fatalf("json.Unmarshal(%s) failed with %v; want success", result[1].GetContent(), err)
+	}
+	want := routeManifest{
+		File: "routes.proto",
+		Routes: []runtime.Route{
+			{
+				HTTPMethod:   "POST",
+				PathTemplate: "/v1/things",
+				RPCMethod:    "/example.routes.RouteService/Create",
+				Body:         "*",
+				Streaming:    runtime.StreamingKindUnary,
+			},
+			{
+				HTTPMethod:   "GET",
+				PathTemplate: "/v1/{name=things/*}:watch",
+				RPCMethod:    "/example.routes.RouteService/Watch",
+				ResponseBody: "name",
+				Streaming:    runtime.StreamingKindServerStreaming,
+			},
+		},
+	}
+	if !reflect.DeepEqual(manifest, want) {
+		t.Errorf("manifest = %+v; want %+v", manifest, want)
+	}
+	if !strings.Contains(result[1].GetContent(), `"streaming": "server_streaming"`) {
+		t.Errorf("expected the streaming kind by name in:\n%s", result[1].GetContent())
+	}
+}
+
+// buildRoutesTestFile returns a file whose bindings are parsed from their
+// google.api.http options: a whole request body, and a response body field.
+func buildRoutesTestFile(t *testing.T) (*descriptor.Registry, *descriptor.File) {
+	t.Helper()
+
+	createOpts := &descriptorpb.MethodOptions{}
+	proto.SetExtension(createOpts, annotations.E_Http, &annotations.HttpRule{
+		Pattern: &annotations.HttpRule_Post{Post: "/v1/things"},
+		Body:    "*",
+	})
+	watchOpts := &descriptorpb.MethodOptions{}
+	proto.SetExtension(watchOpts, annotations.E_Http, &annotations.HttpRule{
+		Pattern:      &annotations.HttpRule_Get{Get: "/v1/{name=things/*}:watch"},
+		ResponseBody: "name",
+	})
+	file := &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("routes.proto"),
+		Package: proto.String("example.routes"),
+		Syntax:  proto.String("proto3"),
+		Options: &descriptorpb.FileOptions{
+			GoPackage: proto.String("example.com/routes;routespb"),
+		},
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name: proto.String("Thing"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					{
+						Name:     proto.String("name"),
+						JsonName: proto.String("name"),
+						Number:   proto.Int32(1),
+						Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+						Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+					},
+				},
+			},
+		},
+		Service: []*descriptorpb.ServiceDescriptorProto{
+			{
+				Name: proto.String("RouteService"),
+				Method: []*descriptorpb.MethodDescriptorProto{
+					{
+						Name:       proto.String("Create"),
+						InputType:  proto.String(".example.routes.Thing"),
+						OutputType: proto.String(".example.routes.Thing"),
+						Options:    createOpts,
+					},
+					{
+						Name:            proto.String("Watch"),
+						InputType:       proto.String(".example.routes.Thing"),
+						OutputType:      proto.String(".example.routes.Thing"),
+						Options:         watchOpts,
+						ServerStreaming: proto.Bool(true),
+					},
+				},
+			},
+		},
+	}
+
+	reg := descriptor.NewRegistry()
+	if err := reg.Load(&pluginpb.CodeGeneratorRequest{
+		ProtoFile:      []*descriptorpb.FileDescriptorProto{file},
+		FileToGenerate: []string{"routes.proto"},
+	}); err != nil {
+		t.Fatalf("registry load failed: %v", err)
+	}
+	f, err := reg.LookupFile("routes.proto")
+	if err != nil {
+		t.Fatalf("lookup routes file: %v", err)
+	}
+	return reg, f
+}
+
+func TestGenerateWithoutRoutes(t *testing.T) {
+	file := crossLinkFixture(newExampleFileDescriptorWithGoPkg(&descriptor.GoPackage{
+		Path: "example.com/path/to/example",
+		Name: "example_pb",
+	}, "path/to/example"))
+
+	g := New(descriptor.NewRegistry(), Options{RegisterFuncSuffix: "Handler"})
+	result, err := g.Generate([]*descriptor.File{file})
+	if err != nil {
+		t.Fatalf("failed to generate stubs: %v", err)
+	}
+	if len(result) != 1 {
+		t.Fatalf("expected to generate one file, got: %d", len(result))
+	}
+	if content := result[0].GetContent(); strings.Contains(content, "runtime.Route") {
+		t.Errorf("expected no route descriptors, got:\n%s", content)
+	}
+}
+
+func TestGenerateConnectProtocol(t *testing.T) {
+	for _, tc := range []struct {
+		name            string
+		connectProtocol bool
+		serverStreaming bool
+		idempotency     descriptorpb.MethodOptions_IdempotencyLevel
+		want            string
+	}{
+		{name: "Disabled"},
+		{name: "Unary", connectProtocol: true, want: "runtime.HandleConnectUnary(mux, ExampleService_Example_HandlerMethod, options, false, connectClient.Example)"},
+		{name: "NoSideEffects", connectProtocol: true, idempotency: descriptorpb.MethodOptions_NO_SIDE_EFFECTS, want: "runtime.HandleConnectUnary(mux, ExampleService_Example_HandlerMethod, options, true, connectClient.Example)"},
+		{name: "ServerStreaming", connectProtocol: true, serverStreaming: true, want: "runtime.HandleConnectServerStream(mux, ExampleService_Example_HandlerMethod, options, connectClient.Example)"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			file := newExampleFileDescriptorWithGoPkg(&descriptor.GoPackage{
+				Path: "example.com/path/to/example",
+				Name: "example_pb",
+			}, "path/to/example")
+			m := file.Services[0].Methods[0]
+			m.ServerStreaming = proto.Bool(tc.serverStreaming)
+			m.Options = &descriptorpb.MethodOptions{IdempotencyLevel: tc.idempotency.Enum()}
+			crossLinkFixture(file)
+
+			g := New(descriptor.NewRegistry(), Options{RegisterFuncSuffix: "Handler", ConnectProtocol: tc.connectProtocol})
+			result, err := g.Generate([]*descriptor.File{file})
+			if err != nil {
+				t.Fatalf("failed to generate stubs: %v", err)
+			}
+			content := result[0].GetContent()
+			if tc.want == "" {
+				if strings.Contains(content, "HandleConnect") {
+					t.Errorf("expected no Connect handlers, got:\n%s", content)
+				}
+				return
+			}
+			if !strings.Contains(content, tc.want) {
+				t.Errorf("expected %q in:\n%s", tc.want, content)
+			}
+		})
+	}
+}
+
+func TestGenerateConnectProtocolShadowedBinding(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		httpMethod  string
+		body        *descriptor.Body
+		idempotency descriptorpb.MethodOptions_IdempotencyLevel
+		wantErr     bool
+	}{
+		{name: "WholeBody", httpMethod: "POST", body: &descriptor.Body{}},
+		{name: "NoBody", httpMethod: "POST", wantErr: true},
+		{name: "Get", httpMethod: "GET"},
+		{name: "GetNoSideEffects", httpMethod: "GET", idempotency: descriptorpb.MethodOptions_NO_SIDE_EFFECTS, wantErr: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			file := newExampleFileDescriptorWithGoPkg(&descriptor.GoPackage{
+				Path: "example.com/path/to/example",
+				Name: "example_pb",
+			}, "path/to/example")
+			m := file.Services[0].Methods[0]
+			m.Options = &descriptorpb.MethodOptions{IdempotencyLevel: tc.idempotency.Enum()}
+			m.Bindings[0].HTTPMethod = tc.httpMethod
+			m.Bindings[0].Body = tc.body
+			m.Bindings[0].PathTmpl = httprule.Template{Template: "/example.ExampleService/Example"}
+			crossLinkFixture(file)
+
+			g := New(descriptor.NewRegistry(), Options{RegisterFuncSuffix: "Handler", ConnectProtocol: true})
+			_, err := g.Generate([]*descriptor.File{file})
+			if got := err != nil; got != tc.wantErr {
+				t.Errorf("g.Generate() = %v; want an error: %t", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestGenerateFakes(t *testing.T) {
+	file := newExampleFileDescriptorWithGoPkg(&descriptor.GoPackage{
+		Path: "example.com/path/to/example",
+		Name: "example_pb",
+	}, "path/to/example")
+	file.Services[0].Methods[0].Bindings[0].PathTmpl = httprule.Template{Template: "/v1/example"}
+	crossLinkFixture(file)
+
+	g := New(descriptor.NewRegistry(), Options{RegisterFuncSuffix: "Handler", Fakes: true})
+	result, err := g.Generate([]*descriptor.File{file})
+	if err != nil {
+		t.Fatalf("failed to generate stubs: %v", err)
+	}
+	if len(result) != 2 {
+		t.Fatalf("expected to generate two files, got: %d", len(result))
+	}
+	if got, want := result[1].GetName(), "path/to/example.pb.gw.fake.go"; got != want {
+		t.Fatalf("invalid name %q, expected %q", got, want)
+	}
+	content := result[1].GetContent()
+	for _, want := range []string{
+		"//go:build grpcgateway_fakes\n\npackage example_pb",
+		"type FakeExampleServiceServer struct {\n\tUnimplementedExampleServiceServer\n\tExampleFunc func(ctx context.Context, req *ExampleMessage) (*ExampleMessage, error)\n}",
+		"func (s *FakeExampleServiceServer) Example(ctx context.Context, req *ExampleMessage) (*ExampleMessage, error) {",
+		"func NewExampleServiceHandlerTestMux(server ExampleServiceServer, opts ...runtime.ServeMuxOption) (*runtime.ServeMux, error) {",
+		"if err := RegisterExampleServiceHandlerServer(context.Background(), mux, server); err != nil {",
+		"func NewExampleService_Example_0_Request(ctx context.Context, req *ExampleMessage) (*http.Request, error) {",
+		`return runtime.NewRouteRequest(ctx, runtime.Route{HTTPMethod: "GET", PathTemplate: "/v1/example", RPCMethod: "/example.ExampleService/Example", Body: "*", ResponseBody: "", Streaming: runtime.StreamingKindUnary}, req)`,
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("expected %q in:\n%s", want, content)
+		}
+	}
+}
+
+func TestGenerateFakesServerStreaming(t *testing.T) {
+	file := newExampleFileDescriptorWithGoPkg(&descriptor.GoPackage{
+		Path: "example.com/path/to/example",
+		Name: "example_pb",
+	}, "path/to/example")
+	file.Services[0].Methods[0].ServerStreaming = proto.Bool(true)
+	crossLinkFixture(file)
+
+	g := New(descriptor.NewRegistry(), Options{RegisterFuncSuffix: "Handler", Fakes: true})
+	result, err := g.Generate([]*descriptor.File{file})
+	if err != nil {
+		t.Fatalf("failed to generate stubs: %v", err)
+	}
+	content := result[1].GetContent()
+	if strings.Contains(content, "ExampleFunc") {
+		t.Errorf("expected the streaming method not to be faked, got:\n%s", content)
+	}
+	if !strings.Contains(content, "func NewExampleService_Example_0_Request(") {
+		t.Errorf("expected a request builder for the streaming method, got:\n%s", content)
+	}
+}
@@ -0,0 +1,94 @@
+package gengateway
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/internal/descriptor"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+)
+
+// routeManifest is the content of the route manifest of a proto file.
+type routeManifest struct {
+	// File is the name of the proto file.
+	File   string          `json:"file"`
+	Routes []runtime.Route `json:"routes"`
+}
+
+var streamingKindExprs = map[runtime.StreamingKind]string{
+	runtime.StreamingKindUnary:           "runtime.StreamingKindUnary",
+	runtime.StreamingKindClientStreaming: "runtime.StreamingKindClientStreaming",
+	runtime.StreamingKindServerStreaming: "runtime.StreamingKindServerStreaming",
+	runtime.StreamingKindBidiStreaming:   "runtime.StreamingKindBidiStreaming",
+}
+
+// routeOf returns the route descriptor of the binding b.
+func routeOf(b *descriptor.Binding) runtime.Route {
+	m := b.Method
+	r := runtime.Route{
+		HTTPMethod:   b.HTTPMethod,
+		PathTemplate: b.PathTmpl.Template,
+		RPCMethod:    fmt.Sprintf("/%s.%s/%s", m.Service.File.GetPackage(), m.Service.GetName(), m.GetName()),
+		Body:         bodyPath(b.Body),
+		ResponseBody: responseBodyPath(b.ResponseBody),
+	}
+	switch {
+	case m.GetClientStreaming() && m.GetServerStreaming():
+		r.Streaming = runtime.StreamingKindBidiStreaming
+	case m.GetClientStreaming():
+		r.Streaming = runtime.StreamingKindClientStreaming
+	case m.GetServerStreaming():
+		r.Streaming = runtime.StreamingKindServerStreaming
+	}
+	return r
+}
+
+// bodyPath returns the field path of the body b as written in the
+// google.api.http option.
+func bodyPath(b *descriptor.Body) string {
+	switch {
+	case b == nil:
+		return ""
+	case len(b.FieldPath) == 0:
+		return "*"
+	}
+	return b.FieldPath.String()
+}
+
+// responseBodyPath returns the field path of the response body b as written in
+// the google.api.http option, or an empty path if the whole response is the
+// body.
+func responseBodyPath(b *descriptor.Body) string {
+	if b == nil {
+		return ""
+	}
+	return b.FieldPath.String()
+}
+
+// routeExpr returns a Go expression of the route descriptor of the binding b.
+func routeExpr(b *descriptor.Binding) string {
+	r := routeOf(b)
+	return fmt.Sprintf("runtime.Route{HTTPMethod: %q, PathTemplate: %q, RPCMethod: %q, Body: %q, ResponseBody: %q, Streaming: %s}",
+		r.HTTPMethod, r.PathTemplate, r.RPCMethod, r.Body, r.ResponseBody, streamingKindExprs[r.Streaming])
+}
+
+// generateRouteManifest returns the route manifest of the bindings of the
+// services of file.
+func generateRouteManifest(file *descriptor.File) ([]byte, error) {
+	manifest := routeManifest{
+		File:   file.GetName(),
+		Routes: []runtime.Route{},
+	}
+	for _, svc := range file.Services {
+		for _, m := range svc.Methods {
+			for _, b := range m.Bindings {
+				manifest.Routes = append(manifest.Routes, routeOf(b))
+			}
+		}
+	}
+	buf, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(buf, '\n'), nil
+}
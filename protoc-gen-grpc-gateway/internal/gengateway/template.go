@@ -7,10 +7,12 @@ import (
 	"net/http"
 	"strings"
 	"text/template"
+	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/internal/casing"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/internal/descriptor"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	"google.golang.org/genproto/googleapis/api/serviceconfig"
 	"google.golang.org/grpc/grpclog"
 	"google.golang.org/protobuf/types/descriptorpb"
 )
@@ -23,6 +25,8 @@ type param struct {
 	AllowPatchFeature  bool
 	OmitPackageDoc     bool
 	UseOpaqueAPI       bool
+	RouteDescriptors   bool
+	ConnectProtocol    bool
 }
 
 type binding struct {
@@ -100,6 +104,11 @@ func (b binding) QueryParamFilter() queryParamFilter {
 	for _, p := range b.PathParams {
 		seqs = append(seqs, strings.Split(p.FieldPath.String(), "."))
 	}
+	for _, p := range b.Method.SystemParameters {
+		if q := p.GetUrlQueryParameter(); q != "" {
+			seqs = append(seqs, []string{q})
+		}
+	}
 	return queryParamFilter{utilities.NewDoubleArray(seqs)}
 }
 
@@ -174,6 +183,10 @@ type trailerParams struct {
 	UseRequestContext  bool
 	RegisterFuncSuffix string
 	UseOpaqueAPI       bool
+	RouteDescriptors   bool
+	ConnectProtocol    bool
+	// TimePackage is the name the "time" package is imported as, if it is.
+	TimePackage string
 }
 
 func applyTemplate(p param, reg *descriptor.Registry) (string, error) {
@@ -238,6 +251,16 @@ func applyTemplate(p param, reg *descriptor.Registry) (string, error) {
 		UseRequestContext:  p.UseRequestContext,
 		RegisterFuncSuffix: p.RegisterFuncSuffix,
 		UseOpaqueAPI:       p.UseOpaqueAPI,
+		RouteDescriptors:   p.RouteDescriptors,
+		ConnectProtocol:    p.ConnectProtocol,
+	}
+	for _, pkg := range p.Imports {
+		if pkg.Path == "time" {
+			tp.TimePackage = pkg.Name
+			if pkg.Alias != "" {
+				tp.TimePackage = pkg.Alias
+			}
+		}
 	}
 	// Local
 	if err := localTrailerTemplate.Execute(w, tp); err != nil {
@@ -250,6 +273,54 @@ func applyTemplate(p param, reg *descriptor.Registry) (string, error) {
 	return w.String(), nil
 }
 
+// systemParametersExpr returns a Go expression of the runtime.WithSystemParameters
+// option for params.
+func systemParametersExpr(params []*serviceconfig.SystemParameter) string {
+	exprs := make([]string, 0, len(params))
+	for _, p := range params {
+		exprs = append(exprs, fmt.Sprintf("runtime.SystemParameter{Name: %q, HTTPHeader: %q, URLQueryParameter: %q}", p.GetName(), p.GetHttpHeader(), p.GetUrlQueryParameter()))
+	}
+	return fmt.Sprintf("runtime.WithSystemParameters(%s)", strings.Join(exprs, ", "))
+}
+
+// durationExpr returns a Go expression of type time.Duration for d, using the
+// largest unit that represents it exactly and timePkg, the name the "time"
+// package is imported as.
+func durationExpr(d time.Duration, timePkg string) string {
+	for _, u := range []struct {
+		unit time.Duration
+		name string
+	}{
+		{time.Hour, "Hour"},
+		{time.Minute, "Minute"},
+		{time.Second, "Second"},
+		{time.Millisecond, "Millisecond"},
+		{time.Microsecond, "Microsecond"},
+	} {
+		if d%u.unit == 0 {
+			return fmt.Sprintf("%d * %s.%s", d/u.unit, timePkg, u.name)
+		}
+	}
+	return fmt.Sprintf("%d * %s.Nanosecond", d, timePkg)
+}
+
+// noSideEffects reports whether the idempotency level of m is NO_SIDE_EFFECTS.
+func noSideEffects(m *descriptor.Method) bool {
+	return m.GetOptions().GetIdempotencyLevel() == descriptorpb.MethodOptions_NO_SIDE_EFFECTS
+}
+
+// connectMethods returns the methods of svc served to Connect clients: the
+// unary and server streaming methods with HTTP bindings.
+func connectMethods(svc *descriptor.Service) []*descriptor.Method {
+	var methods []*descriptor.Method
+	for _, m := range svc.Methods {
+		if len(m.Bindings) > 0 && !m.GetClientStreaming() {
+			methods = append(methods, m)
+		}
+	}
+	return methods
+}
+
 var (
 	httpMethods = map[string]string{
 		http.MethodGet:     "http.MethodGet",
@@ -358,12 +429,17 @@ func request_{{ .Method.Service.GetName }}_{{ .Method.GetName }}_{{ .Index }}(ct
 
 	funcMap template.FuncMap = map[string]interface{}{
 		"camelIdentifier": casing.CamelIdentifier,
+		"routeExpr":       routeExpr,
 		"opaqueSetter": func(p descriptor.FieldPath, msgExpr string) string {
 			return p.OpaqueSetterExpr(msgExpr)
 		},
 		"toHTTPMethod": func(method string) string {
 			return httpMethods[method]
 		},
+		"durationExpr":         durationExpr,
+		"systemParametersExpr": systemParametersExpr,
+		"noSideEffects":        noSideEffects,
+		"connectMethods":       connectMethods,
 	}
 
 	_ = template.Must(handlerTemplate.New("client-rpc-request-func").Funcs(funcMap).Parse(`
@@ -782,7 +858,7 @@ func Register{{ $svc.GetName }}{{ $.RegisterFuncSuffix }}Server(ctx context.Cont
 		_, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 		return
-	})
+	}, runtime.WithRPCMethod("/{{ $svc.File.GetPackage }}.{{ $svc.GetName }}/{{ $m.GetName }}"))
 	{{- else -}}
 	mux.Handle({{ $b.HTTPMethod | toHTTPMethod}}, pattern_{{ $svc.GetName }}_{{ $m.GetName }}_{{ $b.Index }}, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 	{{- if $UseRequestContext }}
@@ -795,14 +871,15 @@ func Register{{ $svc.GetName }}{{ $.RegisterFuncSuffix }}Server(ctx context.Cont
 		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		{{- if $b.PathTmpl }}
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/{{ $svc.File.GetPackage }}.{{ $svc.GetName }}/{{ $m.GetName }}", runtime.WithHTTPPathPattern("{{ $b.PathTmpl.Template }}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/{{ $svc.File.GetPackage }}.{{ $svc.GetName }}/{{ $m.GetName }}", runtime.WithHTTPPathPattern("{{ $b.PathTmpl.Template }}"){{ if $m.Deadline }}, runtime.WithDefaultTimeout({{ durationExpr $m.Deadline $.TimePackage }}){{ end }}{{ if $m.SystemParameters }}, {{ $m.SystemParameters | systemParametersExpr }}{{ end }})
 		{{- else -}}
-		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/{{ $svc.File.GetPackage }}.{{ $svc.GetName }}/{{ $m.GetName }}")
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateIncomingContextWithCancel(ctx, mux, req, "/{{ $svc.File.GetPackage }}.{{ $svc.GetName }}/{{ $m.GetName }}"{{ if $m.Deadline }}, runtime.WithDefaultTimeout({{ durationExpr $m.Deadline $.TimePackage }}){{ end }}{{ if $m.SystemParameters }}, {{ $m.SystemParameters | systemParametersExpr }}{{ end }})
 		{{- end }}
 		if err != nil {
 			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := local_request_{{ $svc.GetName }}_{{ $m.GetName }}_{{ $b.Index }}(annotatedContext, inboundMarshaler, server, req, pathParams)
 		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
@@ -815,7 +892,7 @@ func Register{{ $svc.GetName }}{{ $.RegisterFuncSuffix }}Server(ctx context.Cont
 		{{- else }}
 		forward_{{ $svc.GetName }}_{{ $m.GetName }}_{{ $b.Index }}(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 		{{- end }}
-	})
+	}, runtime.WithRPCMethod("/{{ $svc.File.GetPackage }}.{{ $svc.GetName }}/{{ $m.GetName }}"))
 	{{- end }}
 	{{ end }}
 	{{- end }}
@@ -863,6 +940,19 @@ func Register{{ $svc.GetName }}{{ $.RegisterFuncSuffix }}(ctx context.Context, m
 // doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
 // "{{ $svc.InstanceName }}Client" to call the correct interceptors. This client ignores the HTTP middlewares.
 func Register{{ $svc.GetName }}{{ $.RegisterFuncSuffix }}Client(ctx context.Context, mux *runtime.ServeMux, client {{ $svc.InstanceName }}Client) error {
+	return Register{{ $svc.GetName }}{{ $.RegisterFuncSuffix }}ClientWithOptions(ctx, mux, client)
+}
+
+// Register{{ $svc.GetName }}{{ $.RegisterFuncSuffix }}ClientWithOptions is same as Register{{ $svc.GetName }}{{ $.RegisterFuncSuffix }}Client but
+// customizes the handlers of the methods of {{ $svc.GetName }} with "opts", whose methods are identified by the
+// {{ $svc.GetName }}_{Method}_HandlerMethod variables.
+func Register{{ $svc.GetName }}{{ $.RegisterFuncSuffix }}ClientWithOptions(ctx context.Context, mux *runtime.ServeMux, client {{ $svc.InstanceName }}Client, opts ...runtime.HandlerOption) error {
+	options := runtime.NewHandlerOptions(opts...)
+	{{- if and $.ConnectProtocol (connectMethods $svc) }}
+	// The Connect handlers call the request mutators themselves.
+	connectClient := client
+	{{- end }}
+	client = handlerClient_{{ $svc.GetName }}{ {{- $svc.GetName }}Client: client, options: options}
 	{{- range $m := $svc.Methods }}
 	{{- range $b := $m.Bindings }}
 	mux.Handle({{ $b.HTTPMethod | toHTTPMethod }}, pattern_{{ $svc.GetName }}_{{ $m.GetName }}_{{ $b.Index }}, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
@@ -872,44 +962,88 @@ func Register{{ $svc.GetName }}{{ $.RegisterFuncSuffix }}Client(ctx context.Cont
 		ctx, cancel := context.WithCancel(ctx)
 	{{- end }}
 		defer cancel()
+		methodOptions := options.Method({{ $svc.GetName }}_{{ $m.GetName }}_HandlerMethod.FullMethod())
 		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
 		{{- if $b.PathTmpl }}
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/{{ $svc.File.GetPackage }}.{{ $svc.GetName }}/{{ $m.GetName }}", runtime.WithHTTPPathPattern("{{ $b.PathTmpl.Template}}"))
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/{{ $svc.File.GetPackage }}.{{ $svc.GetName }}/{{ $m.GetName }}", runtime.WithHTTPPathPattern("{{ $b.PathTmpl.Template}}"){{ if $m.Deadline }}, runtime.WithDefaultTimeout({{ durationExpr $m.Deadline $.TimePackage }}){{ end }}{{ if $m.SystemParameters }}, {{ $m.SystemParameters | systemParametersExpr }}{{ end }})
 		{{- else -}}
-		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/{{ $svc.File.GetPackage }}.{{ $svc.GetName }}/{{ $m.GetName }}")
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, "/{{ $svc.File.GetPackage }}.{{ $svc.GetName }}/{{ $m.GetName }}"{{ if $m.Deadline }}, runtime.WithDefaultTimeout({{ durationExpr $m.Deadline $.TimePackage }}){{ end }}{{ if $m.SystemParameters }}, {{ $m.SystemParameters | systemParametersExpr }}{{ end }})
 		{{- end }}
 		if err != nil {
-			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
 			return
 		}
+		defer cancelAnnotated()
 		resp, md, err := request_{{ $svc.GetName }}_{{ $m.GetName }}_{{ $b.Index }}(annotatedContext, inboundMarshaler, client, req, pathParams)
 		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
 		if err != nil {
-			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
 			return
 		}
 		{{- if $m.GetServerStreaming }}
-		{{- if $b.ResponseBody }}
-		forward_{{ $svc.GetName }}_{{ $m.GetName }}_{{ $b.Index }}(annotatedContext, mux, outboundMarshaler, w, req, func() (proto.Message, error) {
+		methodOptions.ForwardResponseStream(forward_{{ $svc.GetName }}_{{ $m.GetName }}_{{ $b.Index }})(annotatedContext, mux, outboundMarshaler, w, req, func() (proto.Message, error) {
 			res, err := resp.Recv()
-			return response_{{ $svc.GetName }}_{{ $m.GetName }}_{{ $b.Index }}{res}, err
+			if err != nil {
+				return res, err
+			}
+			{{- if $b.ResponseBody }}
+			rewritten, err := methodOptions.RewriteResponse(annotatedContext, res)
+			if err != nil {
+				return nil, err
+			}
+			return response_{{ $svc.GetName }}_{{ $m.GetName }}_{{ $b.Index }}{rewritten.(*{{ $m.ResponseType.GoType $m.Service.File.GoPkg.Path }})}, nil
+			{{- else }}
+			return methodOptions.RewriteResponse(annotatedContext, res)
+			{{- end }}
 		}, mux.GetForwardResponseOptions()...)
 		{{- else }}
-		forward_{{ $svc.GetName }}_{{ $m.GetName }}_{{ $b.Index }}(annotatedContext, mux, outboundMarshaler, w, req, func() (proto.Message, error) { return resp.Recv() }, mux.GetForwardResponseOptions()...)
-		{{- end }}
-		{{- else }}
+		resp, err = methodOptions.RewriteResponse(annotatedContext, resp)
+		if err != nil {
+			methodOptions.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
 		{{- if $b.ResponseBody }}
-		forward_{{ $svc.GetName }}_{{ $m.GetName }}_{{ $b.Index }}(annotatedContext, mux, outboundMarshaler, w, req, response_{{ $svc.GetName }}_{{ $m.GetName }}_{{ $b.Index }}{resp.(*{{ $m.ResponseType.GoType $m.Service.File.GoPkg.Path }})}, mux.GetForwardResponseOptions()...)
+		methodOptions.ForwardResponseMessage(forward_{{ $svc.GetName }}_{{ $m.GetName }}_{{ $b.Index }})(annotatedContext, mux, outboundMarshaler, w, req, response_{{ $svc.GetName }}_{{ $m.GetName }}_{{ $b.Index }}{resp.(*{{ $m.ResponseType.GoType $m.Service.File.GoPkg.Path }})}, mux.GetForwardResponseOptions()...)
 		{{- else }}
-		forward_{{ $svc.GetName }}_{{ $m.GetName }}_{{ $b.Index }}(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		methodOptions.ForwardResponseMessage(forward_{{ $svc.GetName }}_{{ $m.GetName }}_{{ $b.Index }})(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 		{{- end }}
 		{{- end }}
-	})
+	}, runtime.WithRPCMethod("/{{ $svc.File.GetPackage }}.{{ $svc.GetName }}/{{ $m.GetName }}"))
+	{{- end }}
+	{{- end }}
+	{{- if $.ConnectProtocol }}
+	{{- range $m := connectMethods $svc }}
+	{{- if $m.GetServerStreaming }}
+	runtime.HandleConnectServerStream(mux, {{ $svc.GetName }}_{{ $m.GetName }}_HandlerMethod, options, connectClient.{{ $m.GetName }})
+	{{- else }}
+	runtime.HandleConnectUnary(mux, {{ $svc.GetName }}_{{ $m.GetName }}_HandlerMethod, options, {{ $m | noSideEffects }}, connectClient.{{ $m.GetName }})
+	{{- end }}
 	{{- end }}
 	{{- end }}
 	return nil
 }
 
+// handlerClient_{{ $svc.GetName }} calls the request mutators of the options given to
+// Register{{ $svc.GetName }}{{ $.RegisterFuncSuffix }}ClientWithOptions before sending requests.
+type handlerClient_{{ $svc.GetName }} struct {
+	{{ $svc.InstanceName }}Client
+	options *runtime.HandlerOptions
+}
+{{ range $m := $svc.Methods }}
+{{- if and $m.Bindings (not $m.GetClientStreaming) }}
+{{ if $m.GetServerStreaming }}
+func (c handlerClient_{{ $svc.GetName }}) {{ $m.GetName }}(ctx context.Context, in *{{ $m.RequestType.GoType $m.Service.File.GoPkg.Path }}, opts ...grpc.CallOption) ({{ $svc.InstanceName }}_{{ $m.GetName }}Client, error) {
+{{- else }}
+func (c handlerClient_{{ $svc.GetName }}) {{ $m.GetName }}(ctx context.Context, in *{{ $m.RequestType.GoType $m.Service.File.GoPkg.Path }}, opts ...grpc.CallOption) (*{{ $m.ResponseType.GoType $m.Service.File.GoPkg.Path }}, error) {
+{{- end }}
+	if err := c.options.Method({{ $svc.GetName }}_{{ $m.GetName }}_HandlerMethod.FullMethod()).MutateRequest(ctx, in); err != nil {
+		return nil, err
+	}
+	return c.{{ $svc.GetName }}Client.{{ $m.GetName }}(ctx, in, opts...)
+}
+{{- end }}
+{{- end }}
+
 {{range $m := $svc.Methods}}
 {{range $b := $m.Bindings}}
 {{if $b.ResponseBody}}
@@ -933,6 +1067,16 @@ func (m response_{{ $svc.GetName }}_{{ $m.GetName }}_{{ $b.Index }}) XXX_Respons
 {{ end }}
 {{ end }}
 
+// The HandlerMethod variables of the methods of {{ $svc.GetName }} identify them in the options given to
+// Register{{ $svc.GetName }}{{ $.RegisterFuncSuffix }}ClientWithOptions.
+var (
+	{{- range $m := $svc.Methods }}
+	{{- if $m.Bindings }}
+	{{ $svc.GetName }}_{{ $m.GetName }}_HandlerMethod = runtime.NewHandlerMethod[*{{ $m.RequestType.GoType $m.Service.File.GoPkg.Path }}, *{{ $m.ResponseType.GoType $m.Service.File.GoPkg.Path }}]("/{{ $svc.File.GetPackage }}.{{ $svc.GetName }}/{{ $m.GetName }}")
+	{{- end }}
+	{{- end }}
+)
+
 var (
 	{{- range $m := $svc.Methods }}
 	{{- range $b := $m.Bindings }}
@@ -948,5 +1092,25 @@ var (
 	{{- end }}
 	{{- end }}
 )
+{{- if $.RouteDescriptors }}
+
+// The Route variables describe the HTTP bindings of the methods of {{ $svc.GetName }}.
+var (
+	{{- range $m := $svc.Methods }}
+	{{- range $b := $m.Bindings }}
+	{{ $svc.GetName }}_{{ $m.GetName }}_{{ $b.Index }}_Route = {{ routeExpr $b }}
+	{{- end }}
+	{{- end }}
+)
+
+// {{ $svc.GetName }}_Routes lists the Route variables of {{ $svc.GetName }}.
+var {{ $svc.GetName }}_Routes = []runtime.Route{
+	{{- range $m := $svc.Methods }}
+	{{- range $b := $m.Bindings }}
+	{{ $svc.GetName }}_{{ $m.GetName }}_{{ $b.Index }}_Route,
+	{{- end }}
+	{{- end }}
+}
+{{- end }}
 {{ end }}`))
 )
@@ -10,6 +10,7 @@
 package main
 
 import (
+	"errors"
 	"flag"
 	"fmt"
 	"os"
@@ -37,6 +38,12 @@ var (
 	warnOnUnboundMethods       = flag.Bool("warn_on_unbound_methods", false, "emit a warning message if an RPC method has no HttpRule annotation")
 	generateUnboundMethods     = flag.Bool("generate_unbound_methods", false, "generate proxy methods even for RPC methods that have no HttpRule annotation")
 	useOpaqueAPI               = flag.Bool("use_opaque_api", false, "generate code compatible with the new Opaque API instead of the older Open Struct API")
+	generateRouteDescriptors   = flag.Bool("generate_route_descriptors", false, "declare an exported runtime.Route describing each HTTP binding in the generated code")
+	generateRouteManifest      = flag.Bool("generate_route_manifest", false, "generate a JSON manifest of the HTTP bindings of each proto file, in a .pb.gw.routes.json file")
+	warnOnBindingConflicts     = flag.Bool("warn_on_binding_conflicts", true, "emit a warning message for each pair of HTTP bindings of the target files whose path templates match common paths; set to false to silence them")
+	failOnBindingConflicts     = flag.Bool("fail_on_binding_conflicts", false, "fail if HTTP bindings of the target files have path templates matching common paths")
+	generateFakes              = flag.Bool("generate_fakes", false, "generate fake servers and HTTP request builders for testing the HTTP bindings of each proto file, in a .pb.gw.fake.go file built with the grpcgateway_fakes build tag")
+	connectProtocol            = flag.Bool("connect_protocol", false, "serve the Connect protocol calls of the methods with HTTP bindings on ServeMuxes created with runtime.WithConnectProtocol")
 
 	_ = flag.Bool("logtostderr", false, "Legacy glog compatibility. This flag is a no-op, you can safely remove it")
 )
@@ -81,7 +88,17 @@ func main() {
 
 		codegenerator.SetSupportedFeaturesOnPluginGen(gen)
 
-		generator := gengateway.New(reg, *useRequestContext, *registerFuncSuffix, *allowPatchFeature, *standalone, *useOpaqueAPI)
+		generator := gengateway.New(reg, gengateway.Options{
+			UseRequestContext:  *useRequestContext,
+			RegisterFuncSuffix: *registerFuncSuffix,
+			AllowPatchFeature:  *allowPatchFeature,
+			Standalone:         *standalone,
+			UseOpaqueAPI:       *useOpaqueAPI,
+			RouteDescriptors:   *generateRouteDescriptors,
+			RouteManifest:      *generateRouteManifest,
+			ConnectProtocol:    *connectProtocol,
+			Fakes:              *generateFakes,
+		})
 
 		if grpclog.V(1) {
 			grpclog.Infof("Parsing code generator request")
@@ -105,6 +122,10 @@ func main() {
 			targets = append(targets, f)
 		}
 
+		if err := checkBindingConflicts(targets); err != nil {
+			return err
+		}
+
 		files, err := generator.Generate(targets)
 		for _, f := range files {
 			if grpclog.V(1) {
@@ -148,3 +169,21 @@ func applyFlags(reg *descriptor.Registry) error {
 	reg.SetGenerateUnboundMethods(*generateUnboundMethods)
 	return reg.SetRepeatedPathParamSeparator(*repeatedPathParamSeparator)
 }
+
+// checkBindingConflicts reports the bindings of targets whose path templates
+// match common paths: as warnings by default, unless warn_on_binding_conflicts
+// is false, and as errors with fail_on_binding_conflicts.
+func checkBindingConflicts(targets []*descriptor.File) error {
+	if !*warnOnBindingConflicts && !*failOnBindingConflicts {
+		return nil
+	}
+	var errs []error
+	for _, c := range descriptor.FindBindingConflicts(targets) {
+		if *failOnBindingConflicts {
+			errs = append(errs, fmt.Errorf("conflicting bindings: %s", c))
+			continue
+		}
+		grpclog.Warningf("Conflicting bindings: %s", c)
+	}
+	return errors.Join(errs...)
+}
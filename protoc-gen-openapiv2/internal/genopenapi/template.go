@@ -23,6 +23,7 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/v2/internal/descriptor"
 	openapi_options "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
 	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/genproto/googleapis/api/serviceconfig"
 	"google.golang.org/genproto/googleapis/api/visibility"
 	"google.golang.org/grpc/grpclog"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -2037,6 +2038,16 @@ func renderServices(services []*descriptor.Service, paths *openapiPathsObject, r
 								},
 							},
 						}
+						for st, desc := range reg.GetHTTPStatusMappingDescriptions() {
+							operationObject.Responses[st] = openapiResponseObject{
+								Description: desc,
+								Schema: openapiSchemaObject{
+									schemaCore: schemaCore{
+										Ref: fmt.Sprintf("#/definitions/%s", errDef),
+									},
+								},
+							}
+						}
 					}
 				}
 				operationObject.OperationID = fmt.Sprintf("%s_%s", svc.GetName(), meth.GetName())
@@ -2197,6 +2208,12 @@ func renderServices(services []*descriptor.Service, paths *openapiPathsObject, r
 					// TODO(ivucica): add remaining fields of operation object
 				}
 
+				if operationObject.Security == nil {
+					if rule, ok := reg.LookupAuthenticationRule(meth.FQMN()); ok {
+						operationObject.Security = authenticationRuleSecurity(rule)
+					}
+				}
+
 				switch b.HTTPMethod {
 				case "DELETE":
 					pathItemObject.Delete = operationObject
@@ -2375,6 +2392,9 @@ func applyTemplate(p param) (*openapiSwaggerObject, error) {
 		return nil, err
 	}
 
+	// The gRPC API Configuration may describe the API as well.
+	updateOpenAPIDataFromServiceConfig(&s, p.reg.GetServiceConfig())
+
 	// There may be additional options in the OpenAPI option in the proto.
 	spb, err := getFileOpenAPIOption(p.reg, p.File)
 	if err != nil {
@@ -3014,7 +3034,130 @@ func enumValueProtoComments(reg *descriptor.Registry, enum *descriptor.Enum) str
 	return ""
 }
 
+// updateOpenAPIDataFromServiceConfig applies the title, the documentation summary
+// and the authentication providers of a gRPC API Configuration to s.
+func updateOpenAPIDataFromServiceConfig(s *openapiSwaggerObject, config *serviceconfig.Service) {
+	if config.GetTitle() != "" {
+		s.Info.Title = config.GetTitle()
+	}
+	if summary := strings.TrimSpace(config.GetDocumentation().GetSummary()); summary != "" {
+		s.Info.Description = summary
+	}
+	for _, provider := range config.GetAuthentication().GetProviders() {
+		if s.SecurityDefinitions == nil {
+			s.SecurityDefinitions = openapiSecurityDefinitionsObject{}
+		}
+		s.SecurityDefinitions[provider.GetId()] = authProviderSecurityScheme(provider)
+	}
+}
+
+// authProviderSecurityScheme returns the security scheme of an authentication
+// provider of a gRPC API Configuration, described with the x-google-* extensions
+// of Cloud Endpoints.
+func authProviderSecurityScheme(provider *serviceconfig.AuthProvider) openapiSecuritySchemeObject {
+	scheme := openapiSecuritySchemeObject{
+		Type:             "oauth2",
+		Flow:             "implicit",
+		AuthorizationURL: provider.GetAuthorizationUrl(),
+	}
+	for _, ext := range []struct {
+		key   string
+		value string
+	}{
+		{key: "x-google-issuer", value: provider.GetIssuer()},
+		{key: "x-google-jwks_uri", value: provider.GetJwksUri()},
+		{key: "x-google-audiences", value: provider.GetAudiences()},
+	} {
+		if ext.value == "" {
+			continue
+		}
+		value, err := json.Marshal(ext.value)
+		if err != nil {
+			continue
+		}
+		scheme.extensions = append(scheme.extensions, extension{key: ext.key, value: value})
+	}
+	return scheme
+}
+
+// authenticationRuleSecurity returns the security requirements of an
+// authentication rule of a gRPC API Configuration.
+func authenticationRuleSecurity(rule *serviceconfig.AuthenticationRule) *[]openapiSecurityRequirementObject {
+	security := []openapiSecurityRequirementObject{}
+	for _, req := range rule.GetRequirements() {
+		security = append(security, openapiSecurityRequirementObject{req.GetProviderId(): []string{}})
+	}
+	if rule.GetAllowWithoutCredential() && len(security) > 0 {
+		security = append(security, openapiSecurityRequirementObject{})
+	}
+	return &security
+}
+
+// protoElementName returns the fully qualified name of the message, field,
+// enum, service or method located by the arguments of protoComments, or an
+// empty string if they locate another element.
+func protoElementName(reg *descriptor.Registry, file *descriptor.File, outers []string, typeName string, typeIndex int32, fieldPaths ...int32) string {
+	qualify := func(names ...string) string {
+		if file.GetPackage() != "" {
+			names = append([]string{file.GetPackage()}, names...)
+		}
+		return "." + strings.Join(names, ".")
+	}
+	switch typeName {
+	case "MessageType":
+		msgs := file.MessageType
+		if len(outers) > 0 {
+			outer, err := reg.LookupMsg(file.GetPackage(), strings.Join(outers, "."))
+			if err != nil {
+				return ""
+			}
+			msgs = outer.NestedType
+		}
+		if int(typeIndex) >= len(msgs) {
+			return ""
+		}
+		msg := msgs[typeIndex]
+		switch {
+		case len(fieldPaths) == 0:
+			return qualify(append(slices.Clone(outers), msg.GetName())...)
+		case len(fieldPaths) == 2 && fieldPaths[0] == fieldProtoPath && int(fieldPaths[1]) < len(msg.Field):
+			return qualify(append(slices.Clone(outers), msg.GetName(), msg.Field[fieldPaths[1]].GetName())...)
+		}
+	case "EnumType":
+		enums := file.EnumType
+		if len(outers) > 0 {
+			outer, err := reg.LookupMsg(file.GetPackage(), strings.Join(outers, "."))
+			if err != nil {
+				return ""
+			}
+			enums = outer.EnumType
+		}
+		if len(fieldPaths) == 0 && int(typeIndex) < len(enums) {
+			return qualify(append(slices.Clone(outers), enums[typeIndex].GetName())...)
+		}
+	case "Service":
+		if int(typeIndex) >= len(file.Service) {
+			return ""
+		}
+		svc := file.Service[typeIndex]
+		switch {
+		case len(fieldPaths) == 0:
+			return qualify(svc.GetName())
+		case len(fieldPaths) == 2 && fieldPaths[0] == methodProtoPath && int(fieldPaths[1]) < len(svc.Method):
+			return qualify(svc.GetName(), svc.Method[fieldPaths[1]].GetName())
+		}
+	}
+	return ""
+}
+
 func protoComments(reg *descriptor.Registry, file *descriptor.File, outers []string, typeName string, typeIndex int32, fieldPaths ...int32) string {
+	// The documentation rules of the gRPC API Configuration replace the comments.
+	if name := protoElementName(reg, file, outers, typeName, typeIndex, fieldPaths...); name != "" {
+		if description, ok := reg.LookupDocumentationRule(name); ok {
+			return description
+		}
+	}
+
 	if file.SourceCodeInfo == nil {
 		fmt.Fprintln(os.Stderr, file.GetName(), "descriptor.File should not contain nil SourceCodeInfo")
 		return ""
@@ -3106,6 +3249,7 @@ var (
 	packageProtoPath = protoPathIndex(reflect.TypeOf((*descriptorpb.FileDescriptorProto)(nil)), "Package")
 	serviceProtoPath = protoPathIndex(reflect.TypeOf((*descriptorpb.FileDescriptorProto)(nil)), "Service")
 	methodProtoPath  = protoPathIndex(reflect.TypeOf((*descriptorpb.ServiceDescriptorProto)(nil)), "Method")
+	fieldProtoPath   = protoPathIndex(reflect.TypeOf((*descriptorpb.DescriptorProto)(nil)), "Field")
 )
 
 func isProtoPathMatches(paths []int32, outerPaths []int32, typeName string, typeIndex int32, fieldPaths []int32) bool {
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math"
 	"os"
+	"path/filepath"
 	"reflect"
 	"strings"
 	"testing"
@@ -2166,6 +2167,257 @@ func TestApplyTemplateSimple(t *testing.T) {
 	}
 }
 
+func TestApplyTemplateServiceConfig(t *testing.T) {
+	fielddesc := &descriptorpb.FieldDescriptorProto{
+		Name:   proto.String("id"),
+		Number: proto.Int32(1),
+		Label:  descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+		Type:   descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+	}
+	msgdesc := &descriptorpb.DescriptorProto{
+		Name:  proto.String("ExampleMessage"),
+		Field: []*descriptorpb.FieldDescriptorProto{fielddesc},
+	}
+	meth := &descriptorpb.MethodDescriptorProto{
+		Name:       proto.String("Example"),
+		InputType:  proto.String("ExampleMessage"),
+		OutputType: proto.String("ExampleMessage"),
+	}
+	svc := &descriptorpb.ServiceDescriptorProto{
+		Name:   proto.String("ExampleService"),
+		Method: []*descriptorpb.MethodDescriptorProto{meth},
+	}
+	msg := &descriptor.Message{
+		DescriptorProto: msgdesc,
+		Fields: []*descriptor.Field{
+			{FieldDescriptorProto: fielddesc},
+		},
+	}
+	msg.Fields[0].Message = msg
+	file := descriptor.File{
+		FileDescriptorProto: &descriptorpb.FileDescriptorProto{
+			SourceCodeInfo: &descriptorpb.SourceCodeInfo{},
+			Name:           proto.String("example.proto"),
+			Package:        proto.String("example"),
+			MessageType:    []*descriptorpb.DescriptorProto{msgdesc},
+			Service:        []*descriptorpb.ServiceDescriptorProto{svc},
+			Options: &descriptorpb.FileOptions{
+				GoPackage: proto.String("github.com/grpc-ecosystem/grpc-gateway/runtime/internal/examplepb;example"),
+			},
+		},
+		GoPkg: descriptor.GoPackage{
+			Path: "example.com/path/to/example/example.pb",
+			Name: "example_pb",
+		},
+		Messages: []*descriptor.Message{msg},
+		Services: []*descriptor.Service{
+			{
+				ServiceDescriptorProto: svc,
+				Methods: []*descriptor.Method{
+					{
+						MethodDescriptorProto: meth,
+						RequestType:           msg,
+						ResponseType:          msg,
+						Bindings: []*descriptor.Binding{
+							{
+								HTTPMethod: "POST",
+								Body:       &descriptor.Body{FieldPath: nil},
+								PathTmpl: httprule.Template{
+									Version:  1,
+									OpCodes:  []int{0, 0},
+									Template: "/v1/echo",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	config := filepath.Join(t.TempDir(), "example.yaml")
+	if err := os.WriteFile(config, []byte(`
+type: google.api.Service
+config_version: 3
+name: example.example.com
+title: Example API
+
+documentation:
+  summary: An example API.
+  rules:
+  - selector: example.ExampleService.Example
+    description: Echoes the example message.
+  - selector: example.ExampleMessage.id
+    description: The ID of the example.
+
+authentication:
+  providers:
+  - id: google_id_token
+    issuer: https://accounts.google.com
+    jwks_uri: https://www.googleapis.com/oauth2/v3/certs
+  rules:
+  - selector: example.ExampleService.*
+    requirements:
+    - provider_id: google_id_token
+    allow_without_credential: true
+`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	reg := descriptor.NewRegistry()
+	if err := reg.LoadGrpcAPIServiceFromYAML(config); err != nil {
+		t.Fatalf("reg.LoadGrpcAPIServiceFromYAML(%q) failed with %v; want success", config, err)
+	}
+	if err := AddErrorDefs(reg); err != nil {
+		t.Fatalf("AddErrorDefs(%#v) failed with %v; want success", reg, err)
+	}
+	fileCL := crossLinkFixture(&file)
+	if err := reg.Load(reqFromFile(fileCL)); err != nil {
+		t.Fatalf("reg.Load(%#v) failed with %v; want success", file, err)
+	}
+	result, err := applyTemplate(param{File: fileCL, reg: reg})
+	if err != nil {
+		t.Fatalf("applyTemplate(%#v) failed with %v; want success", file, err)
+	}
+
+	if want, is := "Example API", result.Info.Title; is != want {
+		t.Errorf("applyTemplate(%#v).Info.Title = %q; want %q", file, is, want)
+	}
+	if want, is := "An example API.", result.Info.Description; is != want {
+		t.Errorf("applyTemplate(%#v).Info.Description = %q; want %q", file, is, want)
+	}
+
+	scheme, ok := result.SecurityDefinitions["google_id_token"]
+	if !ok {
+		t.Fatalf("applyTemplate(%#v).SecurityDefinitions = %v; want a google_id_token scheme", file, result.SecurityDefinitions)
+	}
+	if want, is := "oauth2", scheme.Type; is != want {
+		t.Errorf("applyTemplate(%#v).SecurityDefinitions[google_id_token].Type = %q; want %q", file, is, want)
+	}
+	wantExtensions := []extension{
+		{key: "x-google-issuer", value: json.RawMessage(`"https://accounts.google.com"`)},
+		{key: "x-google-jwks_uri", value: json.RawMessage(`"https://www.googleapis.com/oauth2/v3/certs"`)},
+	}
+	if !reflect.DeepEqual(scheme.extensions, wantExtensions) {
+		t.Errorf("applyTemplate(%#v).SecurityDefinitions[google_id_token].extensions = %v; want %v", file, scheme.extensions, wantExtensions)
+	}
+
+	op := result.Paths[0].PathItemObject.Post
+	if want, is := "Echoes the example message.", op.Summary+op.Description; is != want {
+		t.Errorf("applyTemplate(%#v).Paths[0].Post.Summary+Description = %q; want %q", file, is, want)
+	}
+	wantSecurity := []openapiSecurityRequirementObject{{"google_id_token": {}}, {}}
+	if op.Security == nil || !reflect.DeepEqual(*op.Security, wantSecurity) {
+		t.Errorf("applyTemplate(%#v).Paths[0].Post.Security = %v; want %v", file, op.Security, wantSecurity)
+	}
+
+	def, ok := result.Definitions["exampleExampleMessage"]
+	if !ok {
+		t.Fatalf("applyTemplate(%#v).Definitions = %v; want exampleExampleMessage", file, result.Definitions)
+	}
+	var id openapiSchemaObject
+	if def.Properties != nil {
+		for _, prop := range *def.Properties {
+			if prop.Key == "id" {
+				id, _ = prop.Value.(openapiSchemaObject)
+			}
+		}
+	}
+	if want, is := "The ID of the example.", id.Title+id.Description; is != want {
+		t.Errorf("applyTemplate(%#v).Definitions[exampleExampleMessage].Properties[id] = %q; want %q", file, is, want)
+	}
+}
+
+func TestApplyTemplateHTTPStatusMappings(t *testing.T) {
+	msgdesc := &descriptorpb.DescriptorProto{
+		Name: proto.String("ExampleMessage"),
+	}
+	meth := &descriptorpb.MethodDescriptorProto{
+		Name:       proto.String("Example"),
+		InputType:  proto.String("ExampleMessage"),
+		OutputType: proto.String("ExampleMessage"),
+	}
+	svc := &descriptorpb.ServiceDescriptorProto{
+		Name:   proto.String("ExampleService"),
+		Method: []*descriptorpb.MethodDescriptorProto{meth},
+	}
+	msg := &descriptor.Message{
+		DescriptorProto: msgdesc,
+	}
+	file := descriptor.File{
+		FileDescriptorProto: &descriptorpb.FileDescriptorProto{
+			SourceCodeInfo: &descriptorpb.SourceCodeInfo{},
+			Name:           proto.String("example.proto"),
+			Package:        proto.String("example"),
+			MessageType:    []*descriptorpb.DescriptorProto{msgdesc},
+			Service:        []*descriptorpb.ServiceDescriptorProto{svc},
+			Options: &descriptorpb.FileOptions{
+				GoPackage: proto.String("github.com/grpc-ecosystem/grpc-gateway/runtime/internal/examplepb;example"),
+			},
+		},
+		GoPkg: descriptor.GoPackage{
+			Path: "example.com/path/to/example/example.pb",
+			Name: "example_pb",
+		},
+		Messages: []*descriptor.Message{msg},
+		Services: []*descriptor.Service{
+			{
+				ServiceDescriptorProto: svc,
+				Methods: []*descriptor.Method{
+					{
+						MethodDescriptorProto: meth,
+						RequestType:           msg,
+						ResponseType:          msg,
+						Bindings: []*descriptor.Binding{
+							{
+								HTTPMethod: "GET",
+								PathTmpl: httprule.Template{
+									Version:  1,
+									OpCodes:  []int{0, 0},
+									Template: "/v1/echo",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	reg := descriptor.NewRegistry()
+	if err := AddErrorDefs(reg); err != nil {
+		t.Errorf("AddErrorDefs(%#v) failed with %v; want success", reg, err)
+		return
+	}
+	if err := reg.SetHTTPStatusMappings([]string{"FAILED_PRECONDITION:409"}, []string{"RESOURCE_DELETED:410"}); err != nil {
+		t.Fatalf("reg.SetHTTPStatusMappings() failed with %v; want success", err)
+	}
+	fileCL := crossLinkFixture(&file)
+	if err := reg.Load(reqFromFile(fileCL)); err != nil {
+		t.Fatalf("reg.Load(%#v) failed with %v; want success", file, err)
+	}
+	result, err := applyTemplate(param{File: fileCL, reg: reg})
+	if err != nil {
+		t.Fatalf("applyTemplate(%#v) failed with %v; want success", file, err)
+	}
+
+	responses := result.getPathItemObject("/v1/echo").Get.Responses
+	for code, want := range map[string]string{
+		"409": "Returned when the call fails with code FAILED_PRECONDITION.",
+		"410": "Returned when the call fails with an ErrorInfo of reason RESOURCE_DELETED.",
+	} {
+		resp, ok := responses[code]
+		if !ok {
+			t.Errorf("applyTemplate(%#v).Responses[%q] is missing", file, code)
+			continue
+		}
+		if got := resp.Description; got != want {
+			t.Errorf("applyTemplate(%#v).Responses[%q].Description = %q; want %q", file, code, got, want)
+		}
+		if got, want := resp.Schema.Ref, "#/definitions/rpcStatus"; got != want {
+			t.Errorf("applyTemplate(%#v).Responses[%q].Schema.Ref = %q; want %q", file, code, got, want)
+		}
+	}
+}
+
 func TestApplyTemplateMultiService(t *testing.T) {
 	msgdesc := &descriptorpb.DescriptorProto{
 		Name: proto.String("ExampleMessage"),
@@ -55,6 +55,8 @@ var (
 	expandSlashedPathPatterns       = flag.Bool("expand_slashed_path_patterns", false, "if set, expands path parameters with URI sub-paths into the URI. For example, \"/v1/{name=projects/*}/resource\" becomes \"/v1/projects/{project}/resource\".")
 	useProto3FieldSemantics         = flag.Bool("use_proto3_field_semantics", false, "if set, uses proto3 field semantics for the OpenAPI schema. This means that fields are required by default.")
 	generateXGoType                 = flag.Bool("generate_x_go_type", false, "if set, generates x-go-type extension using the go_package option from proto files")
+	httpStatusMapping               = utilities.StringArrayFlag(flag.CommandLine, "http_status_mapping", "`<code>:<status>` pair, e.g. `NOT_FOUND:410`, of the gRPC code to HTTP status mapping configured with runtime.WithHTTPStatusMapping, documented as an error response of every operation. Repeat this option to supply multiple values.")
+	httpStatusReasonMapping         = utilities.StringArrayFlag(flag.CommandLine, "http_status_reason_mapping", "`<reason>:<status>` pair of the google.rpc.ErrorInfo reason to HTTP status mapping configured with runtime.WithHTTPStatusMapping, documented as an error response of every operation. Repeat this option to supply multiple values.")
 
 	_ = flag.Bool("logtostderr", false, "Legacy glog compatibility. This flag is a no-op, you can safely remove it")
 )
@@ -188,6 +190,11 @@ func main() {
 		emitError(err)
 		return
 	}
+
+	if err := reg.SetHTTPStatusMappings(*httpStatusMapping, *httpStatusReasonMapping); err != nil {
+		emitError(err)
+		return
+	}
 	for k, v := range pkgMap {
 		reg.AddPkgMap(k, v)
 	}
@@ -1,59 +1,89 @@
 package genopenapi
 
 import (
+	"encoding/json"
 	"fmt"
+	"maps"
+	"slices"
+	"strings"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/internal/descriptor"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv3/options"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
-// Annotation lookups. Each returns (nil, false) when the extension is not
-// present. A returned annotation's non-empty sub-fields replace defaults the
-// generator would otherwise derive from proto comments or proto types.
+// Annotation lookups. Each returns (nil, false) when neither the extension is
+// present nor an option is registered for the element by an OpenAPI v3
+// configuration file. A returned annotation's non-empty sub-fields replace
+// defaults the generator would otherwise derive from proto comments or proto
+// types.
 
-func fileDocumentAnnotation(file *descriptor.File) (*options.Document, bool) {
-	if file.Options == nil || !proto.HasExtension(file.Options, options.E_Openapiv3Document) {
-		return nil, false
+func fileDocumentAnnotation(reg *descriptor.Registry, file *descriptor.File) (*options.Document, bool) {
+	var d *options.Document
+	if file.Options != nil && proto.HasExtension(file.Options, options.E_Openapiv3Document) {
+		d, _ = proto.GetExtension(file.Options, options.E_Openapiv3Document).(*options.Document)
 	}
-	d, ok := proto.GetExtension(file.Options, options.E_Openapiv3Document).(*options.Document)
-	if !ok || d == nil {
-		return nil, false
-	}
-	return d, true
+	opt, _ := reg.GetOpenAPIv3FileOption(file.GetName())
+	return mergeConfigOption(d, opt)
 }
 
-func methodOperationAnnotation(m *descriptor.Method) (*options.Operation, bool) {
-	if m.Options == nil || !proto.HasExtension(m.Options, options.E_Openapiv3Operation) {
-		return nil, false
-	}
-	op, ok := proto.GetExtension(m.Options, options.E_Openapiv3Operation).(*options.Operation)
-	if !ok || op == nil {
-		return nil, false
+func serviceAnnotation(reg *descriptor.Registry, svc *descriptor.Service) (*options.Service, bool) {
+	var s *options.Service
+	if svc.Options != nil && proto.HasExtension(svc.Options, options.E_Openapiv3Service) {
+		s, _ = proto.GetExtension(svc.Options, options.E_Openapiv3Service).(*options.Service)
 	}
-	return op, true
+	opt, _ := reg.GetOpenAPIv3ServiceOption(svc.FQSN())
+	return mergeConfigOption(s, opt)
 }
 
-func messageSchemaAnnotation(msg *descriptor.Message) (*options.Schema, bool) {
-	if msg.Options == nil || !proto.HasExtension(msg.Options, options.E_Openapiv3Schema) {
-		return nil, false
+func methodOperationAnnotation(reg *descriptor.Registry, m *descriptor.Method) (*options.Operation, bool) {
+	var op *options.Operation
+	if m.Options != nil && proto.HasExtension(m.Options, options.E_Openapiv3Operation) {
+		op, _ = proto.GetExtension(m.Options, options.E_Openapiv3Operation).(*options.Operation)
 	}
-	s, ok := proto.GetExtension(msg.Options, options.E_Openapiv3Schema).(*options.Schema)
-	if !ok || s == nil {
-		return nil, false
+	opt, _ := reg.GetOpenAPIv3MethodOption(m.FQMN())
+	return mergeConfigOption(op, opt)
+}
+
+func messageSchemaAnnotation(reg *descriptor.Registry, msg *descriptor.Message) (*options.Schema, bool) {
+	var s *options.Schema
+	if msg.Options != nil && proto.HasExtension(msg.Options, options.E_Openapiv3Schema) {
+		s, _ = proto.GetExtension(msg.Options, options.E_Openapiv3Schema).(*options.Schema)
 	}
-	return s, true
+	opt, _ := reg.GetOpenAPIv3MessageOption(msg.FQMN())
+	return mergeConfigOption(s, opt)
 }
 
-func fieldSchemaAnnotation(field *descriptor.Field) (*options.Schema, bool) {
-	if field.Options == nil || !proto.HasExtension(field.Options, options.E_Openapiv3Field) {
-		return nil, false
+func fieldSchemaAnnotation(reg *descriptor.Registry, field *descriptor.Field) (*options.Schema, bool) {
+	var s *options.Schema
+	if field.Options != nil && proto.HasExtension(field.Options, options.E_Openapiv3Field) {
+		s, _ = proto.GetExtension(field.Options, options.E_Openapiv3Field).(*options.Schema)
 	}
-	s, ok := proto.GetExtension(field.Options, options.E_Openapiv3Field).(*options.Schema)
-	if !ok || s == nil {
-		return nil, false
+	opt, _ := reg.GetOpenAPIv3FieldOption(field.FQFN())
+	return mergeConfigOption(s, opt)
+}
+
+// mergeConfigOption merges opt, the option registered for an element by an
+// OpenAPI v3 configuration file, into ann, the in-source annotation of the
+// element, either of which may be nil. The configuration takes precedence:
+// its singular fields replace those of the annotation, its repeated fields
+// are appended to those of the annotation, and its map entries replace those
+// of the annotation with the same key. ann is not modified.
+func mergeConfigOption[T interface {
+	comparable
+	proto.Message
+}](ann, opt T) (T, bool) {
+	var none T
+	switch {
+	case opt == none:
+		return ann, ann != none
+	case ann == none:
+		return opt, true
 	}
-	return s, true
+	merged := proto.Clone(ann).(T)
+	proto.Merge(merged, opt)
+	return merged, true
 }
 
 // applyDocumentOverride applies file-level Document overrides onto the
@@ -61,7 +91,8 @@ func fieldSchemaAnnotation(field *descriptor.Field) (*options.Schema, bool) {
 // leave the current value untouched. Returns an error if the annotation is
 // invalid — for example, a License with no name, a Server with no url, a Tag
 // with no name, or any ExternalDocs without a url. All four are spec-required
-// fields per OpenAPI 3.1.0.
+// fields per OpenAPI 3.1.0. Security schemes are validated by
+// convertSecurityScheme and security requirements by convertSecurity.
 func applyDocumentOverride(doc *Document, d *options.Document) error {
 	if d == nil {
 		return nil
@@ -99,15 +130,18 @@ func applyDocumentOverride(doc *Document, d *options.Document) error {
 				URL:        l.GetUrl(),
 			}
 		}
+		extensions, err := convertExtensions(info.GetExtensions())
+		if err != nil {
+			return fmt.Errorf("openapiv3 info %w", err)
+		}
+		doc.Info.Extensions = extensions
 	}
 	for i, s := range d.GetServers() {
-		if err := validateServer(s); err != nil {
+		server, err := convertServer(s)
+		if err != nil {
 			return fmt.Errorf("openapiv3 document servers[%d]: %w", i, err)
 		}
-		doc.Servers = append(doc.Servers, &Server{
-			URL:         s.GetUrl(),
-			Description: s.GetDescription(),
-		})
+		doc.Servers = append(doc.Servers, server)
 	}
 	if ed := d.GetExternalDocs(); ed != nil {
 		if err := validateExternalDocs(ed); err != nil {
@@ -122,9 +156,14 @@ func applyDocumentOverride(doc *Document, d *options.Document) error {
 		if t.GetName() == "" {
 			return fmt.Errorf("openapiv3 document tags[%d]: name is required", i)
 		}
+		extensions, err := convertExtensions(t.GetExtensions())
+		if err != nil {
+			return fmt.Errorf("openapiv3 document tags[%d] %w", i, err)
+		}
 		tag := &Tag{
 			Name:        t.GetName(),
 			Description: t.GetDescription(),
+			Extensions:  extensions,
 		}
 		if ed := t.GetExternalDocs(); ed != nil {
 			if err := validateExternalDocs(ed); err != nil {
@@ -137,9 +176,172 @@ func applyDocumentOverride(doc *Document, d *options.Document) error {
 		}
 		doc.Tags = append(doc.Tags, tag)
 	}
+	// Sorted, so that the first invalid scheme reported is deterministic.
+	names := slices.Sorted(maps.Keys(d.GetSecuritySchemes()))
+	for _, name := range names {
+		scheme, err := convertSecurityScheme(d.GetSecuritySchemes()[name])
+		if err != nil {
+			return fmt.Errorf("openapiv3 document security_schemes[%q]: %w", name, err)
+		}
+		if doc.Components.SecuritySchemes == nil {
+			doc.Components.SecuritySchemes = make(map[string]*SecurityScheme)
+		}
+		doc.Components.SecuritySchemes[name] = scheme
+	}
+	if len(d.GetSecurity()) > 0 {
+		security, err := convertSecurity(doc.Components.SecuritySchemes, d.GetSecurity())
+		if err != nil {
+			return fmt.Errorf("openapiv3 document %w", err)
+		}
+		doc.Security = security
+	}
+	extensions, err := convertExtensions(d.GetExtensions())
+	if err != nil {
+		return fmt.Errorf("openapiv3 document %w", err)
+	}
+	doc.Extensions = extensions
 	return nil
 }
 
+// convertSecurityScheme converts a SecurityScheme annotation, enforcing the
+// fields the OpenAPI 3.1.0 Security Scheme Object requires for its type.
+func convertSecurityScheme(s *options.SecurityScheme) (*SecurityScheme, error) {
+	scheme := &SecurityScheme{Description: s.GetDescription()}
+	switch s.GetType() {
+	case options.SecurityScheme_TYPE_API_KEY:
+		scheme.Type = "apiKey"
+		if s.GetName() == "" {
+			return nil, fmt.Errorf("name is required for type apiKey")
+		}
+		scheme.Name = s.GetName()
+		switch s.GetIn() {
+		case options.SecurityScheme_IN_QUERY:
+			scheme.In = "query"
+		case options.SecurityScheme_IN_HEADER:
+			scheme.In = "header"
+		case options.SecurityScheme_IN_COOKIE:
+			scheme.In = "cookie"
+		default:
+			return nil, fmt.Errorf("in is required for type apiKey")
+		}
+	case options.SecurityScheme_TYPE_HTTP:
+		scheme.Type = "http"
+		if s.GetScheme() == "" {
+			return nil, fmt.Errorf("scheme is required for type http")
+		}
+		scheme.Scheme = s.GetScheme()
+		scheme.BearerFormat = s.GetBearerFormat()
+	case options.SecurityScheme_TYPE_MUTUAL_TLS:
+		scheme.Type = "mutualTLS"
+	case options.SecurityScheme_TYPE_OAUTH2:
+		scheme.Type = "oauth2"
+		flows, err := convertOAuthFlows(s.GetFlows())
+		if err != nil {
+			return nil, err
+		}
+		scheme.Flows = flows
+	case options.SecurityScheme_TYPE_OPEN_ID_CONNECT:
+		scheme.Type = "openIdConnect"
+		if s.GetOpenIdConnectUrl() == "" {
+			return nil, fmt.Errorf("open_id_connect_url is required for type openIdConnect")
+		}
+		scheme.OpenIDConnectURL = s.GetOpenIdConnectUrl()
+	default:
+		return nil, fmt.Errorf("type is required")
+	}
+	return scheme, nil
+}
+
+// convertOAuthFlows converts the flows of an oauth2 SecurityScheme
+// annotation, at least one of which must be set, enforcing the URLs each
+// flow requires per OpenAPI 3.1.0.
+func convertOAuthFlows(f *options.OAuthFlows) (*OAuthFlows, error) {
+	flows := &OAuthFlows{}
+	for _, flow := range []struct {
+		name                           string
+		src                            *options.OAuthFlow
+		dst                            **OAuthFlow
+		needsAuthorization, needsToken bool
+	}{
+		{"implicit", f.GetImplicit(), &flows.Implicit, true, false},
+		{"password", f.GetPassword(), &flows.Password, false, true},
+		{"client_credentials", f.GetClientCredentials(), &flows.ClientCredentials, false, true},
+		{"authorization_code", f.GetAuthorizationCode(), &flows.AuthorizationCode, true, true},
+	} {
+		if flow.src == nil {
+			continue
+		}
+		if flow.needsAuthorization && flow.src.GetAuthorizationUrl() == "" {
+			return nil, fmt.Errorf("flows %s: authorization_url is required", flow.name)
+		}
+		if flow.needsToken && flow.src.GetTokenUrl() == "" {
+			return nil, fmt.Errorf("flows %s: token_url is required", flow.name)
+		}
+		scopes := flow.src.GetScopes()
+		if scopes == nil {
+			// Required by the spec, even when empty.
+			scopes = map[string]string{}
+		}
+		*flow.dst = &OAuthFlow{
+			AuthorizationURL: flow.src.GetAuthorizationUrl(),
+			TokenURL:         flow.src.GetTokenUrl(),
+			RefreshURL:       flow.src.GetRefreshUrl(),
+			Scopes:           scopes,
+		}
+	}
+	if *flows == (OAuthFlows{}) {
+		return nil, fmt.Errorf("flows: at least one flow is required for type oauth2")
+	}
+	return flows, nil
+}
+
+// convertSecurity converts SecurityRequirement annotations. Returns an error
+// if a requirement references a scheme missing from schemes, or an OAuth2
+// scope not declared by any flow of its scheme. The returned error is
+// prefixed with the index of the invalid requirement, e.g. "security[1]:".
+func convertSecurity(schemes map[string]*SecurityScheme, reqs []*options.SecurityRequirement) ([]SecurityRequirement, error) {
+	security := make([]SecurityRequirement, 0, len(reqs))
+	for i, req := range reqs {
+		requirement := SecurityRequirement{}
+		for _, name := range slices.Sorted(maps.Keys(req.GetSecurityRequirement())) {
+			scheme, ok := schemes[name]
+			if !ok {
+				return nil, fmt.Errorf("security[%d]: undeclared security scheme %q; add it to openapiv3_document.security_schemes", i, name)
+			}
+			scopes := req.GetSecurityRequirement()[name].GetScope()
+			for _, scope := range scopes {
+				if scheme.Type == "oauth2" && !scheme.Flows.declaresScope(scope) {
+					return nil, fmt.Errorf("security[%d]: scope %q is not declared by any flow of security scheme %q", i, scope, name)
+				}
+			}
+			if scopes == nil {
+				scopes = []string{}
+			}
+			requirement[name] = scopes
+		}
+		security = append(security, requirement)
+	}
+	return security, nil
+}
+
+// annotationSecurity returns the security requirements of a service or
+// operation annotation: nil if it declares none, and an empty list, which
+// opts the operations out of the document-level security, if no_security
+// is set. Returns an error if both are set, or the errors of
+// convertSecurity.
+func annotationSecurity(schemes map[string]*SecurityScheme, reqs []*options.SecurityRequirement, noSecurity bool) ([]SecurityRequirement, error) {
+	if noSecurity {
+		if len(reqs) > 0 {
+			return nil, fmt.Errorf("no_security: not allowed together with security")
+		}
+		return []SecurityRequirement{}, nil
+	}
+	if len(reqs) == 0 {
+		return nil, nil
+	}
+	return convertSecurity(schemes, reqs)
+}
+
 // validateServer enforces the OpenAPI 3.1.0 Server Object's required `url`
 // field. Empty url is invalid even though `description` alone may look
 // useful in proto.
@@ -150,6 +352,43 @@ func validateServer(s *options.Server) error {
 	return nil
 }
 
+// convertServer converts a Server annotation, validated by validateServer.
+func convertServer(s *options.Server) (*Server, error) {
+	if err := validateServer(s); err != nil {
+		return nil, err
+	}
+	extensions, err := convertExtensions(s.GetExtensions())
+	if err != nil {
+		return nil, fmt.Errorf("server %w", err)
+	}
+	return &Server{
+		URL:         s.GetUrl(),
+		Description: s.GetDescription(),
+		Extensions:  extensions,
+	}, nil
+}
+
+// convertExtensions converts the specification extensions of an annotation.
+// Returns an error prefixed with "extensions" if a name does not start with
+// "x-", or starts with "x-oai-" or "x-oas-", which are reserved by the
+// OpenAPI Initiative.
+func convertExtensions(extensions map[string]*structpb.Value) (map[string]any, error) {
+	if len(extensions) == 0 {
+		return nil, nil
+	}
+	out := make(map[string]any, len(extensions))
+	for _, name := range slices.Sorted(maps.Keys(extensions)) {
+		if !strings.HasPrefix(name, "x-") {
+			return nil, fmt.Errorf("extensions[%q]: name must start with \"x-\"", name)
+		}
+		if strings.HasPrefix(name, "x-oai-") || strings.HasPrefix(name, "x-oas-") {
+			return nil, fmt.Errorf("extensions[%q]: the \"x-oai-\" and \"x-oas-\" prefixes are reserved", name)
+		}
+		out[name] = extensions[name].AsInterface()
+	}
+	return out, nil
+}
+
 // validateExternalDocs enforces the OpenAPI 3.1.0 External Documentation
 // Object's required `url` field.
 func validateExternalDocs(ed *options.ExternalDocs) error {
@@ -166,9 +405,15 @@ func validateExternalDocs(ed *options.ExternalDocs) error {
 // `deprecated` flag is one-way: it can flip deprecation on, but cannot
 // clear a flag inherited from the proto cascade.
 //
+// Security requirements from the annotation, or the empty ones of
+// no_security, replace the ones of the service and of the gRPC API
+// Configuration, and must reference the security schemes declared in
+// schemes.
+//
 // Returns an error if the annotation contains an external_docs without a url
-// or a server without a url, both spec-required per OpenAPI 3.1.0.
-func applyOperationOverride(op *Operation, o *options.Operation) error {
+// or a server without a url, both spec-required per OpenAPI 3.1.0, or an
+// invalid security requirement.
+func applyOperationOverride(op *Operation, o *options.Operation, schemes map[string]*SecurityScheme) error {
 	if o == nil {
 		return nil
 	}
@@ -195,54 +440,133 @@ func applyOperationOverride(op *Operation, o *options.Operation) error {
 	}
 	op.Deprecated = op.Deprecated || o.GetDeprecated()
 	for i, s := range o.GetServers() {
-		if err := validateServer(s); err != nil {
+		server, err := convertServer(s)
+		if err != nil {
 			return fmt.Errorf("servers[%d]: %w", i, err)
 		}
-		op.Servers = append(op.Servers, &Server{
-			URL:         s.GetUrl(),
-			Description: s.GetDescription(),
-		})
+		op.Servers = append(op.Servers, server)
+	}
+	security, err := annotationSecurity(schemes, o.GetSecurity(), o.GetNoSecurity())
+	if err != nil {
+		return err
+	}
+	if security != nil {
+		op.Security = security
 	}
+	extensions, err := convertExtensions(o.GetExtensions())
+	if err != nil {
+		return err
+	}
+	op.Extensions = extensions
 	return nil
 }
 
-// applySchemaBodyOverride applies the title and deprecated fields from a
-// Schema annotation onto a schema body. Used for both message-level and
-// field-level annotations. For $ref-typed fields the caller must first
-// ensure an allOf wrapper exists, since neither field can sit alongside
-// $ref without one. The annotation `deprecated` flag is one-way: it can
-// flip deprecation on, but cannot clear a flag inherited from the proto
-// cascade.
-func applySchemaBodyOverride(s *Schema, o *options.Schema) {
+// applySchemaBodyOverride applies the title, deprecated and JSON Schema
+// keyword fields from a Schema annotation onto a schema body. Used for both
+// message-level and field-level annotations. For $ref-typed fields the
+// caller must first ensure an allOf wrapper exists, since none of these
+// fields can sit alongside $ref without one. The annotation `deprecated`,
+// `read_only` and `write_only` flags are one-way: they can flip the flag on,
+// but cannot clear a flag inherited from the proto cascade or the field
+// behaviors.
+//
+// On an array schema with inline items, the string and numeric keywords are
+// applied to the items, where they constrain the values of the repeated
+// field. Returns an error if `default` or `examples` are not valid JSON.
+func applySchemaBodyOverride(s *Schema, o *options.Schema) error {
 	if o == nil {
-		return
+		return nil
 	}
 	if v := o.GetTitle(); v != "" {
 		s.Title = v
 	}
 	s.Deprecated = s.Deprecated || o.GetDeprecated()
+	s.ReadOnly = s.ReadOnly || o.GetReadOnly()
+	s.WriteOnly = s.WriteOnly || o.GetWriteOnly()
+
+	values := s
+	if slices.Equal(s.Type, SchemaType{"array"}) && s.Items != nil && s.Items.Value != nil {
+		values = s.Items.Value
+	}
+	if v := o.GetFormat(); v != "" {
+		values.Format = v
+	}
+	if v := o.GetPattern(); v != "" {
+		values.Pattern = v
+	}
+	if o.HasMinLength() {
+		values.MinLength = proto.Uint64(o.GetMinLength())
+	}
+	if o.HasMaxLength() {
+		values.MaxLength = proto.Uint64(o.GetMaxLength())
+	}
+	if o.HasMinimum() {
+		values.Minimum = proto.Float64(o.GetMinimum())
+	}
+	if o.HasMaximum() {
+		values.Maximum = proto.Float64(o.GetMaximum())
+	}
+	if o.HasMultipleOf() {
+		if o.GetMultipleOf() <= 0 {
+			return fmt.Errorf("multiple_of: must be strictly positive")
+		}
+		values.MultipleOf = proto.Float64(o.GetMultipleOf())
+	}
+	if o.HasMinItems() {
+		s.MinItems = proto.Uint64(o.GetMinItems())
+	}
+	if o.HasMaxItems() {
+		s.MaxItems = proto.Uint64(o.GetMaxItems())
+	}
+	s.UniqueItems = s.UniqueItems || o.GetUniqueItems()
+	if v := o.GetDefault(); v != "" {
+		if err := json.Unmarshal([]byte(v), &s.Default); err != nil {
+			return fmt.Errorf("default: invalid JSON %q: %w", v, err)
+		}
+	}
+	for i, v := range o.GetExamples() {
+		var example any
+		if err := json.Unmarshal([]byte(v), &example); err != nil {
+			return fmt.Errorf("examples[%d]: invalid JSON %q: %w", i, v, err)
+		}
+		s.Examples = append(s.Examples, example)
+	}
+	extensions, err := convertExtensions(o.GetExtensions())
+	if err != nil {
+		return err
+	}
+	s.Extensions = extensions
+	return nil
 }
 
 // applyMessageSchemaOverride applies a message-level Schema annotation onto
 // a component schema.
-func applyMessageSchemaOverride(s *Schema, o *options.Schema) {
+func applyMessageSchemaOverride(s *Schema, o *options.Schema) error {
 	if o == nil {
-		return
+		return nil
+	}
+	if len(o.GetAnyTypes()) > 0 {
+		return fmt.Errorf("any_types: only allowed on google.protobuf.Any fields")
+	}
+	if err := applySchemaBodyOverride(s, o); err != nil {
+		return err
 	}
-	applySchemaBodyOverride(s, o)
 	if v := o.GetDescription(); v != "" {
 		s.Description = v
 	}
+	return nil
 }
 
 // annotationNeedsSchemaBody reports whether a field annotation sets anything
-// that cannot be expressed as a $ref sibling in OpenAPI 3.1.0. `title` and
-// `deprecated` both require a real schema body; `description` can sit as a
-// $ref sibling directly. Used by propertySchema to decide when a referenced
-// field needs an allOf wrapper.
+// that cannot be expressed as a $ref sibling in OpenAPI 3.1.0. Only
+// `description` can sit as a $ref sibling directly; every other field
+// requires a real schema body. Used by propertySchema to decide when a
+// referenced field needs an allOf wrapper.
 func annotationNeedsSchemaBody(o *options.Schema) bool {
 	if o == nil {
 		return false
 	}
-	return o.GetTitle() != "" || o.GetDeprecated()
+	body := proto.Clone(o).(*options.Schema)
+	body.SetDescription("")
+	return proto.Size(body) > 0
 }
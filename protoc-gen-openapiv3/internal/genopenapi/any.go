@@ -0,0 +1,82 @@
+package genopenapi
+
+import (
+	"fmt"
+	"strings"
+)
+
+// anyTypeName is the fully-qualified name of google.protobuf.Any.
+const anyTypeName = ".google.protobuf.Any"
+
+// statusDetailsFieldName is the fully-qualified name of the details field of
+// google.rpc.Status, typed by the status_detail_types of the document when
+// its proto file is imported.
+const statusDetailsFieldName = ".google.rpc.Status.details"
+
+// anySuffix is appended to the component name of a message to name the schema
+// of a google.protobuf.Any holding the message.
+const anySuffix = ".Any"
+
+// typeURLPrefix is the prefix of the type URLs protojson writes in the @type
+// property of google.protobuf.Any values.
+const typeURLPrefix = "type.googleapis.com/"
+
+// typedAnySchema returns the schema of a google.protobuf.Any value holding a
+// message of one of types, fully-qualified message names: a oneOf over the
+// schemas of the messages extended with their constant @type, discriminated
+// by @type.
+//
+// The discriminator mapping can only reference component schemas, so the
+// messages must have one: well-known types, which protojson embeds under a
+// "value" property instead, are rejected.
+func (b *schemaBuilder) typedAnySchema(types []string) (*Schema, error) {
+	schema := &Schema{
+		Discriminator: &Discriminator{
+			PropertyName: "@type",
+			Mapping:      make(map[string]string, len(types)),
+		},
+	}
+	for _, t := range types {
+		fqmn := "." + strings.TrimPrefix(t, ".")
+		if wellKnownTypeSchema(fqmn) != nil {
+			return nil, fmt.Errorf("well-known type %q has no component schema", t)
+		}
+		msg, err := b.reg.LookupMsg("", fqmn)
+		if err != nil {
+			return nil, fmt.Errorf("unknown message %q", t)
+		}
+		typeURL := typeURLPrefix + strings.TrimPrefix(fqmn, ".")
+		if _, dup := schema.Discriminator.Mapping[typeURL]; dup {
+			continue
+		}
+		ref := b.anyVariantRef(b.messageRef(msg), msg.FQMN(), typeURL)
+		schema.OneOf = append(schema.OneOf, ref)
+		schema.Discriminator.Mapping[typeURL] = ref.Ref
+	}
+	return schema, nil
+}
+
+// anyVariantRef returns a $ref to the schema of a google.protobuf.Any holding
+// the message whose fully-qualified name is fqmn and whose schema is msgRef,
+// ensuring the component is generated. protojson inlines the fields of the
+// message next to @type, so the schema extends the message schema with a
+// required @type set to typeURL.
+func (b *schemaBuilder) anyVariantRef(msgRef *SchemaOrRef, fqmn, typeURL string) *SchemaOrRef {
+	name := b.derivedSchemaName(fqmn, anySuffix)
+	if _, ok := b.doc.Components.Schemas[name]; ok {
+		return NewSchemaRef(name)
+	}
+	b.doc.Components.Schemas[name] = &SchemaOrRef{Value: &Schema{
+		AllOf: []*SchemaOrRef{
+			msgRef,
+			{Value: &Schema{
+				Type: SchemaType{"object"},
+				Properties: map[string]*SchemaOrRef{
+					"@type": {Value: &Schema{Type: SchemaType{"string"}, Const: typeURL}},
+				},
+				Required: []string{"@type"},
+			}},
+		},
+	}}
+	return NewSchemaRef(name)
+}
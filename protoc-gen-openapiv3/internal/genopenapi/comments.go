@@ -93,8 +93,12 @@ func splitSummaryDescription(comment string) (summary, description string) {
 	return summary, description
 }
 
-// serviceComments returns the leading comment on a service declaration.
-func serviceComments(svc *descriptor.Service) string {
+// serviceComments returns the leading comment on a service declaration, or
+// its description in the documentation rules of the gRPC API Configuration.
+func serviceComments(reg *descriptor.Registry, svc *descriptor.Service) string {
+	if desc, ok := reg.LookupDocumentationRule(svc.FQSN()); ok {
+		return desc
+	}
 	idx := slices.Index(svc.File.Services, svc)
 	if idx < 0 {
 		return ""
@@ -102,8 +106,12 @@ func serviceComments(svc *descriptor.Service) string {
 	return extractComments(svc.File, []int32{serviceProtoPath, int32(idx)})
 }
 
-// methodComments returns the leading comment on a method declaration.
-func methodComments(m *descriptor.Method) string {
+// methodComments returns the leading comment on a method declaration, or
+// its description in the documentation rules of the gRPC API Configuration.
+func methodComments(reg *descriptor.Registry, m *descriptor.Method) string {
+	if desc, ok := reg.LookupDocumentationRule(m.FQMN()); ok {
+		return desc
+	}
 	svcIdx := slices.Index(m.Service.File.Services, m.Service)
 	if svcIdx < 0 {
 		return ""
@@ -119,8 +127,12 @@ func methodComments(m *descriptor.Method) string {
 }
 
 // messageComments returns the leading comment on a message declaration,
-// walking outer messages so nested types resolve correctly.
+// walking outer messages so nested types resolve correctly. A description in
+// the documentation rules of the gRPC API Configuration takes precedence.
 func messageComments(reg *descriptor.Registry, msg *descriptor.Message) string {
+	if desc, ok := reg.LookupDocumentationRule(msg.FQMN()); ok {
+		return desc
+	}
 	path := messagePath(reg, msg)
 	if path == nil {
 		return ""
@@ -128,8 +140,12 @@ func messageComments(reg *descriptor.Registry, msg *descriptor.Message) string {
 	return extractComments(msg.File, path)
 }
 
-// fieldComments returns the leading comment on a field declaration.
+// fieldComments returns the leading comment on a field declaration, or its
+// description in the documentation rules of the gRPC API Configuration.
 func fieldComments(reg *descriptor.Registry, field *descriptor.Field) string {
+	if desc, ok := reg.LookupDocumentationRule(field.FQFN()); ok {
+		return desc
+	}
 	parentPath := messagePath(reg, field.Message)
 	if parentPath == nil {
 		return ""
@@ -141,8 +157,12 @@ func fieldComments(reg *descriptor.Registry, field *descriptor.Field) string {
 	return extractComments(field.Message.File, append(parentPath, fieldProtoPath, int32(idx)))
 }
 
-// enumComments returns the leading comment on an enum declaration.
+// enumComments returns the leading comment on an enum declaration, or its
+// description in the documentation rules of the gRPC API Configuration.
 func enumComments(reg *descriptor.Registry, enum *descriptor.Enum) string {
+	if desc, ok := reg.LookupDocumentationRule(enum.FQEN()); ok {
+		return desc
+	}
 	path := enumPath(reg, enum)
 	if path == nil {
 		return ""
@@ -35,10 +35,16 @@
 //     maps, field_behavior, and cycle-safe recursion.
 //   - wkt.go       — inline schemas for google.protobuf well-known types,
 //     matching protojson's wire representation.
+//   - any.go       — oneOf schemas of the google.protobuf.Any values whose
+//     message types are declared by annotations.
+//   - example.go   — validation of the example messages of the annotations
+//     against their descriptors, and conversion to their JSON form.
 //   - types.go     — the OpenAPI 3.1.0 data model plus custom MarshalJSON
 //     shims that drive deterministic output.
 //   - comments.go  — source-code-info path construction for leading comments
 //     on services, methods, messages, fields, and enums.
 //   - path.go      — proto URL template → OpenAPI URL template conversion.
-//   - naming.go    — FQN → component schema name.
+//   - naming.go    — proto type name → component schema name, per naming
+//     strategy.
+//   - format.go    — JSON → YAML conversion of the output documents.
 package genopenapi
@@ -0,0 +1,154 @@
+package genopenapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/internal/descriptor"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv3/options"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// mediaTypeExamples returns the examples of a media type whose body is a msg
+// message, by name, dropping the given top-level properties from their
+// values.
+func (b *schemaBuilder) mediaTypeExamples(msg *descriptor.Message, examples []*options.Example, drop ...string) (map[string]*Example, error) {
+	out := make(map[string]*Example, len(examples))
+	for i, ex := range examples {
+		name := ex.GetName()
+		if name == "" {
+			name = fmt.Sprintf("example%d", i+1)
+		}
+		if _, dup := out[name]; dup {
+			return nil, fmt.Errorf("[%d]: duplicate example name %q", i, name)
+		}
+		value, err := b.exampleValue(msg, ex)
+		if err != nil {
+			return nil, fmt.Errorf("[%d]: %w", i, err)
+		}
+		if obj, ok := value.(map[string]any); ok {
+			for _, p := range drop {
+				delete(obj, p)
+			}
+		}
+		out[name] = &Example{
+			Summary:     ex.GetSummary(),
+			Description: ex.GetDescription(),
+			Value:       value,
+		}
+	}
+	return out, nil
+}
+
+// exampleValue returns the value of ex, an example of msg, in the JSON form
+// written by protojson. The example is decoded into a message of the
+// descriptor of msg, so that a field or value it does not define is
+// reported as an error.
+func (b *schemaBuilder) exampleValue(msg *descriptor.Message, ex *options.Example) (any, error) {
+	md, err := b.messageDescriptor(msg)
+	if err != nil {
+		return nil, err
+	}
+	types := dynamicpb.NewTypes(b.files)
+	m := dynamicpb.NewMessage(md)
+
+	var content []byte
+	isJSON := false
+	switch ex.WhichValue() {
+	case options.Example_Json_case:
+		content, isJSON = []byte(ex.GetJson()), true
+	case options.Example_Textproto_case:
+		content = []byte(ex.GetTextproto())
+	case options.Example_File_case:
+		path := ex.GetFile()
+		switch filepath.Ext(path) {
+		case ".json":
+			isJSON = true
+		case ".textproto", ".txtpb", ".pbtxt":
+		default:
+			return nil, fmt.Errorf("file %q: unknown format; want a .json, .textproto, .txtpb or .pbtxt file", path)
+		}
+		if content, err = os.ReadFile(path); err != nil {
+			return nil, fmt.Errorf("file: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("one of json, textproto or file is required")
+	}
+
+	name := strings.TrimPrefix(msg.FQMN(), ".")
+	if isJSON {
+		err = protojson.UnmarshalOptions{Resolver: types}.Unmarshal(content, m)
+	} else {
+		err = prototext.UnmarshalOptions{Resolver: types}.Unmarshal(content, m)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s example: %w", name, err)
+	}
+	body, err := protojson.MarshalOptions{Resolver: types}.Marshal(m)
+	if err != nil {
+		return nil, fmt.Errorf("encode %s example: %w", name, err)
+	}
+	var value any
+	if err := json.Unmarshal(body, &value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// messageDescriptor returns the descriptor of msg, built from the proto
+// files of the registry. The files are only built once per document, when
+// an example first needs them.
+func (b *schemaBuilder) messageDescriptor(msg *descriptor.Message) (protoreflect.MessageDescriptor, error) {
+	if b.files == nil {
+		b.files = new(protoregistry.Files)
+	}
+	if err := b.registerFile(msg.File.FileDescriptorProto); err != nil {
+		return nil, err
+	}
+	d, err := b.files.FindDescriptorByName(protoreflect.FullName(strings.TrimPrefix(msg.FQMN(), ".")))
+	if err != nil {
+		return nil, err
+	}
+	md, ok := d.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a message", d.FullName())
+	}
+	return md, nil
+}
+
+// registerFile registers fd and its dependencies in b.files. Dependencies
+// missing from the registry are looked up in protoregistry.GlobalFiles.
+func (b *schemaBuilder) registerFile(fd *descriptorpb.FileDescriptorProto) error {
+	if _, err := b.files.FindFileByPath(fd.GetName()); err == nil {
+		return nil
+	}
+	for _, dep := range fd.GetDependency() {
+		if f, err := b.reg.LookupFile(dep); err == nil {
+			if err := b.registerFile(f.FileDescriptorProto); err != nil {
+				return err
+			}
+			continue
+		}
+		f, err := protoregistry.GlobalFiles.FindFileByPath(dep)
+		if err != nil {
+			return fmt.Errorf("missing dependency %q of %q", dep, fd.GetName())
+		}
+		if err := b.registerFile(protodesc.ToFileDescriptorProto(f)); err != nil {
+			return err
+		}
+	}
+	file, err := protodesc.NewFile(fd, b.files)
+	if err != nil {
+		return fmt.Errorf("build %q: %w", fd.GetName(), err)
+	}
+	return b.files.RegisterFile(file)
+}
@@ -0,0 +1,70 @@
+package genopenapi
+
+import (
+	"bytes"
+	"errors"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// Format is the content format of the generated documents.
+type Format string
+
+const (
+	FormatJSON Format = "json"
+	FormatYAML Format = "yaml"
+)
+
+// Validate returns an error if f is not a supported format.
+func (f Format) Validate() error {
+	switch f {
+	case FormatJSON, FormatYAML:
+		return nil
+	default:
+		return errors.New("unknown format: " + string(f))
+	}
+}
+
+// extension returns the file name extension of the documents in format f.
+func (f Format) extension() string {
+	if f == FormatYAML {
+		return ".openapi.yaml"
+	}
+	return ".openapi.json"
+}
+
+// encode converts body, an indented JSON document, to format f.
+//
+// YAML is produced by decoding the JSON into a yaml.Node, which keeps the
+// key order of the custom MarshalJSON shims, so the YAML output is as
+// deterministic as the JSON one. The JSON styles (flow collections, quoted
+// strings) are reset so the encoder picks the block style and only quotes
+// the strings that would otherwise be read back as another type.
+func (f Format) encode(body []byte) ([]byte, error) {
+	if f != FormatYAML {
+		return body, nil
+	}
+	var node yaml.Node
+	if err := yaml.Unmarshal(body, &node); err != nil {
+		return nil, err
+	}
+	resetStyle(&node)
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&node); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// resetStyle clears the style of n and its descendants.
+func resetStyle(n *yaml.Node) {
+	n.Style = 0
+	for _, c := range n.Content {
+		resetStyle(c)
+	}
+}
@@ -7,16 +7,25 @@ import (
 	"strings"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/internal/descriptor"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/internal/openapiv3/merge"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/pluginpb"
 )
 
-// Generate produces one OpenAPI 3.1.0 JSON document per input proto file.
-// Files without HTTP-bound services are skipped (no output file is emitted).
-func Generate(reg *descriptor.Registry, files []*descriptor.File) ([]*pluginpb.CodeGeneratorResponse_File, error) {
-	var out []*pluginpb.CodeGeneratorResponse_File
+// Generate produces one OpenAPI 3.1.0 document per input proto file, encoded
+// in format. Files without HTTP-bound services are skipped (no output file is
+// emitted). When merging is allowed by the registry, the documents are merged
+// into a single document named after the registry's merge file name instead.
+func Generate(reg *descriptor.Registry, files []*descriptor.File, format Format) ([]*pluginpb.CodeGeneratorResponse_File, error) {
+	strategy := reg.GetOpenAPIv3NamingStrategy()
+	resolveNames := LookupNamingStrategy(strategy)
+	if resolveNames == nil {
+		return nil, fmt.Errorf("unknown naming strategy %q", strategy)
+	}
+
+	var docs []fileDocument
 	for _, file := range files {
-		doc, ok, err := generateFile(reg, file)
+		doc, derived, ok, err := generateFile(reg, file)
 		if err != nil {
 			return nil, fmt.Errorf("%s: %w", file.GetName(), err)
 		}
@@ -24,31 +33,138 @@ func Generate(reg *descriptor.Registry, files []*descriptor.File) ([]*pluginpb.C
 			// No HTTP annotations found, skip file.
 			continue
 		}
-		body, err := json.MarshalIndent(doc, "", "  ")
+		docs = append(docs, fileDocument{file: file, doc: doc, derived: derived})
+	}
+	if !isFQNNamingStrategy(strategy) {
+		if err := resolveComponentNames(reg, docs, resolveNames); err != nil {
+			return nil, err
+		}
+	}
+
+	var inputs []merge.Input
+	for _, d := range docs {
+		body, err := json.MarshalIndent(d.doc, "", "  ")
 		if err != nil {
-			return nil, fmt.Errorf("marshal %s: %w", file.GetName(), err)
+			return nil, fmt.Errorf("marshal %s: %w", d.file.GetName(), err)
 		}
-		base := strings.TrimSuffix(file.GetName(), path.Ext(file.GetName()))
-		out = append(out, &pluginpb.CodeGeneratorResponse_File{
-			Name:    proto.String(base + ".openapi.json"),
-			Content: proto.String(string(body)),
-		})
+		inputs = append(inputs, merge.Input{Name: d.file.GetName(), Data: body})
+	}
+
+	if reg.IsAllowMerge() {
+		if len(inputs) == 0 {
+			return nil, nil
+		}
+		body, err := merge.Merge(inputs)
+		if err != nil {
+			return nil, fmt.Errorf("merge into %s: %w", reg.GetMergeFileName(), err)
+		}
+		f, err := encodeFile(reg.GetMergeFileName(), body, format)
+		if err != nil {
+			return nil, err
+		}
+		return []*pluginpb.CodeGeneratorResponse_File{f}, nil
+	}
+
+	var out []*pluginpb.CodeGeneratorResponse_File
+	for _, in := range inputs {
+		base := strings.TrimSuffix(in.Name, path.Ext(in.Name))
+		f, err := encodeFile(base, in.Data, format)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, f)
 	}
 	return out, nil
 }
 
-// generateFile builds a Document for a single proto file. The boolean return
-// is false when the file has no HTTP-bound operations to emit.
-func generateFile(reg *descriptor.Registry, file *descriptor.File) (*Document, bool, error) {
+// fileDocument is the document generated for a proto file.
+type fileDocument struct {
+	file *descriptor.File
+	doc  *Document
+	// derived holds the component schemas of doc derived from the schema
+	// of a message, by name.
+	derived map[string]derivedSchema
+}
+
+// resolveComponentNames renames the component schemas of the documents of
+// docs, generated with the fully-qualified names of the proto types as
+// component names, after the names resolved by resolveNames. The names are
+// resolved across all the documents when they are merged, so that the same
+// type has the same name in all of them.
+func resolveComponentNames(reg *descriptor.Registry, docs []fileDocument, resolveNames func([]string) map[string]string) error {
+	var names map[string]string
+	if reg.IsAllowMerge() {
+		var types []string
+		for _, d := range docs {
+			types = append(types, d.typeNames()...)
+		}
+		names = resolveNames(types)
+	}
+	for _, d := range docs {
+		fileNames := names
+		if fileNames == nil {
+			fileNames = resolveNames(d.typeNames())
+		}
+		if err := d.renameSchemas(fileNames); err != nil {
+			return fmt.Errorf("%s: %w", d.file.GetName(), err)
+		}
+	}
+	return nil
+}
+
+// typeNames returns the fully-qualified names of the proto types with a
+// component schema in the document. The derived schemas are named after
+// their message, so they are not proto types of their own.
+func (d fileDocument) typeNames() []string {
+	if d.doc.Components == nil {
+		return nil
+	}
+	var names []string
+	for name := range d.doc.Components.Schemas {
+		if _, ok := d.derived[name]; ok {
+			continue
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+// encodeFile returns the output file named after base holding body, a JSON
+// document, encoded in format.
+func encodeFile(base string, body []byte, format Format) (*pluginpb.CodeGeneratorResponse_File, error) {
+	content, err := format.encode(body)
+	if err != nil {
+		return nil, fmt.Errorf("encode %s: %w", base, err)
+	}
+	return &pluginpb.CodeGeneratorResponse_File{
+		Name:    proto.String(base + format.extension()),
+		Content: proto.String(string(content)),
+	}, nil
+}
+
+// generateFile builds a Document for a single proto file, naming the
+// components of the proto types after their fully-qualified names, and
+// returns the component schemas derived from the schema of a message, by
+// name. The boolean return is false when the file has no HTTP-bound
+// operations to emit.
+func generateFile(reg *descriptor.Registry, file *descriptor.File) (*Document, map[string]derivedSchema, bool, error) {
 	name := file.GetName()
 	title := strings.TrimSuffix(path.Base(name), path.Ext(name))
 	doc := NewDocument(title, "1.0.0")
-	if d, ok := fileDocumentAnnotation(file); ok {
+	if reg.GetEventStreamResponses() {
+		// The event stream media types are described with itemSchema,
+		// defined by OpenAPI 3.2.0. All the documents declare the same
+		// version, so that they can be merged.
+		doc.OpenAPI = "3.2.0"
+	}
+	applyServiceConfig(doc, reg.GetServiceConfig())
+	b := newSchemaBuilder(reg, doc)
+	if d, ok := fileDocumentAnnotation(reg, file); ok {
 		if err := applyDocumentOverride(doc, d); err != nil {
-			return nil, false, err
+			return nil, nil, false, err
 		}
+		b.statusDetailTypes = d.GetStatusDetailTypes()
 	}
-	b := newSchemaBuilder(reg, doc)
 
 	// Prime seenTags with any document-level annotation tags so a service's
 	// default tag does not clobber the annotation-provided description.
@@ -92,10 +208,10 @@ func generateFile(reg *descriptor.Registry, file *descriptor.File) (*Document, b
 				urlPath, pathParams := convertPathTemplate(binding.PathTmpl.Template)
 				op, err := buildOperation(b, svc, method, binding, i, pathParams)
 				if err != nil {
-					return nil, false, err
+					return nil, nil, false, err
 				}
 				if prev, dup := seenOpIDs[op.OperationID]; dup {
-					return nil, false, fmt.Errorf("openapiv3: duplicate operationId %q (used by %s and %s.%s); set a unique openapiv3_operation.operation_id",
+					return nil, nil, false, fmt.Errorf("openapiv3: duplicate operationId %q (used by %s and %s.%s); set a unique openapiv3_operation.operation_id",
 						op.OperationID, prev, svc.GetName(), method.GetName())
 				}
 				seenOpIDs[op.OperationID] = svc.GetName() + "." + method.GetName()
@@ -121,11 +237,15 @@ func generateFile(reg *descriptor.Registry, file *descriptor.File) (*Document, b
 			seenTags[tag] = true
 			doc.Tags = append(doc.Tags, &Tag{
 				Name:        tag,
-				Description: serviceComments(svc),
+				Description: serviceComments(reg, svc),
 			})
 		}
 	}
 
+	if b.err != nil {
+		return nil, nil, false, b.err
+	}
+
 	// Validate that every tag referenced by an operation is declared
 	// somewhere — either by a service-derived default or by a
 	// document-level openapiv3_document.tags annotation. An orphan tag
@@ -133,18 +253,18 @@ func generateFile(reg *descriptor.Registry, file *descriptor.File) (*Document, b
 	// almost always a mistake (a typo, or a forgotten doc.tags entry).
 	for _, ref := range opTagRefs {
 		if !seenTags[ref.tag] {
-			return nil, false, fmt.Errorf("openapiv3: operation %q references undeclared tag %q; add it to openapiv3_document.tags",
+			return nil, nil, false, fmt.Errorf("openapiv3: operation %q references undeclared tag %q; add it to openapiv3_document.tags",
 				ref.operationID, ref.tag)
 		}
 	}
 
 	if doc.Paths.Len() == 0 {
-		return nil, false, nil
+		return nil, nil, false, nil
 	}
 	if doc.Components.Empty() {
 		doc.Components = nil
 	}
-	return doc, true, nil
+	return doc, b.derived, true, nil
 }
 
 // opTagRef remembers a tag reference from an operation, deferred for
@@ -3,12 +3,17 @@ package genopenapi
 import (
 	"encoding/json"
 	"os"
+	"path/filepath"
+	"slices"
 	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/internal/descriptor"
+	"go.yaml.in/yaml/v3"
 	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
 	"google.golang.org/protobuf/types/pluginpb"
 )
 
@@ -673,6 +678,46 @@ func TestGenerate_AnnotationErrors(t *testing.T) {
 			fixture: "testdata/orphan_operation_tag.prototext",
 			wantErr: `references undeclared tag "Undeclared"`,
 		},
+		{
+			name:    "security requirement references undeclared scheme",
+			fixture: "testdata/security_undeclared_scheme.prototext",
+			wantErr: `security[0]: undeclared security scheme "missing"`,
+		},
+		{
+			name:    "security requirement references undeclared scope",
+			fixture: "testdata/security_undeclared_scope.prototext",
+			wantErr: `security[0]: scope "admin" is not declared by any flow of security scheme "oauth"`,
+		},
+		{
+			name:    "security requirement with no_security",
+			fixture: "testdata/security_no_security_conflict.prototext",
+			wantErr: `no_security: not allowed together with security`,
+		},
+		{
+			name:    "oauth2 security scheme missing flows",
+			fixture: "testdata/security_scheme_missing_flows.prototext",
+			wantErr: `security_schemes["oauth"]: flows: at least one flow is required for type oauth2`,
+		},
+		{
+			name:    "response with invalid status code",
+			fixture: "testdata/response_invalid_status_code.prototext",
+			wantErr: `responses["600"]: invalid status code`,
+		},
+		{
+			name:    "response with unknown message",
+			fixture: "testdata/response_unknown_message.prototext",
+			wantErr: `responses["404"]: message: unknown message "bad.v1.Missing"`,
+		},
+		{
+			name:    "field schema with invalid default",
+			fixture: "testdata/schema_invalid_default.prototext",
+			wantErr: `openapiv3 field .bad.v1.GetRequest.id: default: invalid JSON "unquoted"`,
+		},
+		{
+			name:    "extension without x- prefix",
+			fixture: "testdata/extension_invalid_name.prototext",
+			wantErr: `openapiv3 document extensions["internal"]: name must start with "x-"`,
+		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -817,7 +862,7 @@ func TestGenerate_DisableDefaultErrors(t *testing.T) {
 		}
 		targets = append(targets, f)
 	}
-	out, err := Generate(reg, targets)
+	out, err := Generate(reg, targets, FormatJSON)
 	if err != nil {
 		t.Fatalf("generate: %v", err)
 	}
@@ -851,6 +896,1455 @@ func TestGenerate_DisableDefaultErrors(t *testing.T) {
 	}
 }
 
+// TestGenerate_HTTPStatusMappings verifies that every status of the configured
+// HTTP status mapping is documented as a google.rpc.Status error response, with
+// the descriptions of mappings sharing a status merged.
+func TestGenerate_HTTPStatusMappings(t *testing.T) {
+	t.Parallel()
+
+	req := loadRequest(t, "testdata/simple_echo.prototext")
+
+	reg := descriptor.NewRegistry()
+	if err := reg.Load(req); err != nil {
+		t.Fatalf("registry load: %v", err)
+	}
+	if err := reg.SetHTTPStatusMappings([]string{"FAILED_PRECONDITION:409", "NOT_FOUND:410"}, []string{"RESOURCE_DELETED:410"}); err != nil {
+		t.Fatalf("set HTTP status mappings: %v", err)
+	}
+
+	var targets []*descriptor.File
+	for _, name := range req.FileToGenerate {
+		f, err := reg.LookupFile(name)
+		if err != nil {
+			t.Fatalf("lookup %s: %v", name, err)
+		}
+		targets = append(targets, f)
+	}
+	out, err := Generate(reg, targets, FormatJSON)
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+	if len(out) != 1 {
+		t.Fatalf("expected 1 output file, got %d", len(out))
+	}
+
+	var doc map[string]any
+	if err := json.Unmarshal([]byte(out[0].GetContent()), &doc); err != nil {
+		t.Fatalf("unmarshal output: %v", err)
+	}
+
+	want := map[string]string{
+		"409": "Returned when the call fails with code FAILED_PRECONDITION.",
+		"410": "Returned when the call fails with code NOT_FOUND. Returned when the call fails with an ErrorInfo of reason RESOURCE_DELETED.",
+	}
+	paths, _ := doc["paths"].(map[string]any)
+	if len(paths) == 0 {
+		t.Fatalf("no paths generated")
+	}
+	for urlPath, item := range paths {
+		m, _ := item.(map[string]any)
+		for method, op := range m {
+			o, _ := op.(map[string]any)
+			responses, _ := o["responses"].(map[string]any)
+			for st, desc := range want {
+				r, ok := responses[st].(map[string]any)
+				if !ok {
+					t.Errorf("%s %s: missing %s response", method, urlPath, st)
+					continue
+				}
+				if got := r["description"]; got != desc {
+					t.Errorf("%s %s: %s description = %q, want %q", method, urlPath, st, got, desc)
+				}
+			}
+		}
+	}
+}
+
+func TestGenerate_ServiceConfig(t *testing.T) {
+	t.Parallel()
+
+	req := loadRequest(t, "testdata/simple_echo.prototext")
+
+	config := filepath.Join(t.TempDir(), "echo.yaml")
+	if err := os.WriteFile(config, []byte(`
+type: google.api.Service
+config_version: 3
+name: echo.example.com
+title: Echo API
+
+documentation:
+  summary: Echoes messages.
+  rules:
+  - selector: example.v1.EchoService.Echo
+    description: Echoes the message of the request.
+  - selector: example.v1.EchoResponse.message
+    description: The echoed message.
+
+authentication:
+  providers:
+  - id: google_id_token
+    issuer: https://accounts.google.com
+  rules:
+  - selector: example.v1.EchoService.Echo
+    requirements:
+    - provider_id: google_id_token
+`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	reg := descriptor.NewRegistry()
+	if err := reg.LoadGrpcAPIServiceFromYAML(config); err != nil {
+		t.Fatalf("load gRPC API Configuration: %v", err)
+	}
+	if err := reg.Load(req); err != nil {
+		t.Fatalf("registry load: %v", err)
+	}
+	var targets []*descriptor.File
+	for _, name := range req.FileToGenerate {
+		f, err := reg.LookupFile(name)
+		if err != nil {
+			t.Fatalf("lookup %s: %v", name, err)
+		}
+		targets = append(targets, f)
+	}
+	out, err := Generate(reg, targets, FormatJSON)
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+	if len(out) != 1 {
+		t.Fatalf("expected 1 output file, got %d", len(out))
+	}
+
+	var doc struct {
+		Info       Info `json:"info"`
+		Components struct {
+			Schemas map[string]struct {
+				Properties map[string]struct {
+					Description string `json:"description"`
+				} `json:"properties"`
+			} `json:"schemas"`
+			SecuritySchemes map[string]SecurityScheme `json:"securitySchemes"`
+		} `json:"components"`
+		Paths map[string]map[string]Operation `json:"paths"`
+	}
+	if err := json.Unmarshal([]byte(out[0].GetContent()), &doc); err != nil {
+		t.Fatalf("unmarshal output: %v", err)
+	}
+
+	if got, want := doc.Info.Title, "Echo API"; got != want {
+		t.Errorf("info.title = %q, want %q", got, want)
+	}
+	if got, want := doc.Info.Description, "Echoes messages."; got != want {
+		t.Errorf("info.description = %q, want %q", got, want)
+	}
+	wantScheme := SecurityScheme{
+		Type:         "http",
+		Description:  "JSON Web Tokens issued by https://accounts.google.com.",
+		Scheme:       "bearer",
+		BearerFormat: "JWT",
+	}
+	if diff := cmp.Diff(wantScheme, doc.Components.SecuritySchemes["google_id_token"]); diff != "" {
+		t.Errorf("securitySchemes.google_id_token mismatch (-want +got):\n%s", diff)
+	}
+
+	var echo, getEcho *Operation
+	for _, item := range doc.Paths {
+		for _, op := range item {
+			switch op.OperationID {
+			case "EchoService_Echo":
+				echo = &op
+			case "EchoService_GetEcho":
+				getEcho = &op
+			}
+		}
+	}
+	if echo == nil || getEcho == nil {
+		t.Fatalf("missing operations in paths %v", doc.Paths)
+	}
+	if got, want := echo.Summary, "Echoes the message of the request."; got != want {
+		t.Errorf("Echo summary = %q, want %q", got, want)
+	}
+	if diff := cmp.Diff([]SecurityRequirement{{"google_id_token": {}}}, echo.Security); diff != "" {
+		t.Errorf("Echo security mismatch (-want +got):\n%s", diff)
+	}
+	if getEcho.Security != nil {
+		t.Errorf("GetEcho security = %v, want none", getEcho.Security)
+	}
+	if got, want := doc.Components.Schemas["example.v1.EchoResponse"].Properties["message"].Description, "The echoed message."; got != want {
+		t.Errorf("EchoResponse.message description = %q, want %q", got, want)
+	}
+}
+
+// TestGenerate_SchemaKeywords covers the JSON Schema keywords of the
+// openapiv3_schema and openapiv3_field annotations and the
+// google.api.field_info formats, on properties and parameters.
+func TestGenerate_SchemaKeywords(t *testing.T) {
+	t.Parallel()
+
+	req := loadRequest(t, "testdata/schema_keywords.prototext")
+	got := runGenerator(t, req)
+
+	var doc struct {
+		Components struct {
+			Schemas map[string]struct {
+				Properties map[string]any `json:"properties"`
+				Examples   []any          `json:"examples"`
+			} `json:"schemas"`
+		} `json:"components"`
+		Paths map[string]map[string]struct {
+			Parameters []struct {
+				Name   string `json:"name"`
+				Schema any    `json:"schema"`
+			} `json:"parameters"`
+		} `json:"paths"`
+	}
+	if err := json.Unmarshal(got, &doc); err != nil {
+		t.Fatalf("unmarshal output: %v\n%s", err, string(got))
+	}
+
+	name := map[string]any{
+		"type":      "string",
+		"pattern":   "^[a-z]+$",
+		"minLength": 0.0,
+		"maxLength": 63.0,
+		"default":   "widget",
+		"examples":  []any{"gear"},
+	}
+	wantProps := map[string]any{
+		"id":   map[string]any{"type": "string", "format": "uuid"},
+		"name": name,
+		"count": map[string]any{
+			"type":       "integer",
+			"format":     "int32",
+			"minimum":    0.0,
+			"maximum":    100.0,
+			"multipleOf": 5.0,
+			"readOnly":   true,
+		},
+		"tags": map[string]any{
+			"type":        "array",
+			"items":       map[string]any{"type": "string", "pattern": "^[a-z]+$"},
+			"minItems":    1.0,
+			"maxItems":    10.0,
+			"uniqueItems": true,
+		},
+		"addresses": map[string]any{
+			"type":  "array",
+			"items": map[string]any{"type": "string", "format": "ipv6"},
+		},
+		"range": map[string]any{
+			"allOf":   []any{map[string]any{"$ref": "#/components/schemas/kw.v1.Range"}},
+			"default": map[string]any{"start": 0.0},
+		},
+		"address": map[string]any{"type": "string", "format": "hostname"},
+	}
+	if diff := cmp.Diff(wantProps, doc.Components.Schemas["kw.v1.Widget"].Properties); diff != "" {
+		t.Errorf("kw.v1.Widget properties mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]any{map[string]any{"start": 1.0}}, doc.Components.Schemas["kw.v1.Range"].Examples); diff != "" {
+		t.Errorf("kw.v1.Range examples mismatch (-want +got):\n%s", diff)
+	}
+
+	params := map[string]any{}
+	for _, p := range doc.Paths["/v1/widgets/{id}"]["get"].Parameters {
+		params[p.Name] = p.Schema
+	}
+	if diff := cmp.Diff(wantProps["id"], params["id"]); diff != "" {
+		t.Errorf("id parameter schema mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(name, params["name"]); diff != "" {
+		t.Errorf("name parameter schema mismatch (-want +got):\n%s", diff)
+	}
+}
+
+// TestGenerate_Extensions covers the specification extensions of every
+// annotated object, which are emitted after the fields of the object in
+// name order.
+func TestGenerate_Extensions(t *testing.T) {
+	t.Parallel()
+
+	req := loadRequest(t, "testdata/extensions.prototext")
+	got := runGenerator(t, req)
+
+	var doc map[string]any
+	if err := json.Unmarshal(got, &doc); err != nil {
+		t.Fatalf("unmarshal output: %v\n%s", err, string(got))
+	}
+	lookup := func(path ...any) any {
+		var v any = doc
+		for _, p := range path {
+			switch p := p.(type) {
+			case string:
+				m, _ := v.(map[string]any)
+				v = m[p]
+			case int:
+				a, _ := v.([]any)
+				if p >= len(a) {
+					return nil
+				}
+				v = a[p]
+			}
+		}
+		return v
+	}
+	for _, tc := range []struct {
+		path []any
+		want any
+	}{
+		{[]any{"x-api-id"}, 42.0},
+		{[]any{"x-tag-groups"}, []any{"WidgetService"}},
+		{[]any{"info", "x-logo"}, "https://example.com/logo.png"},
+		{[]any{"servers", 0, "x-region"}, "eu"},
+		{[]any{"tags", 0, "x-display-name"}, "Widgets"},
+		{[]any{"paths", "/v1/widgets", "post", "x-codegen-request-body-name"}, "widget"},
+		{[]any{"paths", "/v1/widgets", "post", "x-speakeasy-retries"}, map[string]any{"strategy": "backoff"}},
+		{[]any{"components", "schemas", "ext.v1.Widget", "x-internal"}, true},
+		{[]any{"components", "schemas", "ext.v1.Widget", "properties", "id", "x-go-name"}, "ID"},
+	} {
+		if diff := cmp.Diff(tc.want, lookup(tc.path...)); diff != "" {
+			t.Errorf("%v mismatch (-want +got):\n%s", tc.path, diff)
+		}
+	}
+
+	// Extensions follow the fields of the object, sorted by name.
+	out := string(got)
+	if i, j := strings.Index(out, `"x-api-id"`), strings.Index(out, `"x-tag-groups"`); i < strings.Index(out, `"tags"`) || j < i {
+		t.Errorf("document extensions out of order:\n%s", out)
+	}
+}
+
+// TestGenerate_Security covers the security schemes of the document
+// annotation and the precedence of the document-, service- and
+// operation-level security requirements.
+func TestGenerate_Security(t *testing.T) {
+	t.Parallel()
+
+	req := loadRequest(t, "testdata/security.prototext")
+	got := runGenerator(t, req)
+
+	var doc struct {
+		Components struct {
+			SecuritySchemes map[string]SecurityScheme `json:"securitySchemes"`
+		} `json:"components"`
+		Security []SecurityRequirement           `json:"security"`
+		Paths    map[string]map[string]Operation `json:"paths"`
+	}
+	if err := json.Unmarshal(got, &doc); err != nil {
+		t.Fatalf("unmarshal output: %v\n%s", err, string(got))
+	}
+
+	wantSchemes := map[string]SecurityScheme{
+		"api_key": {Type: "apiKey", Description: "API key issued by the console.", Name: "X-API-Key", In: "header"},
+		"basic":   {Type: "http", Scheme: "basic"},
+		"bearer":  {Type: "http", Scheme: "bearer", BearerFormat: "JWT"},
+		"oauth": {Type: "oauth2", Flows: &OAuthFlows{
+			AuthorizationCode: &OAuthFlow{
+				AuthorizationURL: "https://auth.example.com/authorize",
+				TokenURL:         "https://auth.example.com/token",
+				Scopes:           map[string]string{"read": "Read things."},
+			},
+			ClientCredentials: &OAuthFlow{
+				TokenURL: "https://auth.example.com/token",
+				Scopes:   map[string]string{"write": "Write things."},
+			},
+		}},
+		"oidc": {Type: "openIdConnect", OpenIDConnectURL: "https://auth.example.com/.well-known/openid-configuration"},
+		"mtls": {Type: "mutualTLS"},
+	}
+	if diff := cmp.Diff(wantSchemes, doc.Components.SecuritySchemes); diff != "" {
+		t.Errorf("securitySchemes mismatch (-want +got):\n%s", diff)
+	}
+
+	wantDoc := []SecurityRequirement{{"api_key": {}}, {"oidc": {"profile"}}}
+	if diff := cmp.Diff(wantDoc, doc.Security); diff != "" {
+		t.Errorf("security mismatch (-want +got):\n%s", diff)
+	}
+
+	for _, tc := range []struct {
+		path, method string
+		want         []SecurityRequirement
+	}{
+		// The service requirements replace the document ones; the empty
+		// requirement makes the security optional.
+		{"/v1/things/{id}", "get", []SecurityRequirement{{"bearer": {}}, {}}},
+		// The operation requirements replace the service ones.
+		{"/v1/things/{id}", "delete", []SecurityRequirement{{"oauth": {"read", "write"}, "mtls": {}}}},
+		// No override: the document requirements apply.
+		{"/v1/health", "get", nil},
+		// The operation opts out of the service and document requirements.
+		{"/v1/things", "get", []SecurityRequirement{}},
+		// The service opts out of the document requirements.
+		{"/v1/login", "post", []SecurityRequirement{}},
+		// The operation requirements replace the service opt-out.
+		{"/v1/logout", "post", []SecurityRequirement{{"bearer": {}}}},
+	} {
+		op, ok := doc.Paths[tc.path][tc.method]
+		if !ok {
+			t.Errorf("missing operation %s %s", tc.method, tc.path)
+			continue
+		}
+		if diff := cmp.Diff(tc.want, op.Security); diff != "" {
+			t.Errorf("%s %s security mismatch (-want +got):\n%s", tc.method, tc.path, diff)
+		}
+	}
+}
+
+// TestGenerate_Responses covers the merge of the responses of the service
+// and operation annotations with the generated ones.
+func TestGenerate_Responses(t *testing.T) {
+	t.Parallel()
+
+	req := loadRequest(t, "testdata/responses.prototext")
+	got := runGenerator(t, req)
+
+	var doc struct {
+		Paths map[string]map[string]struct {
+			Responses map[string]any `json:"responses"`
+		} `json:"paths"`
+	}
+	if err := json.Unmarshal(got, &doc); err != nil {
+		t.Fatalf("unmarshal output: %v\n%s", err, string(got))
+	}
+
+	status := map[string]any{
+		"application/json": map[string]any{"schema": map[string]any{"$ref": "#/components/schemas/google.rpc.Status"}},
+	}
+	notFound := map[string]any{"description": "Not Found", "content": status}
+	serverError := map[string]any{
+		"description": "A server error.",
+		"content":     status,
+		"headers": map[string]any{
+			"Retry-After": map[string]any{"schema": map[string]any{"type": "integer"}},
+		},
+	}
+	defaultError := map[string]any{"description": "An unexpected error response.", "content": status}
+	thing := map[string]any{"schema": map[string]any{"$ref": "#/components/schemas/resp.v1.Thing"}}
+	conflict := map[string]any{"schema": map[string]any{"$ref": "#/components/schemas/resp.v1.Conflict"}}
+
+	for _, tc := range []struct {
+		path, method string
+		want         map[string]any
+	}{
+		{
+			// The 201 replaces the generated 200; the service responses
+			// are merged in.
+			path: "/v1/things", method: "post",
+			want: map[string]any{
+				"default": defaultError,
+				"201": map[string]any{
+					"description": "The thing was created.",
+					"content":     map[string]any{"application/json": thing},
+					"headers": map[string]any{
+						"Location": map[string]any{
+							"description": "The URL of the created thing.",
+							"required":    true,
+							"schema":      map[string]any{"type": "string", "format": "uri"},
+						},
+					},
+				},
+				"409": map[string]any{
+					"description": "Conflict",
+					"content":     map[string]any{"application/json": conflict, "application/problem+json": conflict},
+				},
+				"404": notFound,
+				"5XX": serverError,
+			},
+		},
+		{
+			// The operation 404 replaces the service one.
+			path: "/v1/things/{id}", method: "delete",
+			want: map[string]any{
+				"default": defaultError,
+				"204":     map[string]any{"description": "No Content"},
+				"404":     map[string]any{"description": "No such thing."},
+				"5XX":     serverError,
+			},
+		},
+		{
+			path: "/v1/things/{id}", method: "get",
+			want: map[string]any{
+				"default": defaultError,
+				"200":     map[string]any{"description": "A successful response.", "content": map[string]any{"application/json": thing}},
+				"404":     notFound,
+				"5XX":     serverError,
+			},
+		},
+	} {
+		op, ok := doc.Paths[tc.path][tc.method]
+		if !ok {
+			t.Errorf("missing operation %s %s", tc.method, tc.path)
+			continue
+		}
+		if diff := cmp.Diff(tc.want, op.Responses); diff != "" {
+			t.Errorf("%s %s responses mismatch (-want +got):\n%s", tc.method, tc.path, diff)
+		}
+	}
+}
+
+// TestGenerate_Streaming verifies that server-streaming responses are
+// documented as newline-delimited stream results, optionally as server-sent
+// events, and that client-streaming request bodies are documented as
+// newline-delimited request messages.
+func TestGenerate_Streaming(t *testing.T) {
+	t.Parallel()
+
+	resultRef := map[string]any{"$ref": "#/components/schemas/stream.v1.Tick.StreamResult"}
+	tick := map[string]any{
+		"type":       "object",
+		"properties": map[string]any{"id": map[string]any{"type": "string"}},
+	}
+	for _, tc := range []struct {
+		name        string
+		eventStream bool
+		wantVersion string
+		wantContent map[string]any
+	}{
+		{
+			name:        "default",
+			wantVersion: "3.1.0",
+			wantContent: map[string]any{
+				"application/x-ndjson": map[string]any{"schema": resultRef},
+				"application/jsonl":    map[string]any{"schema": resultRef},
+			},
+		},
+		{
+			name:        "event stream responses",
+			eventStream: true,
+			// itemSchema is defined by OpenAPI 3.2.0.
+			wantVersion: "3.2.0",
+			wantContent: map[string]any{
+				"application/x-ndjson": map[string]any{"schema": resultRef},
+				"application/jsonl":    map[string]any{"schema": resultRef},
+				"text/event-stream":    map[string]any{"itemSchema": resultRef},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := loadRequest(t, "testdata/streaming.prototext")
+			reg := descriptor.NewRegistry()
+			if err := reg.Load(req); err != nil {
+				t.Fatalf("registry load: %v", err)
+			}
+			reg.SetEventStreamResponses(tc.eventStream)
+			f, err := reg.LookupFile(req.FileToGenerate[0])
+			if err != nil {
+				t.Fatalf("lookup %s: %v", req.FileToGenerate[0], err)
+			}
+			out, err := Generate(reg, []*descriptor.File{f}, FormatJSON)
+			if err != nil {
+				t.Fatalf("generate: %v", err)
+			}
+
+			var doc struct {
+				OpenAPI string `json:"openapi"`
+				Paths   map[string]map[string]struct {
+					RequestBody map[string]any `json:"requestBody"`
+					Responses   map[string]any `json:"responses"`
+				} `json:"paths"`
+				Components struct {
+					Schemas map[string]any `json:"schemas"`
+				} `json:"components"`
+			}
+			if err := json.Unmarshal([]byte(out[0].GetContent()), &doc); err != nil {
+				t.Fatalf("unmarshal output: %v", err)
+			}
+			if doc.OpenAPI != tc.wantVersion {
+				t.Errorf("openapi = %q; want %q", doc.OpenAPI, tc.wantVersion)
+			}
+
+			wantOK := map[string]any{
+				"description": "A successful response. (streaming responses)",
+				"content":     tc.wantContent,
+			}
+			if diff := cmp.Diff(wantOK, doc.Paths["/v1/topics/{topic}:watch"]["get"].Responses["200"]); diff != "" {
+				t.Errorf("watch 200 response mismatch (-want +got):\n%s", diff)
+			}
+
+			wantResult := map[string]any{
+				"type":  "object",
+				"title": "Stream result of stream.v1.Tick",
+				"properties": map[string]any{
+					"result": map[string]any{"$ref": "#/components/schemas/stream.v1.Tick"},
+					"error":  map[string]any{"$ref": "#/components/schemas/google.rpc.Status"},
+				},
+			}
+			if diff := cmp.Diff(wantResult, doc.Components.Schemas["stream.v1.Tick.StreamResult"]); diff != "" {
+				t.Errorf("stream.v1.Tick.StreamResult mismatch (-want +got):\n%s", diff)
+			}
+
+			wantBody := map[string]any{
+				"description": "A stream of request messages, one JSON value per line (streaming inputs).",
+				"required":    true,
+				"content": map[string]any{
+					"application/x-ndjson": map[string]any{"schema": tick},
+					"application/jsonl":    map[string]any{"schema": tick},
+				},
+			}
+			if diff := cmp.Diff(wantBody, doc.Paths["/v1/ticks:upload"]["post"].RequestBody); diff != "" {
+				t.Errorf("upload requestBody mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestGenerate_StreamResultCollision verifies that a message nested in a
+// streamed message and named like its stream result schema is rejected,
+// instead of replacing the stream result schema.
+func TestGenerate_StreamResultCollision(t *testing.T) {
+	t.Parallel()
+
+	req := loadRequest(t, "testdata/streaming.prototext")
+	tick := req.ProtoFile[0].MessageType[0]
+	tick.NestedType = append(tick.NestedType, &descriptorpb.DescriptorProto{Name: proto.String("StreamResult")})
+	tick.Field = append(tick.Field, &descriptorpb.FieldDescriptorProto{
+		Name:     proto.String("last"),
+		Number:   proto.Int32(2),
+		Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+		Type:     descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+		TypeName: proto.String(".stream.v1.Tick.StreamResult"),
+		JsonName: proto.String("last"),
+	})
+
+	for _, strategy := range []string{"fqn", "simple"} {
+		reg := descriptor.NewRegistry()
+		reg.SetOpenAPIv3NamingStrategy(strategy)
+		if err := reg.Load(req); err != nil {
+			t.Fatalf("registry load: %v", err)
+		}
+		f, err := reg.LookupFile(req.FileToGenerate[0])
+		if err != nil {
+			t.Fatalf("lookup %s: %v", req.FileToGenerate[0], err)
+		}
+		_, err = Generate(reg, []*descriptor.File{f}, FormatJSON)
+		if err == nil || !strings.Contains(err.Error(), `"stream.v1.Tick.StreamResult"`) {
+			t.Errorf("Generate() with the %s naming strategy error = %v; want a stream.v1.Tick.StreamResult collision", strategy, err)
+		}
+	}
+}
+
+// TestGenerate_YAML verifies that output_format=yaml emits the same document
+// as the JSON output, with the keys in the same order.
+func TestGenerate_YAML(t *testing.T) {
+	t.Parallel()
+
+	req := loadRequest(t, "testdata/simple_echo.prototext")
+	reg := descriptor.NewRegistry()
+	if err := reg.Load(req); err != nil {
+		t.Fatalf("registry load: %v", err)
+	}
+	f, err := reg.LookupFile(req.FileToGenerate[0])
+	if err != nil {
+		t.Fatalf("lookup %s: %v", req.FileToGenerate[0], err)
+	}
+	out, err := Generate(reg, []*descriptor.File{f}, FormatYAML)
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+	if len(out) != 1 {
+		t.Fatalf("expected 1 output file, got %d", len(out))
+	}
+	if got, want := out[0].GetName(), "example/v1/echo.openapi.yaml"; got != want {
+		t.Errorf("name = %q, want %q", got, want)
+	}
+
+	content := out[0].GetContent()
+	if want := "openapi: 3.1.0\ninfo:\n"; !strings.HasPrefix(content, want) {
+		t.Errorf("content does not start with %q:\n%s", want, content)
+	}
+	var doc any
+	if err := yaml.Unmarshal([]byte(content), &doc); err != nil {
+		t.Fatalf("unmarshal output: %v", err)
+	}
+	got, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatalf("marshal output: %v", err)
+	}
+	want, err := os.ReadFile("testdata/simple_echo.openapi.json")
+	if err != nil {
+		t.Fatalf("read golden: %v", err)
+	}
+	assertJSONEqual(t, got, want)
+}
+
+// TestGenerate_Merge verifies that allow_merge emits a single document with
+// the operations and schemas of every file, named after merge_file_name.
+func TestGenerate_Merge(t *testing.T) {
+	t.Parallel()
+
+	req := loadRequest(t, "testdata/simple_echo.prototext")
+	streaming := loadRequest(t, "testdata/streaming.prototext")
+	req.ProtoFile = append(req.ProtoFile, streaming.ProtoFile...)
+	req.FileToGenerate = append(req.FileToGenerate, streaming.FileToGenerate...)
+
+	reg := descriptor.NewRegistry()
+	if err := reg.Load(req); err != nil {
+		t.Fatalf("registry load: %v", err)
+	}
+	reg.SetAllowMerge(true)
+	reg.SetMergeFileName("apidocs")
+	var targets []*descriptor.File
+	for _, name := range req.FileToGenerate {
+		f, err := reg.LookupFile(name)
+		if err != nil {
+			t.Fatalf("lookup %s: %v", name, err)
+		}
+		targets = append(targets, f)
+	}
+	out, err := Generate(reg, targets, FormatJSON)
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+	if len(out) != 1 {
+		t.Fatalf("expected 1 output file, got %d", len(out))
+	}
+	if got, want := out[0].GetName(), "apidocs.openapi.json"; got != want {
+		t.Errorf("name = %q, want %q", got, want)
+	}
+
+	var doc struct {
+		Info       Info                             `json:"info"`
+		Paths      map[string]map[string]any        `json:"paths"`
+		Components struct{ Schemas map[string]any } `json:"components"`
+		Tags       []Tag                            `json:"tags"`
+	}
+	if err := json.Unmarshal([]byte(out[0].GetContent()), &doc); err != nil {
+		t.Fatalf("unmarshal output: %v", err)
+	}
+	// The info of the first document is kept.
+	if got, want := doc.Info.Title, "echo"; got != want {
+		t.Errorf("info.title = %q, want %q", got, want)
+	}
+	for _, p := range []string{"/v1/echo", "/v1/topics/{topic}:watch", "/v1/ticks:upload"} {
+		if _, ok := doc.Paths[p]; !ok {
+			t.Errorf("missing path %s", p)
+		}
+	}
+	for _, name := range []string{"example.v1.EchoResponse", "stream.v1.Tick.StreamResult", "google.rpc.Status"} {
+		if _, ok := doc.Components.Schemas[name]; !ok {
+			t.Errorf("missing component schema %s", name)
+		}
+	}
+	var tags []string
+	for _, tag := range doc.Tags {
+		tags = append(tags, tag.Name)
+	}
+	if diff := cmp.Diff([]string{"EchoService", "StreamService"}, tags); diff != "" {
+		t.Errorf("tags mismatch (-want +got):\n%s", diff)
+	}
+}
+
+// TestGenerate_NamingStrategy verifies that the components are named by the
+// naming strategy, and that the references follow. The unreferenced
+// naming.v1.Entry message must not lengthen the names of the nested Entry
+// messages.
+func TestGenerate_NamingStrategy(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		strategy    string
+		library     string
+		wantSchemas []string
+		wantRefs    map[string]string
+	}{
+		{
+			strategy:    "simple",
+			library:     "Library",
+			wantSchemas: []string{"Library", "Library.Entry", "Shelf", "Shelf.Entry", "Status"},
+			wantRefs:    map[string]string{"shelf": "Shelf", "entries": "Library.Entry"},
+		},
+		{
+			strategy:    "package",
+			library:     "Library",
+			wantSchemas: []string{"Library", "Library.Entry", "Shelf", "Shelf.Entry", "Status"},
+			wantRefs:    map[string]string{"shelf": "Shelf", "entries": "Library.Entry"},
+		},
+		{
+			strategy:    "legacy",
+			library:     "v1Library",
+			wantSchemas: []string{"rpcStatus", "v1Library", "v1LibraryEntry", "v1Shelf", "v1ShelfEntry"},
+			wantRefs:    map[string]string{"shelf": "v1Shelf", "entries": "v1LibraryEntry"},
+		},
+	} {
+		t.Run(tc.strategy, func(t *testing.T) {
+			t.Parallel()
+
+			req := loadRequest(t, "testdata/naming.prototext")
+			reg := descriptor.NewRegistry()
+			reg.SetOpenAPIv3NamingStrategy(tc.strategy)
+			if err := reg.Load(req); err != nil {
+				t.Fatalf("registry load: %v", err)
+			}
+			f, err := reg.LookupFile(req.FileToGenerate[0])
+			if err != nil {
+				t.Fatalf("lookup %s: %v", req.FileToGenerate[0], err)
+			}
+			out, err := Generate(reg, []*descriptor.File{f}, FormatJSON)
+			if err != nil {
+				t.Fatalf("generate: %v", err)
+			}
+
+			var doc struct {
+				Paths map[string]map[string]struct {
+					Responses map[string]struct {
+						Content map[string]struct {
+							Schema struct {
+								Ref string `json:"$ref"`
+							} `json:"schema"`
+						} `json:"content"`
+					} `json:"responses"`
+				} `json:"paths"`
+				Components struct {
+					Schemas map[string]struct {
+						Properties map[string]struct {
+							Ref   string `json:"$ref"`
+							Items struct {
+								Ref string `json:"$ref"`
+							} `json:"items"`
+						} `json:"properties"`
+					} `json:"schemas"`
+				} `json:"components"`
+			}
+			if err := json.Unmarshal([]byte(out[0].GetContent()), &doc); err != nil {
+				t.Fatalf("unmarshal output: %v", err)
+			}
+
+			var schemas []string
+			for name := range doc.Components.Schemas {
+				schemas = append(schemas, name)
+			}
+			slices.Sort(schemas)
+			if diff := cmp.Diff(tc.wantSchemas, schemas); diff != "" {
+				t.Errorf("component schemas mismatch (-want +got):\n%s", diff)
+			}
+
+			library := doc.Components.Schemas[tc.library]
+			gotRefs := map[string]string{
+				"shelf":   strings.TrimPrefix(library.Properties["shelf"].Ref, "#/components/schemas/"),
+				"entries": strings.TrimPrefix(library.Properties["entries"].Items.Ref, "#/components/schemas/"),
+			}
+			if diff := cmp.Diff(tc.wantRefs, gotRefs); diff != "" {
+				t.Errorf("%s refs mismatch (-want +got):\n%s", tc.library, diff)
+			}
+
+			op := doc.Paths["/v1/libraries/{id}"]["get"]
+			if got, want := op.Responses["200"].Content["application/json"].Schema.Ref, "#/components/schemas/"+tc.library; got != want {
+				t.Errorf("200 response $ref = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestGenerate_OpenAPIConfiguration(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	grpcAPIConfig := filepath.Join(dir, "config.yaml")
+	if err := os.WriteFile(grpcAPIConfig, []byte(`
+type: google.api.Service
+config_version: 3
+http:
+  rules:
+  - selector: config.v1.PingService.Ping
+    get: /v1/pings/{id}
+`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	openAPIConfig := filepath.Join(dir, "config.openapiv3.yaml")
+	if err := os.WriteFile(openAPIConfig, []byte(`
+openapiv3Options:
+  file:
+  - file: config/v1/config.proto
+    option:
+      info:
+        title: Configured API
+      tags:
+      - name: Pings
+  method:
+  - method: config.v1.PingService.Ping
+    option:
+      summary: Pings a target.
+      tags:
+      - Pings
+  message:
+  - message: config.v1.Pong
+    option:
+      description: Configured externally.
+  field:
+  - field: config.v1.Pong.id
+    option:
+      format: uuid
+`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	req := loadRequest(t, "testdata/configuration.prototext")
+	reg := descriptor.NewRegistry()
+	if err := reg.LoadGrpcAPIServiceFromYAML(grpcAPIConfig); err != nil {
+		t.Fatalf("load gRPC API configuration: %v", err)
+	}
+	if err := reg.Load(req); err != nil {
+		t.Fatalf("registry load: %v", err)
+	}
+	if err := reg.LoadOpenAPIv3ConfigFromYAML(openAPIConfig); err != nil {
+		t.Fatalf("load OpenAPI configuration: %v", err)
+	}
+	f, err := reg.LookupFile(req.FileToGenerate[0])
+	if err != nil {
+		t.Fatalf("lookup %s: %v", req.FileToGenerate[0], err)
+	}
+	out, err := Generate(reg, []*descriptor.File{f}, FormatJSON)
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+
+	type schema struct {
+		Title       string            `json:"title"`
+		Description string            `json:"description"`
+		Format      string            `json:"format"`
+		Properties  map[string]schema `json:"properties"`
+	}
+	var doc struct {
+		Info struct {
+			Title   string `json:"title"`
+			Version string `json:"version"`
+		} `json:"info"`
+		Paths map[string]map[string]struct {
+			Summary string   `json:"summary"`
+			Tags    []string `json:"tags"`
+		} `json:"paths"`
+		Components struct {
+			Schemas map[string]schema `json:"schemas"`
+		} `json:"components"`
+	}
+	if err := json.Unmarshal([]byte(out[0].GetContent()), &doc); err != nil {
+		t.Fatalf("unmarshal output: %v", err)
+	}
+
+	// Singular fields set by the configuration override the annotations,
+	// the others are kept.
+	if got, want := doc.Info.Title, "Configured API"; got != want {
+		t.Errorf("info.title = %q, want %q", got, want)
+	}
+	if got, want := doc.Info.Version, "1.0.0"; got != want {
+		t.Errorf("info.version = %q, want %q", got, want)
+	}
+
+	op, ok := doc.Paths["/v1/pings/{id}"]["get"]
+	if !ok {
+		t.Fatalf("missing GET /v1/pings/{id} bound by the gRPC API configuration; paths: %v", doc.Paths)
+	}
+	if got, want := op.Summary, "Pings a target."; got != want {
+		t.Errorf("operation summary = %q, want %q", got, want)
+	}
+	if diff := cmp.Diff([]string{"Pings"}, op.Tags); diff != "" {
+		t.Errorf("operation tags mismatch (-want +got):\n%s", diff)
+	}
+
+	pong := doc.Components.Schemas["config.v1.Pong"]
+	if got, want := pong.Title, "Pong"; got != want {
+		t.Errorf("Pong title = %q, want %q", got, want)
+	}
+	if got, want := pong.Description, "Configured externally."; got != want {
+		t.Errorf("Pong description = %q, want %q", got, want)
+	}
+	if got, want := pong.Properties["id"].Format, "uuid"; got != want {
+		t.Errorf("Pong.id format = %q, want %q", got, want)
+	}
+}
+
+func TestGenerate_TypedAny(t *testing.T) {
+	t.Parallel()
+
+	out := runGenerator(t, loadRequest(t, "testdata/any.prototext"))
+	type schema struct {
+		Ref           string             `json:"$ref"`
+		Type          any                `json:"type"`
+		Const         string             `json:"const"`
+		Required      []string           `json:"required"`
+		Properties    map[string]*schema `json:"properties"`
+		Items         *schema            `json:"items"`
+		AllOf         []*schema          `json:"allOf"`
+		OneOf         []*schema          `json:"oneOf"`
+		Discriminator *struct {
+			PropertyName string            `json:"propertyName"`
+			Mapping      map[string]string `json:"mapping"`
+		} `json:"discriminator"`
+	}
+	var doc struct {
+		Components struct {
+			Schemas map[string]*schema `json:"schemas"`
+		} `json:"components"`
+	}
+	if err := json.Unmarshal(out, &doc); err != nil {
+		t.Fatalf("unmarshal output: %v", err)
+	}
+	schemas := doc.Components.Schemas
+
+	// checkTypedAny checks that s is a oneOf over the schemas of the
+	// messages, discriminated by @type.
+	checkTypedAny := func(t *testing.T, s *schema, messages ...string) {
+		t.Helper()
+		if s == nil {
+			t.Fatal("missing schema")
+		}
+		var refs []string
+		mapping := make(map[string]string)
+		for _, m := range messages {
+			ref := "#/components/schemas/" + m + ".Any"
+			refs = append(refs, ref)
+			mapping["type.googleapis.com/"+m] = ref
+		}
+		var gotRefs []string
+		for _, o := range s.OneOf {
+			gotRefs = append(gotRefs, o.Ref)
+		}
+		if diff := cmp.Diff(refs, gotRefs); diff != "" {
+			t.Errorf("oneOf mismatch (-want +got):\n%s", diff)
+		}
+		if s.Discriminator == nil {
+			t.Fatal("missing discriminator")
+		}
+		if got, want := s.Discriminator.PropertyName, "@type"; got != want {
+			t.Errorf("discriminator.propertyName = %q, want %q", got, want)
+		}
+		if diff := cmp.Diff(mapping, s.Discriminator.Mapping); diff != "" {
+			t.Errorf("discriminator.mapping mismatch (-want +got):\n%s", diff)
+		}
+	}
+
+	event := schemas["events.v1.Event"]
+	t.Run("singular field", func(t *testing.T) {
+		checkTypedAny(t, event.Properties["payload"], "events.v1.Created", "events.v1.Deleted")
+	})
+	t.Run("repeated field", func(t *testing.T) {
+		checkTypedAny(t, event.Properties["history"].Items, "events.v1.Created", "events.v1.Deleted")
+	})
+	t.Run("unannotated field", func(t *testing.T) {
+		if metadata := event.Properties["metadata"]; metadata.OneOf != nil || metadata.Properties["@type"] == nil {
+			t.Errorf("metadata is not an open google.protobuf.Any object: %+v", metadata)
+		}
+	})
+	t.Run("status details", func(t *testing.T) {
+		checkTypedAny(t, schemas["google.rpc.Status"].Properties["details"].Items, "events.v1.Conflict")
+	})
+	t.Run("variant", func(t *testing.T) {
+		created := schemas["events.v1.Created.Any"]
+		if created == nil || len(created.AllOf) != 2 {
+			t.Fatalf("events.v1.Created.Any is not an allOf of two schemas: %+v", created)
+		}
+		if got, want := created.AllOf[0].Ref, "#/components/schemas/events.v1.Created"; got != want {
+			t.Errorf("allOf[0].$ref = %q, want %q", got, want)
+		}
+		ext := created.AllOf[1]
+		if got, want := ext.Properties["@type"].Const, "type.googleapis.com/events.v1.Created"; got != want {
+			t.Errorf("@type const = %q, want %q", got, want)
+		}
+		if diff := cmp.Diff([]string{"@type"}, ext.Required); diff != "" {
+			t.Errorf("required mismatch (-want +got):\n%s", diff)
+		}
+	})
+}
+
+// TestGenerate_TypedAnyCollision verifies that a message nested in a message
+// of an Any field and named like its typed Any schema is rejected, instead of
+// replacing the typed Any schema.
+func TestGenerate_TypedAnyCollision(t *testing.T) {
+	t.Parallel()
+
+	req := loadRequest(t, "testdata/any.prototext")
+	created, event := req.ProtoFile[1].MessageType[0], req.ProtoFile[1].MessageType[3]
+	created.NestedType = append(created.NestedType, &descriptorpb.DescriptorProto{Name: proto.String("Any")})
+	event.Field = append(event.Field, &descriptorpb.FieldDescriptorProto{
+		Name:     proto.String("created_any"),
+		Number:   proto.Int32(100),
+		Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+		Type:     descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+		TypeName: proto.String(".events.v1.Created.Any"),
+		JsonName: proto.String("createdAny"),
+	})
+
+	for _, strategy := range []string{"fqn", "simple"} {
+		reg := descriptor.NewRegistry()
+		reg.SetOpenAPIv3NamingStrategy(strategy)
+		if err := reg.Load(req); err != nil {
+			t.Fatalf("registry load: %v", err)
+		}
+		f, err := reg.LookupFile(req.FileToGenerate[0])
+		if err != nil {
+			t.Fatalf("lookup %s: %v", req.FileToGenerate[0], err)
+		}
+		_, err = Generate(reg, []*descriptor.File{f}, FormatJSON)
+		if err == nil || !strings.Contains(err.Error(), `"events.v1.Created.Any"`) {
+			t.Errorf("Generate() with the %s naming strategy error = %v; want an events.v1.Created.Any collision", strategy, err)
+		}
+	}
+}
+
+func TestGenerate_TypedAnyNamingStrategy(t *testing.T) {
+	t.Parallel()
+
+	req := loadRequest(t, "testdata/any.prototext")
+	reg := descriptor.NewRegistry()
+	reg.SetOpenAPIv3NamingStrategy("simple")
+	if err := reg.Load(req); err != nil {
+		t.Fatalf("registry load: %v", err)
+	}
+	f, err := reg.LookupFile(req.FileToGenerate[0])
+	if err != nil {
+		t.Fatalf("lookup %s: %v", req.FileToGenerate[0], err)
+	}
+	out, err := Generate(reg, []*descriptor.File{f}, FormatJSON)
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+	var doc struct {
+		Components struct {
+			Schemas map[string]json.RawMessage `json:"schemas"`
+		} `json:"components"`
+	}
+	if err := json.Unmarshal([]byte(out[0].GetContent()), &doc); err != nil {
+		t.Fatalf("unmarshal output: %v", err)
+	}
+	var names []string
+	for name := range doc.Components.Schemas {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	want := []string{"Conflict", "Conflict.Any", "Created", "Created.Any", "Deleted", "Deleted.Any", "Event", "Status"}
+	if diff := cmp.Diff(want, names); diff != "" {
+		t.Errorf("component schemas mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestGenerate_TypedAnyErrors(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name    string
+		config  string
+		wantErr string
+	}{
+		{
+			name: "not an Any field",
+			config: `
+openapiv3Options:
+  field:
+  - field: events.v1.Event.id
+    option:
+      anyTypes: [events.v1.Created]
+`,
+			wantErr: "openapiv3 field .events.v1.Event.id: any_types: field is not a google.protobuf.Any",
+		},
+		{
+			name: "message annotation",
+			config: `
+openapiv3Options:
+  message:
+  - message: events.v1.Event
+    option:
+      anyTypes: [events.v1.Created]
+`,
+			wantErr: "openapiv3 schema events.v1.Event: any_types: only allowed on google.protobuf.Any fields",
+		},
+		{
+			name: "unknown message",
+			config: `
+openapiv3Options:
+  field:
+  - field: events.v1.Event.metadata
+    option:
+      anyTypes: [events.v1.Missing]
+`,
+			wantErr: `openapiv3 field .events.v1.Event.metadata: any_types: unknown message "events.v1.Missing"`,
+		},
+		{
+			name: "well-known type",
+			config: `
+openapiv3Options:
+  field:
+  - field: events.v1.Event.metadata
+    option:
+      anyTypes: [google.protobuf.Any]
+`,
+			wantErr: `openapiv3 field .events.v1.Event.metadata: any_types: well-known type "google.protobuf.Any" has no component schema`,
+		},
+		{
+			name: "unknown status detail",
+			config: `
+openapiv3Options:
+  file:
+  - file: events/v1/events.proto
+    option:
+      statusDetailTypes: [events.v1.Missing]
+`,
+			wantErr: `openapiv3 status_detail_types: unknown message "events.v1.Missing"`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			config := filepath.Join(t.TempDir(), "config.yaml")
+			if err := os.WriteFile(config, []byte(tc.config), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			req := loadRequest(t, "testdata/any.prototext")
+			reg := descriptor.NewRegistry()
+			if err := reg.Load(req); err != nil {
+				t.Fatalf("registry load: %v", err)
+			}
+			if err := reg.LoadOpenAPIv3ConfigFromYAML(config); err != nil {
+				t.Fatalf("load OpenAPI configuration: %v", err)
+			}
+			f, err := reg.LookupFile(req.FileToGenerate[0])
+			if err != nil {
+				t.Fatalf("lookup %s: %v", req.FileToGenerate[0], err)
+			}
+			_, err = Generate(reg, []*descriptor.File{f}, FormatJSON)
+			if err == nil {
+				t.Fatal("generate: want error, got nil")
+			}
+			if !strings.Contains(err.Error(), tc.wantErr) {
+				t.Errorf("generate error = %q, want it to contain %q", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestGenerate_Examples(t *testing.T) {
+	t.Parallel()
+
+	out := runGenerator(t, loadRequest(t, "testdata/examples.prototext"))
+	type mediaType struct {
+		Examples map[string]any `json:"examples"`
+	}
+	type content struct {
+		Content map[string]mediaType `json:"content"`
+	}
+	var doc struct {
+		Paths map[string]map[string]struct {
+			RequestBody content            `json:"requestBody"`
+			Responses   map[string]content `json:"responses"`
+		} `json:"paths"`
+		Components struct {
+			Schemas map[string]struct {
+				Examples []any `json:"examples"`
+			} `json:"schemas"`
+		} `json:"components"`
+	}
+	if err := json.Unmarshal(out, &doc); err != nil {
+		t.Fatalf("unmarshal output: %v", err)
+	}
+
+	create := doc.Paths["/v1/shops/{parent}/items"]["post"]
+	update := doc.Paths["/v1/items/{id}"]["patch"]
+	for _, tc := range []struct {
+		name string
+		got  any
+		want any
+	}{
+		{
+			name: "request examples from a JSON file",
+			got:  create.RequestBody.Content["application/json"].Examples,
+			want: map[string]any{"desk": map[string]any{
+				"summary": "A desk.",
+				"value": map[string]any{
+					"name":       "Desk",
+					"priceCents": "24900",
+					"tags":       []any{"furniture", "office"},
+				},
+			}},
+		},
+		{
+			// 64-bit integers are normalized to their protojson string form.
+			name: "response examples",
+			got:  create.Responses["200"].Content["application/json"].Examples,
+			want: map[string]any{"desk": map[string]any{
+				"value": map[string]any{"id": "desk-1", "name": "Desk", "priceCents": "24900"},
+			}},
+		},
+		{
+			name: "custom response examples from textproto",
+			got:  create.Responses["409"].Content["application/json"].Examples,
+			want: map[string]any{"example1": map[string]any{
+				"value": map[string]any{"reason": "An item with this name already exists."},
+			}},
+		},
+		{
+			// The path parameters are not part of the body.
+			name: "body star request examples",
+			got:  update.RequestBody.Content["application/json"].Examples,
+			want: map[string]any{"example1": map[string]any{
+				"value": map[string]any{"name": "Standing desk"},
+			}},
+		},
+		{
+			name: "message examples",
+			got:  doc.Components.Schemas["shop.v1.Item"].Examples,
+			want: []any{map[string]any{"id": "lamp-1", "name": "Lamp", "priceCents": "1999"}},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, tc.got); diff != "" {
+				t.Errorf("examples mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerate_ExamplesErrors(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name    string
+		config  string
+		wantErr string
+	}{
+		{
+			name: "unknown field",
+			config: `
+openapiv3Options:
+  method:
+  - method: shop.v1.ShopService.UpdateItem
+    option:
+      requestExamples:
+      - json: '{"title": "Desk"}'
+`,
+			wantErr: `openapiv3 operation ShopService.UpdateItem: request_examples[1]: invalid shop.v1.UpdateItemRequest example`,
+		},
+		{
+			name: "invalid textproto",
+			config: `
+openapiv3Options:
+  message:
+  - message: shop.v1.Item
+    option:
+      messageExamples:
+      - textproto: 'price_cents: "cheap"'
+`,
+			wantErr: `openapiv3 schema shop.v1.Item: message_examples[1]: invalid shop.v1.Item example`,
+		},
+		{
+			name: "unknown file format",
+			config: `
+openapiv3Options:
+  method:
+  - method: shop.v1.ShopService.GetItem
+    option:
+      responseExamples:
+      - file: testdata/examples/item.yaml
+`,
+			wantErr: `openapiv3 operation ShopService.GetItem: response_examples[0]: file "testdata/examples/item.yaml": unknown format`,
+		},
+		{
+			name: "missing file",
+			config: `
+openapiv3Options:
+  method:
+  - method: shop.v1.ShopService.GetItem
+    option:
+      responseExamples:
+      - file: testdata/examples/missing.json
+`,
+			wantErr: `openapiv3 operation ShopService.GetItem: response_examples[0]: file: open testdata/examples/missing.json`,
+		},
+		{
+			name: "no value",
+			config: `
+openapiv3Options:
+  method:
+  - method: shop.v1.ShopService.GetItem
+    option:
+      responseExamples:
+      - name: empty
+`,
+			wantErr: `openapiv3 operation ShopService.GetItem: response_examples[0]: one of json, textproto or file is required`,
+		},
+		{
+			name: "duplicate name",
+			config: `
+openapiv3Options:
+  method:
+  - method: shop.v1.ShopService.GetItem
+    option:
+      responseExamples:
+      - name: desk
+        json: '{}'
+      - name: desk
+        json: '{}'
+`,
+			wantErr: `openapiv3 operation ShopService.GetItem: response_examples[1]: duplicate example name "desk"`,
+		},
+		{
+			name: "no request body",
+			config: `
+openapiv3Options:
+  method:
+  - method: shop.v1.ShopService.GetItem
+    option:
+      requestExamples:
+      - json: '{}'
+`,
+			wantErr: `openapiv3 operation ShopService.GetItem: request_examples: operation has no request body`,
+		},
+		{
+			name: "response without message",
+			config: `
+openapiv3Options:
+  method:
+  - method: shop.v1.ShopService.GetItem
+    option:
+      responses:
+        "404":
+          examples:
+          - json: '{}'
+`,
+			wantErr: `openapiv3 operation ShopService.GetItem: responses["404"]: examples: response has no message`,
+		},
+		{
+			name: "field annotation",
+			config: `
+openapiv3Options:
+  field:
+  - field: shop.v1.Item.name
+    option:
+      messageExamples:
+      - json: '{}'
+`,
+			wantErr: `openapiv3 field .shop.v1.Item.name: message_examples: only allowed in openapiv3_schema`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			config := filepath.Join(t.TempDir(), "config.yaml")
+			if err := os.WriteFile(config, []byte(tc.config), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			req := loadRequest(t, "testdata/examples.prototext")
+			reg := descriptor.NewRegistry()
+			if err := reg.Load(req); err != nil {
+				t.Fatalf("registry load: %v", err)
+			}
+			if err := reg.LoadOpenAPIv3ConfigFromYAML(config); err != nil {
+				t.Fatalf("load OpenAPI configuration: %v", err)
+			}
+			f, err := reg.LookupFile(req.FileToGenerate[0])
+			if err != nil {
+				t.Fatalf("lookup %s: %v", req.FileToGenerate[0], err)
+			}
+			_, err = Generate(reg, []*descriptor.File{f}, FormatJSON)
+			if err == nil {
+				t.Fatal("generate: want error, got nil")
+			}
+			if !strings.Contains(err.Error(), tc.wantErr) {
+				t.Errorf("generate error = %q, want it to contain %q", err, tc.wantErr)
+			}
+		})
+	}
+}
+
 // loadRequest reads a prototext-encoded CodeGeneratorRequest from disk.
 func loadRequest(t *testing.T, path string) *pluginpb.CodeGeneratorRequest {
 	t.Helper()
@@ -882,7 +2376,7 @@ func runGenerator(t *testing.T, req *pluginpb.CodeGeneratorRequest) []byte {
 		}
 		targets = append(targets, f)
 	}
-	out, err := Generate(reg, targets)
+	out, err := Generate(reg, targets, FormatJSON)
 	if err != nil {
 		t.Fatalf("generate: %v", err)
 	}
@@ -908,7 +2402,7 @@ func runGeneratorExpectError(t *testing.T, req *pluginpb.CodeGeneratorRequest) s
 		}
 		targets = append(targets, f)
 	}
-	_, err := Generate(reg, targets)
+	_, err := Generate(reg, targets, FormatJSON)
 	if err == nil {
 		t.Fatalf("generate: want error, got nil")
 	}
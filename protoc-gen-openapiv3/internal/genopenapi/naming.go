@@ -1,14 +1,294 @@
 package genopenapi
 
-import "strings"
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+)
 
-// schemaName returns the OpenAPI component name for a fully-qualified proto
-// name. We use a single naming strategy: strip the leading dot.
+// LookupNamingStrategy returns the function resolving the component names of
+// proto types for the named strategy, or nil if the strategy is unknown. The
+// function takes the fully-qualified names, without the leading dot, of all
+// the proto types with a component schema in a document, and returns a
+// mapping from each of them to its component name.
+//
+// Only the types of the document are taken into account, so adding a proto
+// type that the document does not reference never renames its components.
+func LookupNamingStrategy(strategy string) func([]string) map[string]string {
+	switch strings.ToLower(strategy) {
+	case "fqn":
+		return resolveNamesFQN
+	case "legacy":
+		return resolveNamesLegacy
+	case "simple":
+		return resolveNamesSimple
+	case "package":
+		return resolveNamesPackage
+	}
+	return nil
+}
+
+// isFQNNamingStrategy reports whether strategy names the components after the
+// fully-qualified names of the proto types, in which case there is nothing to
+// resolve.
+func isFQNNamingStrategy(strategy string) bool {
+	return strings.EqualFold(strategy, "fqn")
+}
+
+// schemaName returns the component name of the proto type whose
+// fully-qualified name is fqn: the fully-qualified name with the leading dot
+// stripped. The components are renamed after the naming strategy once the
+// document is generated, see resolveComponentNames.
 //
 // Examples:
 //
 //	".foo.bar.Baz"      -> "foo.bar.Baz"
 //	".foo.bar.Baz.Qux"  -> "foo.bar.Baz.Qux" (nested)
-func schemaName(fqn string) string {
+func (b *schemaBuilder) schemaName(fqn string) string {
 	return strings.TrimPrefix(fqn, ".")
 }
+
+// renameSchemas renames the component schemas of the document after names,
+// mapping the fully-qualified names of proto types to their component names,
+// and updates the references to them. The derived schemas are renamed after
+// their message.
+func (d fileDocument) renameSchemas(names map[string]string) error {
+	if d.doc.Components == nil {
+		return nil
+	}
+	rename := func(name string) string {
+		if resolved, ok := names[name]; ok {
+			return resolved
+		}
+		return name
+	}
+	renamed := make(map[string]string, len(d.doc.Components.Schemas))
+	// owners maps the new names to the old ones, to detect collisions.
+	owners := make(map[string]string, len(d.doc.Components.Schemas))
+	schemas := make(map[string]*SchemaOrRef, len(d.doc.Components.Schemas))
+	for _, name := range slices.Sorted(maps.Keys(d.doc.Components.Schemas)) {
+		schema := d.doc.Components.Schemas[name]
+		newName := rename(name)
+		if ds, ok := d.derived[name]; ok {
+			msgName := rename(ds.msg)
+			newName = msgName + ds.suffix
+			if ds.suffix == streamResultSuffix && schema.Value != nil {
+				schema.Value.Title = streamResultTitle(msgName)
+			}
+		}
+		if prev, dup := owners[newName]; dup {
+			return fmt.Errorf("openapiv3: component schemas %q and %q are both named %q by the naming strategy", prev, name, newName)
+		}
+		owners[newName] = name
+		renamed[name] = newName
+		schemas[newName] = schema
+	}
+	d.doc.Components.Schemas = schemas
+	renameSchemaRefs(d.doc, func(name string) (string, bool) {
+		newName, ok := renamed[name]
+		return newName, ok
+	})
+	return nil
+}
+
+// renameSchemaRefs renames the component schemas referenced by doc after
+// rename, which reports false for the names to keep. Each reference is
+// renamed once, even if it is shared.
+func renameSchemaRefs(doc *Document, rename func(name string) (string, bool)) {
+	const prefix = "#/components/schemas/"
+	renameRef := func(ref string) string {
+		if name, ok := strings.CutPrefix(ref, prefix); ok {
+			if newName, ok := rename(name); ok {
+				return prefix + newName
+			}
+		}
+		return ref
+	}
+	seenRefs := make(map[*SchemaOrRef]bool)
+	seenSchemas := make(map[*Schema]bool)
+	var walk func(s *SchemaOrRef)
+	walk = func(s *SchemaOrRef) {
+		if s == nil || seenRefs[s] {
+			return
+		}
+		seenRefs[s] = true
+		s.Ref = renameRef(s.Ref)
+		v := s.Value
+		if v == nil || seenSchemas[v] {
+			return
+		}
+		seenSchemas[v] = true
+		if v.Discriminator != nil {
+			for value, ref := range v.Discriminator.Mapping {
+				v.Discriminator.Mapping[value] = renameRef(ref)
+			}
+		}
+		for _, p := range v.Properties {
+			walk(p)
+		}
+		if v.AdditionalProperties != nil {
+			walk(v.AdditionalProperties.Schema)
+		}
+		walk(v.Items)
+		walk(v.Not)
+		for _, sub := range slices.Concat(v.AllOf, v.OneOf, v.AnyOf) {
+			walk(sub)
+		}
+	}
+	walkContent := func(content map[string]*MediaType) {
+		for _, mt := range content {
+			walk(mt.Schema)
+			walk(mt.ItemSchema)
+		}
+	}
+	walkParameters := func(params []*ParameterRef) {
+		for _, p := range params {
+			if p.Value != nil {
+				walk(p.Value.Schema)
+			}
+		}
+	}
+	walkResponse := func(r *ResponseRef) {
+		if r == nil || r.Value == nil {
+			return
+		}
+		walkContent(r.Value.Content)
+		for _, h := range r.Value.Headers {
+			walk(h.Schema)
+		}
+	}
+
+	if doc.Paths != nil {
+		for _, path := range doc.Paths.order {
+			item := doc.Paths.items[path]
+			walkParameters(item.Parameters)
+			for _, op := range []*Operation{item.Get, item.Put, item.Post, item.Delete, item.Options, item.Head, item.Patch, item.Trace} {
+				if op == nil {
+					continue
+				}
+				walkParameters(op.Parameters)
+				if op.RequestBody != nil && op.RequestBody.Value != nil {
+					walkContent(op.RequestBody.Value.Content)
+				}
+				if op.Responses != nil {
+					walkResponse(op.Responses.Default)
+					for _, r := range op.Responses.Codes {
+						walkResponse(r)
+					}
+				}
+			}
+		}
+	}
+	for _, s := range doc.Components.Schemas {
+		walk(s)
+	}
+}
+
+// resolveNamesFQN names each type after its fully-qualified name.
+func resolveNamesFQN(names []string) map[string]string {
+	resolved := make(map[string]string, len(names))
+	for _, name := range names {
+		resolved[name] = name
+	}
+	return resolved
+}
+
+// resolveNamesLegacy names each type after the shortest suffix of its name
+// hierarchy that is unique among names, plus one more component, with the
+// components concatenated in camel case, as protoc-gen-openapiv2 does by
+// default.
+//
+// E.g. `a.b.C.D` is named `bCD` if other types end with `.D` but not `.C.D`.
+func resolveNamesLegacy(names []string) map[string]string {
+	return resolveNamesUnique(names, 1, "", false)
+}
+
+// resolveNamesSimple names each type after the shortest suffix of its name
+// hierarchy that is unique among names, with the components joined by dots.
+//
+// E.g. `a.b.C.D` is named `C.D` if other types end with `.D` but not `.C.D`,
+// and `D` if no other type ends with `.D`.
+func resolveNamesSimple(names []string) map[string]string {
+	return resolveNamesUnique(names, 0, ".", false)
+}
+
+// resolveNamesPackage names each type after its package-scoped name, with
+// nested types qualified by their parents, prefixed with as many package
+// components as needed to make it unique among names.
+//
+// E.g. `a.b.C.D` is named `C.D`, or `b.C.D` if another package declares a
+// `C.D`.
+func resolveNamesPackage(names []string) map[string]string {
+	return resolveNamesUnique(names, 0, ".", true)
+}
+
+// pkgEndRegexp matches the end of the package of a fully-qualified name, by
+// the convention that package names are lowercase and type names capitalized.
+var pkgEndRegexp = regexp.MustCompile(`\.[A-Z]`)
+
+// resolveNamesUnique names each type after the shortest suffix of its name
+// hierarchy that is unique among names, extended with extraContext more
+// components, joined with separator. With qualifyNested, the package-scoped
+// name of a type is a single component of its hierarchy.
+//
+// Names only depend on the set of names, not on their order, so the
+// resolution is deterministic.
+func resolveNamesUnique(names []string, extraContext int, separator string, qualifyNested bool) map[string]string {
+	hierarchy := func(name string) []string {
+		if !qualifyNested {
+			return strings.Split(name, ".")
+		}
+		// Match the leading dot too, so that a type without a package
+		// is not mistaken for a package.
+		pkgEnd := pkgEndRegexp.FindStringIndex("." + name)
+		if pkgEnd == nil {
+			return strings.Split(name, ".")
+		}
+		nested := name[pkgEnd[0]:]
+		if pkgEnd[0] == 0 {
+			return []string{nested}
+		}
+		return append(strings.Split(name[:pkgEnd[0]-1], "."), nested)
+	}
+	// Names are sets: a duplicate would never be unique.
+	names = slices.Clone(names)
+	slices.Sort(names)
+	names = slices.Compact(names)
+
+	// suffixCount counts the names ending with each suffix of components.
+	suffixCount := make(map[string]int)
+	suffixKey := func(components []string) string {
+		return strings.Join(components, " ")
+	}
+	for _, name := range names {
+		h := hierarchy(name)
+		for depth := 1; depth <= len(h); depth++ {
+			suffixCount[suffixKey(h[len(h)-depth:])]++
+		}
+	}
+
+	resolved := make(map[string]string, len(names))
+	for _, name := range names {
+		h := hierarchy(name)
+		depth := 1
+		for ; depth < len(h); depth++ {
+			if suffixCount[suffixKey(h[len(h)-depth:])] == 1 {
+				break
+			}
+		}
+		components := slices.Clone(h[max(len(h)-depth-extraContext, 0):])
+		if separator == "" {
+			// Camel-case the concatenation by capitalizing the lowercase
+			// package components after the first one.
+			for i := 1; i < len(components); i++ {
+				if c := components[i]; c != "" && c == strings.ToLower(c) {
+					components[i] = strings.ToUpper(c[:1]) + c[1:]
+				}
+			}
+		}
+		resolved[name] = strings.Join(components, separator)
+	}
+	return resolved
+}
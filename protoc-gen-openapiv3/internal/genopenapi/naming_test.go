@@ -0,0 +1,142 @@
+package genopenapi
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLookupNamingStrategy(t *testing.T) {
+	t.Parallel()
+
+	names := []string{
+		"A",
+		"a.B.C",
+		"a.D.C",
+		"a.E.F",
+		"b.E.F",
+		"c.G.H",
+		"E.F",
+		// Duplicates do not make a name ambiguous.
+		"c.G.H",
+	}
+	for _, tc := range []struct {
+		strategy string
+		want     map[string]string
+	}{
+		{
+			strategy: "fqn",
+			want: map[string]string{
+				"A": "A", "a.B.C": "a.B.C", "a.D.C": "a.D.C", "a.E.F": "a.E.F",
+				"b.E.F": "b.E.F", "c.G.H": "c.G.H", "E.F": "E.F",
+			},
+		},
+		{
+			strategy: "legacy",
+			want: map[string]string{
+				"A": "A", "a.B.C": "aBC", "a.D.C": "aDC", "a.E.F": "aEF",
+				"b.E.F": "bEF", "c.G.H": "GH", "E.F": "EF",
+			},
+		},
+		{
+			strategy: "simple",
+			want: map[string]string{
+				"A": "A", "a.B.C": "B.C", "a.D.C": "D.C", "a.E.F": "a.E.F",
+				"b.E.F": "b.E.F", "c.G.H": "H", "E.F": "E.F",
+			},
+		},
+		{
+			strategy: "package",
+			want: map[string]string{
+				"A": "A", "a.B.C": "B.C", "a.D.C": "D.C", "a.E.F": "a.E.F",
+				"b.E.F": "b.E.F", "c.G.H": "G.H", "E.F": "E.F",
+			},
+		},
+	} {
+		t.Run(tc.strategy, func(t *testing.T) {
+			t.Parallel()
+
+			resolve := LookupNamingStrategy(tc.strategy)
+			if resolve == nil {
+				t.Fatalf("LookupNamingStrategy(%q) = nil", tc.strategy)
+			}
+			if diff := cmp.Diff(tc.want, resolve(names)); diff != "" {
+				t.Errorf("names mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	if resolve := LookupNamingStrategy("unknown"); resolve != nil {
+		t.Errorf("LookupNamingStrategy(%q) != nil", "unknown")
+	}
+}
+
+// TestRenameSchemas verifies that the component schemas are renamed with the
+// references to them, and that the messages whose names end like the derived
+// schemas are still named as proto types.
+func TestRenameSchemas(t *testing.T) {
+	t.Parallel()
+
+	doc := NewDocument("test", "1.0.0")
+	result := NewSchemaRef("a.Bar.StreamResult")
+	op := &Operation{Responses: NewResponses()}
+	op.Responses.Codes["200"] = &ResponseRef{Value: &Response{
+		Description: "ok",
+		Content:     streamContent(result, true),
+	}}
+	doc.Paths.Set("/v1/bars", &PathItem{Get: op})
+	doc.Components.Schemas = map[string]*SchemaOrRef{
+		"a.Bar": {Value: &Schema{
+			Type:       SchemaType{"object"},
+			Properties: map[string]*SchemaOrRef{"foo": NewSchemaRef("a.Foo")},
+		}},
+		"a.Bar.StreamResult": {Value: &Schema{
+			Title:      streamResultTitle("a.Bar"),
+			Properties: map[string]*SchemaOrRef{"result": NewSchemaRef("a.Bar")},
+		}},
+		"a.Foo": {Value: &Schema{
+			OneOf: []*SchemaOrRef{NewSchemaRef("a.Foo.Any")},
+			Discriminator: &Discriminator{
+				PropertyName: "kind",
+				Mapping:      map[string]string{"any": "#/components/schemas/a.Foo.Any"},
+			},
+		}},
+		"a.Foo.Any": {Value: &Schema{Type: SchemaType{"object"}}},
+	}
+	d := fileDocument{
+		doc:     doc,
+		derived: map[string]derivedSchema{"a.Bar.StreamResult": {msg: "a.Bar", suffix: streamResultSuffix}},
+	}
+
+	types := d.typeNames()
+	slices.Sort(types)
+	if diff := cmp.Diff([]string{"a.Bar", "a.Foo", "a.Foo.Any"}, types); diff != "" {
+		t.Errorf("typeNames() mismatch (-want +got):\n%s", diff)
+	}
+	if err := d.renameSchemas(LookupNamingStrategy("simple")(types)); err != nil {
+		t.Fatalf("renameSchemas() failed: %v", err)
+	}
+
+	if diff := cmp.Diff([]string{"Any", "Bar", "Bar.StreamResult", "Foo"}, slices.Sorted(maps.Keys(doc.Components.Schemas))); diff != "" {
+		t.Errorf("component names mismatch (-want +got):\n%s", diff)
+	}
+	schemas := doc.Components.Schemas
+	for _, tc := range []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"stream result", result.Ref, "#/components/schemas/Bar.StreamResult"},
+		{"stream result title", schemas["Bar.StreamResult"].Value.Title, "Stream result of Bar"},
+		{"result", schemas["Bar.StreamResult"].Value.Properties["result"].Ref, "#/components/schemas/Bar"},
+		{"property", schemas["Bar"].Value.Properties["foo"].Ref, "#/components/schemas/Foo"},
+		{"oneOf", schemas["Foo"].Value.OneOf[0].Ref, "#/components/schemas/Any"},
+		{"discriminator", schemas["Foo"].Value.Discriminator.Mapping["any"], "#/components/schemas/Any"},
+	} {
+		if tc.got != tc.want {
+			t.Errorf("%s = %q; want %q", tc.name, tc.got, tc.want)
+		}
+	}
+}
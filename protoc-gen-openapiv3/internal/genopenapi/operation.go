@@ -2,17 +2,28 @@ package genopenapi
 
 import (
 	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/internal/descriptor"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv3/options"
 	"google.golang.org/genproto/googleapis/api/annotations"
 	"google.golang.org/grpc/grpclog"
 	"google.golang.org/protobuf/types/descriptorpb"
 )
 
-// statusSchemaName is the component name we use for the auto-injected
-// google.rpc.Status default error response schema.
+// statusSchemaName is the fully-qualified name of the auto-injected
+// google.rpc.Status default error response schema, whose component is named
+// by the naming strategy like those of the other proto types.
 const statusSchemaName = "google.rpc.Status"
 
+// streamResultSuffix is appended to the component name of a message to name
+// the schema of the chunks of a server stream of the message.
+const streamResultSuffix = ".StreamResult"
+
 // buildOperation produces an OpenAPI Operation for one HTTP binding of an
 // RPC method, registering any referenced schemas with the schema builder.
 //
@@ -22,7 +33,7 @@ const statusSchemaName = "google.rpc.Status"
 // convertPathTemplate; it may be longer than binding.PathParams when a
 // single proto field expanded into multiple wildcards.
 func buildOperation(b *schemaBuilder, svc *descriptor.Service, m *descriptor.Method, binding *descriptor.Binding, bindingIdx int, pathParams []pathParam) (*Operation, error) {
-	summary, description := splitSummaryDescription(methodComments(m))
+	summary, description := splitSummaryDescription(methodComments(b.reg, m))
 
 	op := &Operation{
 		Summary:     summary,
@@ -39,14 +50,101 @@ func buildOperation(b *schemaBuilder, svc *descriptor.Service, m *descriptor.Met
 	}
 
 	op.Responses = buildResponses(b, m)
-	if o, ok := methodOperationAnnotation(m); ok {
-		if err := applyOperationOverride(op, o); err != nil {
+	if o, ok := methodOperationAnnotation(b.reg, m); ok {
+		// The examples are set before the responses of the annotations,
+		// which may replace the generated 200 response.
+		if err := applyOperationExamples(b, op, m, binding, o); err != nil {
+			return nil, fmt.Errorf("openapiv3 operation %s.%s: %w", svc.GetName(), m.GetName(), err)
+		}
+	}
+	if s, ok := serviceAnnotation(b.reg, svc); ok {
+		security, err := annotationSecurity(b.doc.Components.SecuritySchemes, s.GetSecurity(), s.GetNoSecurity())
+		if err != nil {
+			return nil, fmt.Errorf("openapiv3 service %s: %w", svc.GetName(), err)
+		}
+		if security != nil {
+			op.Security = security
+		}
+		if err := applyResponsesOverride(b, op.Responses, s.GetResponses()); err != nil {
+			return nil, fmt.Errorf("openapiv3 service %s: %w", svc.GetName(), err)
+		}
+	}
+	if rule, ok := b.reg.LookupAuthenticationRule(m.FQMN()); ok {
+		op.Security = authenticationRuleSecurity(rule)
+	}
+	if o, ok := methodOperationAnnotation(b.reg, m); ok {
+		if err := applyOperationOverride(op, o, b.doc.Components.SecuritySchemes); err != nil {
+			return nil, fmt.Errorf("openapiv3 operation %s.%s: %w", svc.GetName(), m.GetName(), err)
+		}
+		if err := applyResponsesOverride(b, op.Responses, o.GetResponses()); err != nil {
 			return nil, fmt.Errorf("openapiv3 operation %s.%s: %w", svc.GetName(), m.GetName(), err)
 		}
 	}
 	return op, nil
 }
 
+// applyOperationExamples sets the examples of the request body and of the
+// generated 200 response of op, an operation of m bound by binding, from the
+// request_examples and response_examples of o.
+func applyOperationExamples(b *schemaBuilder, op *Operation, m *descriptor.Method, binding *descriptor.Binding, o *options.Operation) error {
+	if examples := o.GetRequestExamples(); len(examples) > 0 {
+		if op.RequestBody == nil {
+			return fmt.Errorf("request_examples: operation has no request body")
+		}
+		if m.GetClientStreaming() {
+			return fmt.Errorf("request_examples: not allowed on client-streaming methods")
+		}
+		msg, drop, err := b.requestBodyMessage(m, binding)
+		if err != nil {
+			return fmt.Errorf("request_examples: %w", err)
+		}
+		values, err := b.mediaTypeExamples(msg, examples, drop...)
+		if err != nil {
+			return fmt.Errorf("request_examples%w", err)
+		}
+		op.RequestBody.Value.Content["application/json"].Examples = values
+	}
+	if examples := o.GetResponseExamples(); len(examples) > 0 {
+		if m.GetServerStreaming() {
+			return fmt.Errorf("response_examples: not allowed on server-streaming methods")
+		}
+		if m.ResponseType == nil {
+			return fmt.Errorf("response_examples: operation has no response message")
+		}
+		values, err := b.mediaTypeExamples(m.ResponseType, examples)
+		if err != nil {
+			return fmt.Errorf("response_examples%w", err)
+		}
+		op.Responses.Codes["200"].Value.Content["application/json"].Examples = values
+	}
+	return nil
+}
+
+// requestBodyMessage returns the message of the request body of m bound by
+// binding, and the JSON names of its fields bound to path parameters, which
+// are not part of the body.
+func (b *schemaBuilder) requestBodyMessage(m *descriptor.Method, binding *descriptor.Binding) (*descriptor.Message, []string, error) {
+	if len(binding.Body.FieldPath) == 0 {
+		var drop []string
+		for _, field := range m.RequestType.Fields {
+			if b.fieldConsumedByPathParams(field, binding.PathParams) {
+				drop = append(drop, jsonName(field))
+			}
+		}
+		return m.RequestType, drop, nil
+	}
+	bodyField := binding.Body.FieldPath[len(binding.Body.FieldPath)-1].Target
+	if bodyField.GetType() != descriptorpb.FieldDescriptorProto_TYPE_MESSAGE ||
+		bodyField.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED {
+		return nil, nil, fmt.Errorf("body field %s is not a singular message", bodyField.GetName())
+	}
+	msg, err := b.reg.LookupMsg("", bodyField.GetTypeName())
+	if err != nil {
+		return nil, nil, err
+	}
+	return msg, nil, nil
+}
+
 // buildParameters returns the path and query parameters for an operation.
 // Body fields are excluded; path-bound fields are emitted as `in: path` and
 // everything else from the request type is emitted as `in: query`.
@@ -84,7 +182,7 @@ func buildParameters(b *schemaBuilder, m *descriptor.Method, binding *descriptor
 			Name:       pp.openAPIName,
 			In:         "path",
 			Required:   true,
-			Schema:     b.fieldSchema(proto.Target),
+			Schema:     b.parameterSchema(proto.Target),
 			Deprecated: fieldDeprecated(proto.Target),
 		}
 		if desc := fieldComments(b.reg, proto.Target); desc != "" {
@@ -186,7 +284,7 @@ func (b *schemaBuilder) queryParameters(field *descriptor.Field, prefix string,
 	param := &Parameter{
 		Name:   name,
 		In:     "query",
-		Schema: b.fieldSchema(field),
+		Schema: b.parameterSchema(field),
 	}
 	if desc := fieldComments(b.reg, field); desc != "" {
 		param.Description = desc
@@ -325,23 +423,33 @@ func buildRequestBody(b *schemaBuilder, m *descriptor.Method, binding *descripto
 			}
 			b.addProperty(bodySchema, field)
 		}
-		return &RequestBodyRef{Value: &RequestBody{
-			Content:  map[string]*MediaType{"application/json": {Schema: &SchemaOrRef{Value: bodySchema}}},
-			Required: true,
-		}}
+		return newRequestBody(m, &SchemaOrRef{Value: bodySchema})
 	}
 
 	// body="field_name": the body is the type of that single field.
 	bodyField := binding.Body.FieldPath[len(binding.Body.FieldPath)-1].Target
-	return &RequestBodyRef{Value: &RequestBody{
-		Content:  map[string]*MediaType{"application/json": {Schema: b.fieldSchema(bodyField)}},
+	return newRequestBody(m, b.fieldSchema(bodyField))
+}
+
+// newRequestBody returns the required request body of m with the given
+// schema. The gateway decodes the request messages of client-streaming
+// methods from consecutive JSON values in the body, so it is documented as
+// newline-delimited JSON instead of a single JSON document.
+func newRequestBody(m *descriptor.Method, schema *SchemaOrRef) *RequestBodyRef {
+	body := &RequestBody{
+		Content:  map[string]*MediaType{"application/json": {Schema: schema}},
 		Required: true,
-	}}
+	}
+	if m.GetClientStreaming() {
+		body.Description = "A stream of request messages, one JSON value per line (streaming inputs)."
+		body.Content = streamContent(schema, false)
+	}
+	return &RequestBodyRef{Value: body}
 }
 
 // buildResponses constructs the responses map for an RPC: a 200 with the
 // response message schema, and (unless disabled) a default google.rpc.Status
-// error response.
+// error response plus one for each status of the configured HTTP status mapping.
 //
 // RPCs returning google.protobuf.Empty get a 200 with an empty object
 // schema rather than the HTTP-conventional 204 No Content. The grpc-gateway
@@ -360,26 +468,216 @@ func buildResponses(b *schemaBuilder, m *descriptor.Method) *Responses {
 			schema = &SchemaOrRef{Value: wkt}
 		} else {
 			b.ensureMessageSchema(m.ResponseType)
-			schema = NewSchemaRef(schemaName(m.ResponseType.FQMN()))
+			schema = NewSchemaRef(b.schemaName(m.ResponseType.FQMN()))
+		}
+		if m.GetServerStreaming() {
+			r := NewResponse(desc + " (streaming responses)")
+			r.Content = streamContent(b.streamResultRef(m.ResponseType, schema), b.reg.GetEventStreamResponses())
+			resp.Codes["200"] = &ResponseRef{Value: r}
+		} else {
+			resp.Codes["200"] = &ResponseRef{Value: NewResponse(desc).WithJSONSchema(schema)}
 		}
-		resp.Codes["200"] = &ResponseRef{Value: NewResponse(desc).WithJSONSchema(schema)}
 	}
 
 	if !b.reg.GetDisableDefaultErrors() {
-		ensureStatusSchema(b.doc)
-		resp.Default = &ResponseRef{Value: NewResponse("An unexpected error response.").WithJSONSchema(NewSchemaRef(statusSchemaName))}
+		resp.Default = &ResponseRef{Value: NewResponse("An unexpected error response.").WithJSONSchema(b.statusRef())}
+		for st, desc := range b.reg.GetHTTPStatusMappingDescriptions() {
+			resp.Codes[st] = &ResponseRef{Value: NewResponse(desc).WithJSONSchema(b.statusRef())}
+		}
 	}
 	return resp
 }
 
+// applyResponsesOverride merges the responses of a Service or Operation
+// annotation into resp by status code. A success (2XX) response in
+// overrides drops every success response of resp, so that e.g. a 201
+// replaces the generated 200. Codes are processed in sorted order so the
+// first invalid response reported is deterministic.
+func applyResponsesOverride(b *schemaBuilder, resp *Responses, overrides map[string]*options.Response) error {
+	codes := slices.Sorted(maps.Keys(overrides))
+	for _, code := range codes {
+		if isSuccessCode(code) {
+			for c := range resp.Codes {
+				if isSuccessCode(c) {
+					delete(resp.Codes, c)
+				}
+			}
+			break
+		}
+	}
+	for _, code := range codes {
+		r, err := convertResponse(b, code, overrides[code])
+		if err != nil {
+			return fmt.Errorf("responses[%q]: %w", code, err)
+		}
+		if code == "default" {
+			resp.Default = &ResponseRef{Value: r}
+			continue
+		}
+		resp.Codes[code] = &ResponseRef{Value: r}
+	}
+	return nil
+}
+
+// convertResponse converts the Response annotation of the status code,
+// which must be "default", a code between 100 and 599, or a range such as
+// "4XX".
+func convertResponse(b *schemaBuilder, code string, o *options.Response) (*Response, error) {
+	desc := o.GetDescription()
+	switch {
+	case code == "default":
+	case len(code) == 3 && code[0] >= '1' && code[0] <= '5' && code[1:] == "XX":
+	default:
+		n, err := strconv.Atoi(code)
+		if err != nil || n < 100 || n > 599 {
+			return nil, fmt.Errorf("invalid status code")
+		}
+		if desc == "" {
+			desc = http.StatusText(n)
+		}
+	}
+	if desc == "" {
+		return nil, fmt.Errorf("description is required")
+	}
+	r := NewResponse(desc)
+	if name := o.GetMessage(); name != "" {
+		schema, err := responseMessageSchema(b, name)
+		if err != nil {
+			return nil, err
+		}
+		contentTypes := o.GetContentTypes()
+		if len(contentTypes) == 0 {
+			contentTypes = []string{"application/json"}
+		}
+		var examples map[string]*Example
+		if len(o.GetExamples()) > 0 {
+			msg, err := b.reg.LookupMsg("", "."+strings.TrimPrefix(name, "."))
+			if err != nil {
+				return nil, fmt.Errorf("examples: message %q is not part of the input", name)
+			}
+			if examples, err = b.mediaTypeExamples(msg, o.GetExamples()); err != nil {
+				return nil, fmt.Errorf("examples%w", err)
+			}
+		}
+		r.Content = make(map[string]*MediaType, len(contentTypes))
+		for _, ct := range contentTypes {
+			r.Content[ct] = &MediaType{Schema: schema, Examples: examples}
+		}
+	} else if len(o.GetExamples()) > 0 {
+		return nil, fmt.Errorf("examples: response has no message")
+	}
+	for _, name := range slices.Sorted(maps.Keys(o.GetHeaders())) {
+		h := o.GetHeaders()[name]
+		typ := h.GetType()
+		switch typ {
+		case "":
+			typ = "string"
+		case "string", "number", "integer", "boolean":
+		default:
+			return nil, fmt.Errorf("headers[%q]: invalid type %q; want string, number, integer or boolean", name, typ)
+		}
+		if r.Headers == nil {
+			r.Headers = make(map[string]*Header)
+		}
+		r.Headers[name] = &Header{
+			Description: h.GetDescription(),
+			Required:    h.GetRequired(),
+			Schema:      &SchemaOrRef{Value: &Schema{Type: SchemaType{typ}, Format: h.GetFormat()}},
+		}
+	}
+	return r, nil
+}
+
+// responseMessageSchema returns the schema of the body of a response whose
+// message is the fully-qualified proto message name. google.rpc.Status is
+// resolved to the generated error schema even if its proto file is not
+// imported.
+func responseMessageSchema(b *schemaBuilder, name string) (*SchemaOrRef, error) {
+	fqmn := "." + strings.TrimPrefix(name, ".")
+	msg, err := b.reg.LookupMsg("", fqmn)
+	if err != nil {
+		if fqmn == "."+statusSchemaName {
+			return b.statusRef(), nil
+		}
+		return nil, fmt.Errorf("message: unknown message %q", name)
+	}
+	return b.messageRef(msg), nil
+}
+
+// isSuccessCode reports whether the status code of a Responses entry is a
+// 2XX code or range.
+func isSuccessCode(code string) bool {
+	return len(code) == 3 && code[0] == '2'
+}
+
+// streamResultRef returns a $ref to the schema of the chunks of a server
+// stream of msg, whose schema is result, ensuring the component is
+// generated. The gateway writes each message as {"result": <msg>}, and
+// {"error": <google.rpc.Status>} if the stream fails. The error property is
+// omitted with disable_default_errors, like the default error response.
+func (b *schemaBuilder) streamResultRef(msg *descriptor.Message, result *SchemaOrRef) *SchemaOrRef {
+	name := b.derivedSchemaName(msg.FQMN(), streamResultSuffix)
+	if _, ok := b.doc.Components.Schemas[name]; ok {
+		return NewSchemaRef(name)
+	}
+	schema := &Schema{
+		Type:       SchemaType{"object"},
+		Title:      streamResultTitle(b.schemaName(msg.FQMN())),
+		Properties: map[string]*SchemaOrRef{"result": result},
+	}
+	if !b.reg.GetDisableDefaultErrors() {
+		schema.Properties["error"] = b.statusRef()
+	}
+	b.doc.Components.Schemas[name] = &SchemaOrRef{Value: schema}
+	return NewSchemaRef(name)
+}
+
+// streamResultTitle returns the title of the stream result schema of the
+// message whose component is named msgName.
+func streamResultTitle(msgName string) string {
+	return "Stream result of " + msgName
+}
+
+// streamContent returns the media types of a stream of JSON values whose
+// schema is item: newline-delimited JSON, under both of its media types.
+// OpenAPI 3.1.0 has no way to describe the items of a sequential media type,
+// so their schema is the item schema, as understood by most tools. With
+// eventStream, text/event-stream is added with an OpenAPI 3.2.0 itemSchema.
+func streamContent(item *SchemaOrRef, eventStream bool) map[string]*MediaType {
+	content := map[string]*MediaType{
+		"application/x-ndjson": {Schema: item},
+		"application/jsonl":    {Schema: item},
+	}
+	if eventStream {
+		content["text/event-stream"] = &MediaType{ItemSchema: item}
+	}
+	return content
+}
+
+// statusRef returns a $ref to the google.rpc.Status component schema,
+// ensuring the component is generated.
+func (b *schemaBuilder) statusRef() *SchemaOrRef {
+	name := b.schemaName(statusSchemaName)
+	b.ensureStatusSchema(name)
+	return NewSchemaRef(name)
+}
+
 // ensureStatusSchema makes sure the google.rpc.Status component schema is
-// present.
-func ensureStatusSchema(doc *Document) {
-	if _, ok := doc.Components.Schemas[statusSchemaName]; ok {
+// present under name. The details items are typed by the
+// status_detail_types of the document annotation, if any.
+func (b *schemaBuilder) ensureStatusSchema(name string) {
+	if _, ok := b.doc.Components.Schemas[name]; ok {
 		return
 	}
 	t := true
-	doc.Components.Schemas[statusSchemaName] = &SchemaOrRef{Value: &Schema{
+	detail := &SchemaOrRef{Value: &Schema{
+		Type: SchemaType{"object"},
+		Properties: map[string]*SchemaOrRef{
+			"@type": {Value: &Schema{Type: SchemaType{"string"}}},
+		},
+		AdditionalProperties: &AdditionalProperties{Bool: &t},
+	}}
+	status := &Schema{
 		Type:        SchemaType{"object"},
 		Description: "The Status type defines a logical error model suitable for different programming environments.",
 		Properties: map[string]*SchemaOrRef{
@@ -395,16 +693,21 @@ func ensureStatusSchema(doc *Document) {
 			"details": {Value: &Schema{
 				Type:        SchemaType{"array"},
 				Description: "A list of messages that carry the error details.",
-				Items: &SchemaOrRef{Value: &Schema{
-					Type: SchemaType{"object"},
-					Properties: map[string]*SchemaOrRef{
-						"@type": {Value: &Schema{Type: SchemaType{"string"}}},
-					},
-					AdditionalProperties: &AdditionalProperties{Bool: &t},
-				}},
+				Items:       detail,
 			}},
 		},
-	}}
+	}
+	// Reserve the slot before typing the details, whose schemas may
+	// reference the status.
+	b.doc.Components.Schemas[name] = &SchemaOrRef{Value: status}
+	if len(b.statusDetailTypes) > 0 {
+		typed, err := b.typedAnySchema(b.statusDetailTypes)
+		if err != nil {
+			b.fail(fmt.Errorf("openapiv3 status_detail_types: %w", err))
+			return
+		}
+		detail.Value = typed
+	}
 }
 
 // operationID returns the OpenAPI operationId for a method binding. We use
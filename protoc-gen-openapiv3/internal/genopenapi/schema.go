@@ -1,12 +1,15 @@
 package genopenapi
 
 import (
+	"fmt"
 	"slices"
+	"strings"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/internal/descriptor"
 	"google.golang.org/genproto/googleapis/api/annotations"
 	"google.golang.org/grpc/grpclog"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoregistry"
 	"google.golang.org/protobuf/types/descriptorpb"
 )
 
@@ -14,18 +17,73 @@ import (
 // Construction is intentionally lazy: schemas are only added when something
 // references them, transitively from RPC request/response types. We never
 // emit unreferenced messages.
+//
+// Schemas are built on demand from deep call chains, so the first invalid
+// annotation met is recorded in err instead of being returned; generateFile
+// checks it once all the operations are built.
 type schemaBuilder struct {
 	reg *descriptor.Registry
 	doc *Document
+	err error
+	// derived holds the component schemas derived from the schema of a
+	// message, e.g. the stream results, by name.
+	derived map[string]derivedSchema
+	// statusDetailTypes are the fully-qualified names of the message types
+	// of the details of the google.rpc.Status errors, from the
+	// status_detail_types of the document annotation.
+	statusDetailTypes []string
+	// files holds the descriptors of the proto files needed to validate
+	// the examples of the document, built on demand.
+	files *protoregistry.Files
 }
 
 func newSchemaBuilder(reg *descriptor.Registry, doc *Document) *schemaBuilder {
 	return &schemaBuilder{
-		reg: reg,
-		doc: doc,
+		reg:     reg,
+		doc:     doc,
+		derived: make(map[string]derivedSchema),
 	}
 }
 
+// derivedSchema is a component schema derived from the schema of a message,
+// named after the component name of the message followed by suffix.
+type derivedSchema struct {
+	// msg is the fully-qualified name of the message, without the leading
+	// dot.
+	msg    string
+	suffix string
+}
+
+// derivedSchemaName returns the name of the component schema derived from
+// the schema of the message whose fully-qualified name is fqmn, recording it
+// as derived. It fails if a proto type has a component of the same name,
+// e.g. a message nested in the message with the name of the suffix.
+func (b *schemaBuilder) derivedSchemaName(fqmn, suffix string) string {
+	msg := strings.TrimPrefix(fqmn, ".")
+	name := b.schemaName(fqmn) + suffix
+	if _, ok := b.derived[name]; !ok {
+		if _, ok := b.doc.Components.Schemas[name]; ok {
+			b.fail(derivedSchemaCollision(name, msg))
+		}
+	}
+	b.derived[name] = derivedSchema{msg: msg, suffix: suffix}
+	return name
+}
+
+// checkTypeSchemaName fails if name, the component name of a proto type, is
+// the name of a schema derived from the schema of a message.
+func (b *schemaBuilder) checkTypeSchemaName(name string) {
+	if ds, ok := b.derived[name]; ok {
+		b.fail(derivedSchemaCollision(name, ds.msg))
+	}
+}
+
+// derivedSchemaCollision returns the error of a proto type named like the
+// schema name derived from the schema of the message msg.
+func derivedSchemaCollision(name, msg string) error {
+	return fmt.Errorf("openapiv3: the component schema %q of %s is also the name of a proto type; rename the type", name, msg)
+}
+
 // fieldSchema returns the schema (or $ref) describing the given proto field's
 // type. For repeated fields it produces an array; for map entries it produces
 // an object with additionalProperties; for messages and enums it produces a
@@ -76,7 +134,7 @@ func (b *schemaBuilder) messageRef(msg *descriptor.Message) *SchemaOrRef {
 		return &SchemaOrRef{Value: wkt}
 	}
 	b.ensureMessageSchema(msg)
-	return NewSchemaRef(schemaName(msg.FQMN()))
+	return NewSchemaRef(b.schemaName(msg.FQMN()))
 }
 
 // mapSchema returns the additionalProperties schema for a proto map field.
@@ -149,7 +207,7 @@ func (b *schemaBuilder) scalarOrRef(field *descriptor.Field) *SchemaOrRef {
 			return &SchemaOrRef{Value: &Schema{Type: SchemaType{"object"}}}
 		}
 		b.ensureMessageSchema(msg)
-		return NewSchemaRef(schemaName(msg.FQMN()))
+		return NewSchemaRef(b.schemaName(msg.FQMN()))
 	case descriptorpb.FieldDescriptorProto_TYPE_ENUM:
 		enum, err := b.reg.LookupEnum("", field.GetTypeName())
 		if err != nil {
@@ -158,7 +216,7 @@ func (b *schemaBuilder) scalarOrRef(field *descriptor.Field) *SchemaOrRef {
 			return &SchemaOrRef{Value: &Schema{Type: SchemaType{"string"}}}
 		}
 		b.ensureEnumSchema(enum)
-		return NewSchemaRef(schemaName(enum.FQEN()))
+		return NewSchemaRef(b.schemaName(enum.FQEN()))
 	default:
 		// Fall back to a string value on unhandled field types
 		return &SchemaOrRef{Value: &Schema{Type: SchemaType{"string"}}}
@@ -172,7 +230,8 @@ func (b *schemaBuilder) ensureMessageSchema(msg *descriptor.Message) {
 		// Map entries are handled separately
 		return
 	}
-	name := schemaName(msg.FQMN())
+	name := b.schemaName(msg.FQMN())
+	b.checkTypeSchemaName(name)
 	if _, exists := b.doc.Components.Schemas[name]; exists {
 		// Message already in schema, skip
 		return
@@ -188,8 +247,18 @@ func (b *schemaBuilder) ensureMessageSchema(msg *descriptor.Message) {
 	if desc := messageComments(b.reg, msg); desc != "" {
 		schema.Description = desc
 	}
-	if ann, ok := messageSchemaAnnotation(msg); ok {
-		applyMessageSchemaOverride(schema, ann)
+	if ann, ok := messageSchemaAnnotation(b.reg, msg); ok {
+		if err := applyMessageSchemaOverride(schema, ann); err != nil {
+			b.fail(fmt.Errorf("openapiv3 schema %s: %w", name, err))
+		}
+		for i, ex := range ann.GetMessageExamples() {
+			value, err := b.exampleValue(msg, ex)
+			if err != nil {
+				b.fail(fmt.Errorf("openapiv3 schema %s: message_examples[%d]: %w", name, i, err))
+				break
+			}
+			schema.Examples = append(schema.Examples, value)
+		}
 	}
 	b.doc.Components.Schemas[name] = &SchemaOrRef{Value: schema}
 
@@ -329,7 +398,19 @@ func (b *schemaBuilder) addProperty(schema *Schema, field *descriptor.Field) {
 func (b *schemaBuilder) propertySchema(field *descriptor.Field) *SchemaOrRef {
 	prop := b.fieldSchema(field)
 	desc := fieldComments(b.reg, field)
-	ann, hasAnn := fieldSchemaAnnotation(field)
+	ann, hasAnn := fieldSchemaAnnotation(b.reg, field)
+	anyTypes := ann.GetAnyTypes()
+	if len(anyTypes) == 0 && field.FQFN() == statusDetailsFieldName {
+		anyTypes = b.statusDetailTypes
+	}
+	if len(anyTypes) > 0 {
+		if err := b.applyAnyTypes(prop, field, anyTypes); err != nil {
+			b.fail(fmt.Errorf("openapiv3 field %s: %w", field.FQFN(), err))
+		}
+	}
+	if len(ann.GetMessageExamples()) > 0 {
+		b.fail(fmt.Errorf("openapiv3 field %s: message_examples: only allowed in openapiv3_schema", field.FQFN()))
+	}
 	if hasAnn && ann.GetDescription() != "" {
 		desc = ann.GetDescription()
 	}
@@ -345,7 +426,9 @@ func (b *schemaBuilder) propertySchema(field *descriptor.Field) *SchemaOrRef {
 				wrapped.Description = desc
 			}
 			applyFieldFlags(wrapped, field)
-			applySchemaBodyOverride(wrapped, ann)
+			if err := applySchemaBodyOverride(wrapped, ann); err != nil {
+				b.fail(fmt.Errorf("openapiv3 field %s: %w", field.FQFN(), err))
+			}
 			return &SchemaOrRef{Value: wrapped}
 		}
 		if desc != "" {
@@ -359,11 +442,58 @@ func (b *schemaBuilder) propertySchema(field *descriptor.Field) *SchemaOrRef {
 			prop.Value.Description = desc
 		}
 		applyFieldFlags(prop.Value, field)
-		applySchemaBodyOverride(prop.Value, ann)
+		applyFieldInfoFormat(prop.Value, field)
+		if err := applySchemaBodyOverride(prop.Value, ann); err != nil {
+			b.fail(fmt.Errorf("openapiv3 field %s: %w", field.FQFN(), err))
+		}
 	}
 	return prop
 }
 
+// applyAnyTypes replaces the schema of the google.protobuf.Any values of
+// field, whose schema is prop, with a oneOf over the message types named by
+// types.
+func (b *schemaBuilder) applyAnyTypes(prop *SchemaOrRef, field *descriptor.Field, types []string) error {
+	if field.GetTypeName() != anyTypeName {
+		return fmt.Errorf("any_types: field is not a google.protobuf.Any")
+	}
+	schema, err := b.typedAnySchema(types)
+	if err != nil {
+		return fmt.Errorf("any_types: %w", err)
+	}
+	if field.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED {
+		prop.Value.Items = &SchemaOrRef{Value: schema}
+	} else {
+		prop.Value = schema
+	}
+	return nil
+}
+
+// parameterSchema returns the schema of a path or query parameter bound to
+// field. Inline schemas carry the format of its google.api.field_info
+// annotation and the keywords of its openapiv3_field annotation, so the
+// parameter is validated like the property.
+func (b *schemaBuilder) parameterSchema(field *descriptor.Field) *SchemaOrRef {
+	s := b.fieldSchema(field)
+	if s.Value == nil {
+		return s
+	}
+	applyFieldInfoFormat(s.Value, field)
+	if ann, ok := fieldSchemaAnnotation(b.reg, field); ok {
+		if err := applySchemaBodyOverride(s.Value, ann); err != nil {
+			b.fail(fmt.Errorf("openapiv3 field %s: %w", field.FQFN(), err))
+		}
+	}
+	return s
+}
+
+// fail records err unless an error has already been recorded.
+func (b *schemaBuilder) fail(err error) {
+	if b.err == nil {
+		b.err = err
+	}
+}
+
 // needsAllOfWrap reports whether a referenced field needs an allOf wrapper to
 // carry per-occurrence flags (read-only / write-only / deprecated). A pure
 // description does not.
@@ -396,6 +526,34 @@ func applyFieldFlags(s *Schema, field *descriptor.Field) {
 	}
 }
 
+// fieldInfoFormats maps the google.api.field_info formats to the JSON Schema
+// formats of string values. IPV4_OR_IPV6 has no JSON Schema counterpart.
+var fieldInfoFormats = map[annotations.FieldInfo_Format]string{
+	annotations.FieldInfo_UUID4: "uuid",
+	annotations.FieldInfo_IPV4:  "ipv4",
+	annotations.FieldInfo_IPV6:  "ipv6",
+}
+
+// applyFieldInfoFormat sets the format of the string values of an inline
+// schema from the google.api.field_info annotation of the field. For
+// repeated fields, the format is set on the items.
+func applyFieldInfoFormat(s *Schema, field *descriptor.Field) {
+	if field.Options == nil || !proto.HasExtension(field.Options, annotations.E_FieldInfo) {
+		return
+	}
+	info, _ := proto.GetExtension(field.Options, annotations.E_FieldInfo).(*annotations.FieldInfo)
+	format, ok := fieldInfoFormats[info.GetFormat()]
+	if !ok {
+		return
+	}
+	if slices.Equal(s.Type, SchemaType{"array"}) && s.Items != nil && s.Items.Value != nil {
+		s = s.Items.Value
+	}
+	if slices.Equal(s.Type, SchemaType{"string"}) {
+		s.Format = format
+	}
+}
+
 // fieldDeprecated reports whether a field should be flagged deprecated in
 // the emitted schema. The flag cascades from the enclosing message and
 // file: a field is deprecated if the field itself, its containing message,
@@ -449,7 +607,8 @@ func enumDeprecated(enum *descriptor.Enum) bool {
 // document the string form in the spec and rely on protojson's leniency
 // to accept the integer form at the gateway boundary.
 func (b *schemaBuilder) ensureEnumSchema(enum *descriptor.Enum) {
-	name := schemaName(enum.FQEN())
+	name := b.schemaName(enum.FQEN())
+	b.checkTypeSchemaName(name)
 	if _, exists := b.doc.Components.Schemas[name]; exists {
 		return
 	}
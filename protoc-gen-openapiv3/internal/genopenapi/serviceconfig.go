@@ -0,0 +1,56 @@
+package genopenapi
+
+import (
+	"strings"
+
+	"google.golang.org/genproto/googleapis/api/serviceconfig"
+)
+
+// applyServiceConfig applies the title, the documentation summary and the
+// authentication providers of the gRPC API Configuration to doc. The
+// openapiv3_document annotation of the file is applied afterwards, so it
+// takes precedence.
+func applyServiceConfig(doc *Document, config *serviceconfig.Service) {
+	if config.GetTitle() != "" {
+		doc.Info.Title = config.GetTitle()
+	}
+	if summary := strings.TrimSpace(config.GetDocumentation().GetSummary()); summary != "" {
+		doc.Info.Description = summary
+	}
+	for _, provider := range config.GetAuthentication().GetProviders() {
+		if doc.Components.SecuritySchemes == nil {
+			doc.Components.SecuritySchemes = make(map[string]*SecurityScheme)
+		}
+		doc.Components.SecuritySchemes[provider.GetId()] = authProviderSecurityScheme(provider)
+	}
+}
+
+// authProviderSecurityScheme returns the security scheme of an authentication
+// provider. Providers authenticate callers with JSON Web Tokens, which are
+// sent as bearer tokens.
+func authProviderSecurityScheme(provider *serviceconfig.AuthProvider) *SecurityScheme {
+	scheme := &SecurityScheme{
+		Type:         "http",
+		Scheme:       "bearer",
+		BearerFormat: "JWT",
+	}
+	if issuer := provider.GetIssuer(); issuer != "" {
+		scheme.Description = "JSON Web Tokens issued by " + issuer + "."
+	}
+	return scheme
+}
+
+// authenticationRuleSecurity returns the security requirements of an
+// authentication rule. A rule allowing calls without credentials adds an
+// empty requirement, which makes the others optional, and a rule without
+// requirements opts the method out of the document-level security.
+func authenticationRuleSecurity(rule *serviceconfig.AuthenticationRule) []SecurityRequirement {
+	security := []SecurityRequirement{}
+	for _, req := range rule.GetRequirements() {
+		security = append(security, SecurityRequirement{req.GetProviderId(): []string{}})
+	}
+	if rule.GetAllowWithoutCredential() && len(security) > 0 {
+		security = append(security, SecurityRequirement{})
+	}
+	return security
+}
@@ -20,6 +20,14 @@ type Document struct {
 	Security     []SecurityRequirement `json:"security,omitempty"`
 	Tags         []*Tag                `json:"tags,omitempty"`
 	ExternalDocs *ExternalDocs         `json:"externalDocs,omitempty"`
+
+	Extensions map[string]any `json:"-"`
+}
+
+// MarshalJSON appends the specification extensions to the fields.
+func (d *Document) MarshalJSON() ([]byte, error) {
+	type document Document
+	return marshalWithExtensions((*document)(d), d.Extensions)
 }
 
 // NewDocument returns a Document populated with the required fields.
@@ -48,6 +56,14 @@ type Info struct {
 	Contact        *Contact `json:"contact,omitempty"`
 	License        *License `json:"license,omitempty"`
 	Version        string   `json:"version"`
+
+	Extensions map[string]any `json:"-"`
+}
+
+// MarshalJSON appends the specification extensions to the fields.
+func (i *Info) MarshalJSON() ([]byte, error) {
+	type info Info
+	return marshalWithExtensions((*info)(i), i.Extensions)
 }
 
 // Contact is the API contact info.
@@ -76,6 +92,14 @@ type Server struct {
 	URL         string                     `json:"url"`
 	Description string                     `json:"description,omitempty"`
 	Variables   map[string]*ServerVariable `json:"variables,omitempty"`
+
+	Extensions map[string]any `json:"-"`
+}
+
+// MarshalJSON appends the specification extensions to the fields.
+func (s *Server) MarshalJSON() ([]byte, error) {
+	type server Server
+	return marshalWithExtensions((*server)(s), s.Extensions)
 }
 
 // ServerVariable is a server URL template variable.
@@ -194,7 +218,9 @@ func (p *PathItem) SetOperation(method string, op *Operation) {
 	}
 }
 
-// Operation describes a single API operation on a path.
+// Operation describes a single API operation on a path. A nil Security is
+// omitted, while an empty one is kept to opt the operation out of the
+// document-level security.
 //
 // Spec: https://spec.openapis.org/oas/v3.1.0#operation-object
 type Operation struct {
@@ -207,8 +233,16 @@ type Operation struct {
 	RequestBody  *RequestBodyRef       `json:"requestBody,omitempty"`
 	Responses    *Responses            `json:"responses,omitempty"`
 	Deprecated   bool                  `json:"deprecated,omitempty"`
-	Security     []SecurityRequirement `json:"security,omitempty"`
+	Security     []SecurityRequirement `json:"security,omitzero"`
 	Servers      []*Server             `json:"servers,omitempty"`
+
+	Extensions map[string]any `json:"-"`
+}
+
+// MarshalJSON appends the specification extensions to the fields.
+func (o *Operation) MarshalJSON() ([]byte, error) {
+	type operation Operation
+	return marshalWithExtensions((*operation)(o), o.Extensions)
 }
 
 // Parameter describes one operation parameter.
@@ -276,8 +310,24 @@ func (r *RequestBodyRef) MarshalJSON() ([]byte, error) {
 //
 // Spec: https://spec.openapis.org/oas/v3.1.0#media-type-object
 type MediaType struct {
-	Schema  *SchemaOrRef `json:"schema,omitempty"`
-	Example any          `json:"example,omitempty"`
+	Schema *SchemaOrRef `json:"schema,omitempty"`
+	// ItemSchema is the schema of each item of a sequential media type,
+	// e.g. text/event-stream. It is defined by OpenAPI 3.2.0, and only
+	// emitted with the event_stream_responses option.
+	//
+	// Spec: https://spec.openapis.org/oas/v3.2.0#media-type-object
+	ItemSchema *SchemaOrRef        `json:"itemSchema,omitempty"`
+	Example    any                 `json:"example,omitempty"`
+	Examples   map[string]*Example `json:"examples,omitempty"`
+}
+
+// Example is a named example of a media type.
+//
+// Spec: https://spec.openapis.org/oas/v3.1.0#example-object
+type Example struct {
+	Summary     string `json:"summary,omitempty"`
+	Description string `json:"description,omitempty"`
+	Value       any    `json:"value,omitempty"`
 }
 
 // Responses is the responses map. It is encoded with "default" first, then
@@ -384,6 +434,7 @@ func (r *ResponseRef) MarshalJSON() ([]byte, error) {
 // Spec: https://spec.openapis.org/oas/v3.1.0#header-object
 type Header struct {
 	Description string       `json:"description,omitempty"`
+	Required    bool         `json:"required,omitempty"`
 	Schema      *SchemaOrRef `json:"schema,omitempty"`
 }
 
@@ -421,14 +472,28 @@ type Schema struct {
 	Default  any   `json:"default,omitempty"`
 	Examples []any `json:"examples,omitempty"`
 	Enum     []any `json:"enum,omitempty"`
+	Const    any   `json:"const,omitempty"`
 
 	// Object validation
 	Properties           map[string]*SchemaOrRef `json:"properties,omitempty"`
 	Required             []string                `json:"required,omitempty"`
 	AdditionalProperties *AdditionalProperties   `json:"additionalProperties,omitempty"`
 
+	// String validation
+	Pattern   string  `json:"pattern,omitempty"`
+	MinLength *uint64 `json:"minLength,omitempty"`
+	MaxLength *uint64 `json:"maxLength,omitempty"`
+
+	// Numeric validation
+	Minimum    *float64 `json:"minimum,omitempty"`
+	Maximum    *float64 `json:"maximum,omitempty"`
+	MultipleOf *float64 `json:"multipleOf,omitempty"`
+
 	// Array validation
-	Items *SchemaOrRef `json:"items,omitempty"`
+	Items       *SchemaOrRef `json:"items,omitempty"`
+	MinItems    *uint64      `json:"minItems,omitempty"`
+	MaxItems    *uint64      `json:"maxItems,omitempty"`
+	UniqueItems bool         `json:"uniqueItems,omitempty"`
 
 	// Composition
 	AllOf []*SchemaOrRef `json:"allOf,omitempty"`
@@ -436,10 +501,30 @@ type Schema struct {
 	AnyOf []*SchemaOrRef `json:"anyOf,omitempty"`
 	Not   *SchemaOrRef   `json:"not,omitempty"`
 
+	// Polymorphism
+	Discriminator *Discriminator `json:"discriminator,omitempty"`
+
 	// Access / status
 	ReadOnly   bool `json:"readOnly,omitempty"`
 	WriteOnly  bool `json:"writeOnly,omitempty"`
 	Deprecated bool `json:"deprecated,omitempty"`
+
+	Extensions map[string]any `json:"-"`
+}
+
+// MarshalJSON appends the specification extensions to the fields.
+func (s *Schema) MarshalJSON() ([]byte, error) {
+	type schema Schema
+	return marshalWithExtensions((*schema)(s), s.Extensions)
+}
+
+// Discriminator tells which of the oneOf schemas of a polymorphic schema an
+// instance matches, based on the value of one of its properties.
+//
+// Spec: https://spec.openapis.org/oas/v3.1.0#discriminator-object
+type Discriminator struct {
+	PropertyName string            `json:"propertyName"`
+	Mapping      map[string]string `json:"mapping,omitempty"`
 }
 
 // AdditionalProperties is the union of a boolean and a schema. JSON Schema
@@ -535,6 +620,40 @@ func (c *Components) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+// marshalWithExtensions marshals v, a pointer to a struct without a custom
+// marshaler, and appends the specification extensions sorted by name, so
+// that the output stays byte-stable.
+//
+// Spec: https://spec.openapis.org/oas/v3.1.0#specification-extensions
+func marshalWithExtensions(v any, extensions map[string]any) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil || len(extensions) == 0 {
+		return data, err
+	}
+	var buf bytes.Buffer
+	buf.Write(data[:len(data)-1])
+	first := len(data) == 2
+	for _, k := range slices.Sorted(maps.Keys(extensions)) {
+		if !first {
+			buf.WriteByte(',')
+		}
+		first = false
+		key, err := json.Marshal(k)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		value, err := json.Marshal(extensions[k])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
 // writeSortedKeys emits a `"field":{...}` JSON object into buf with the
 // entries of m sorted by key. It writes a leading comma when *first is
 // false, then sets *first to false. If m is empty, nothing is written and
@@ -606,6 +725,22 @@ type OAuthFlow struct {
 	Scopes           map[string]string `json:"scopes"`
 }
 
+// declaresScope reports whether any flow declares the OAuth2 scope.
+func (f *OAuthFlows) declaresScope(scope string) bool {
+	if f == nil {
+		return false
+	}
+	for _, flow := range []*OAuthFlow{f.Implicit, f.Password, f.ClientCredentials, f.AuthorizationCode} {
+		if flow == nil {
+			continue
+		}
+		if _, ok := flow.Scopes[scope]; ok {
+			return true
+		}
+	}
+	return false
+}
+
 // SecurityRequirement is a map of scheme name to required scopes.
 //
 // Spec: https://spec.openapis.org/oas/v3.1.0#security-requirement-object
@@ -618,6 +753,14 @@ type Tag struct {
 	Name         string        `json:"name"`
 	Description  string        `json:"description,omitempty"`
 	ExternalDocs *ExternalDocs `json:"externalDocs,omitempty"`
+
+	Extensions map[string]any `json:"-"`
+}
+
+// MarshalJSON appends the specification extensions to the fields.
+func (t *Tag) MarshalJSON() ([]byte, error) {
+	type tag Tag
+	return marshalWithExtensions((*tag)(t), t.Extensions)
 }
 
 // ExternalDocs is a link to external documentation.
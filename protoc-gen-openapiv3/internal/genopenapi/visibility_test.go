@@ -72,7 +72,7 @@ func runWithSelectors(t *testing.T, req *pluginpb.CodeGeneratorRequest, selector
 		}
 		targets = append(targets, f)
 	}
-	out, err := Generate(reg, targets)
+	out, err := Generate(reg, targets, FormatJSON)
 	if err != nil {
 		t.Fatalf("generate: %v", err)
 	}
@@ -26,6 +26,15 @@ import (
 var (
 	visibilityRestrictionSelectors = utilities.StringArrayFlag(flag.CommandLine, "visibility_restriction_selectors", "list of `google.api.VisibilityRule` visibility labels to include in the generated output when a visibility annotation is defined. Repeat this option to supply multiple values. Elements without visibility annotations are unaffected by this setting.")
 	disableDefaultErrors           = flag.Bool("disable_default_errors", false, "if set, disables generation of default errors. This is useful if you have defined custom error handling")
+	httpStatusMapping              = utilities.StringArrayFlag(flag.CommandLine, "http_status_mapping", "`<code>:<status>` pair, e.g. `NOT_FOUND:410`, of the gRPC code to HTTP status mapping configured with runtime.WithHTTPStatusMapping, documented as an error response of every operation. Repeat this option to supply multiple values.")
+	httpStatusReasonMapping        = utilities.StringArrayFlag(flag.CommandLine, "http_status_reason_mapping", "`<reason>:<status>` pair of the google.rpc.ErrorInfo reason to HTTP status mapping configured with runtime.WithHTTPStatusMapping, documented as an error response of every operation. Repeat this option to supply multiple values.")
+	grpcAPIConfiguration           = flag.String("grpc_api_configuration", "", "path to file which describes the gRPC API Configuration in YAML format")
+	openAPIConfiguration           = flag.String("openapi_configuration", "", "path to file which describes the OpenAPI v3 Configuration in YAML format")
+	outputFormat                   = flag.String("output_format", string(genopenapi.FormatJSON), fmt.Sprintf("output content format. Allowed values are: `%s`, `%s`", genopenapi.FormatJSON, genopenapi.FormatYAML))
+	allowMerge                     = flag.Bool("allow_merge", false, "if set, the documents generated for all the proto files are merged into a single document")
+	mergeFileName                  = flag.String("merge_file_name", "apidocs", "name, without extension, of the document generated when allow_merge is set")
+	openAPINamingStrategy          = flag.String("openapi_naming_strategy", "fqn", "use the given OpenAPI naming strategy for the component schemas. Allowed values are `fqn`, `simple`, `package`, `legacy`")
+	eventStreamResponses           = flag.Bool("event_stream_responses", false, "if set, the responses of server-streaming methods are also documented as server-sent events, with an OpenAPI 3.2 itemSchema, and the documents declare OpenAPI 3.2.0. This is useful if the gateway uses a marshaler streaming text/event-stream responses")
 )
 
 func main() {
@@ -51,12 +60,38 @@ func run() error {
 		}
 	}
 
+	format := genopenapi.Format(*outputFormat)
+	if err := format.Validate(); err != nil {
+		return err
+	}
+
+	if genopenapi.LookupNamingStrategy(*openAPINamingStrategy) == nil {
+		return fmt.Errorf("invalid naming strategy %q", *openAPINamingStrategy)
+	}
+
 	reg := descriptor.NewRegistry()
 	reg.SetVisibilityRestrictionSelectors(*visibilityRestrictionSelectors)
 	reg.SetDisableDefaultErrors(*disableDefaultErrors)
+	reg.SetEventStreamResponses(*eventStreamResponses)
+	reg.SetOpenAPIv3NamingStrategy(*openAPINamingStrategy)
+	reg.SetAllowMerge(*allowMerge)
+	reg.SetMergeFileName(*mergeFileName)
+	if err := reg.SetHTTPStatusMappings(*httpStatusMapping, *httpStatusReasonMapping); err != nil {
+		return err
+	}
+	if *grpcAPIConfiguration != "" {
+		if err := reg.LoadGrpcAPIServiceFromYAML(*grpcAPIConfiguration); err != nil {
+			return err
+		}
+	}
 	if err := reg.Load(req); err != nil {
 		return err
 	}
+	if *openAPIConfiguration != "" {
+		if err := reg.LoadOpenAPIv3ConfigFromYAML(*openAPIConfiguration); err != nil {
+			return err
+		}
+	}
 
 	var targets []*descriptor.File
 	for _, name := range req.FileToGenerate {
@@ -67,7 +102,7 @@ func run() error {
 		targets = append(targets, f)
 	}
 
-	out, err := genopenapi.Generate(reg, targets)
+	out, err := genopenapi.Generate(reg, targets, format)
 	if err != nil {
 		return err
 	}
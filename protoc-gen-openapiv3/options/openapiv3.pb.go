@@ -14,6 +14,7 @@ package options
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	reflect "reflect"
 )
 
@@ -24,6 +25,108 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// The type of the security scheme.
+type SecurityScheme_Type int32
+
+const (
+	SecurityScheme_TYPE_UNSPECIFIED     SecurityScheme_Type = 0
+	SecurityScheme_TYPE_API_KEY         SecurityScheme_Type = 1
+	SecurityScheme_TYPE_HTTP            SecurityScheme_Type = 2
+	SecurityScheme_TYPE_MUTUAL_TLS      SecurityScheme_Type = 3
+	SecurityScheme_TYPE_OAUTH2          SecurityScheme_Type = 4
+	SecurityScheme_TYPE_OPEN_ID_CONNECT SecurityScheme_Type = 5
+)
+
+// Enum value maps for SecurityScheme_Type.
+var (
+	SecurityScheme_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "TYPE_API_KEY",
+		2: "TYPE_HTTP",
+		3: "TYPE_MUTUAL_TLS",
+		4: "TYPE_OAUTH2",
+		5: "TYPE_OPEN_ID_CONNECT",
+	}
+	SecurityScheme_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED":     0,
+		"TYPE_API_KEY":         1,
+		"TYPE_HTTP":            2,
+		"TYPE_MUTUAL_TLS":      3,
+		"TYPE_OAUTH2":          4,
+		"TYPE_OPEN_ID_CONNECT": 5,
+	}
+)
+
+func (x SecurityScheme_Type) Enum() *SecurityScheme_Type {
+	p := new(SecurityScheme_Type)
+	*p = x
+	return p
+}
+
+func (x SecurityScheme_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SecurityScheme_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_protoc_gen_openapiv3_options_openapiv3_proto_enumTypes[0].Descriptor()
+}
+
+func (SecurityScheme_Type) Type() protoreflect.EnumType {
+	return &file_protoc_gen_openapiv3_options_openapiv3_proto_enumTypes[0]
+}
+
+func (x SecurityScheme_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// The location of an API key.
+type SecurityScheme_In int32
+
+const (
+	SecurityScheme_IN_UNSPECIFIED SecurityScheme_In = 0
+	SecurityScheme_IN_QUERY       SecurityScheme_In = 1
+	SecurityScheme_IN_HEADER      SecurityScheme_In = 2
+	SecurityScheme_IN_COOKIE      SecurityScheme_In = 3
+)
+
+// Enum value maps for SecurityScheme_In.
+var (
+	SecurityScheme_In_name = map[int32]string{
+		0: "IN_UNSPECIFIED",
+		1: "IN_QUERY",
+		2: "IN_HEADER",
+		3: "IN_COOKIE",
+	}
+	SecurityScheme_In_value = map[string]int32{
+		"IN_UNSPECIFIED": 0,
+		"IN_QUERY":       1,
+		"IN_HEADER":      2,
+		"IN_COOKIE":      3,
+	}
+)
+
+func (x SecurityScheme_In) Enum() *SecurityScheme_In {
+	p := new(SecurityScheme_In)
+	*p = x
+	return p
+}
+
+func (x SecurityScheme_In) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SecurityScheme_In) Descriptor() protoreflect.EnumDescriptor {
+	return file_protoc_gen_openapiv3_options_openapiv3_proto_enumTypes[1].Descriptor()
+}
+
+func (SecurityScheme_In) Type() protoreflect.EnumType {
+	return &file_protoc_gen_openapiv3_options_openapiv3_proto_enumTypes[1]
+}
+
+func (x SecurityScheme_In) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
 // Document is a file-level override applied to the top of the generated
 // OpenAPI document. Only non-empty sub-fields replace the defaults the
 // generator would otherwise synthesize from the proto file name.
@@ -47,9 +150,29 @@ type Document struct {
 	// attach a description (or external docs) to a tag referenced by a
 	// method-level `openapiv3_operation.tags` override, since those tags
 	// would otherwise appear in the document with no metadata.
-	Tags          []*Tag `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Tags []*Tag `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	// The security schemes that can be referenced by the security requirements
+	// of the document, its services and its operations, by name. They are
+	// emitted in the `components.securitySchemes` of the document, alongside
+	// the schemes derived from the authentication providers of the gRPC API
+	// Configuration, which they replace on a name clash.
+	SecuritySchemes map[string]*SecurityScheme `protobuf:"bytes,5,rep,name=security_schemes,json=securitySchemes,proto3" json:"security_schemes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// A declaration of which security mechanisms can be used across the API.
+	// The list of values includes alternative security requirement objects
+	// that can be used. Only one of the security requirement objects need to
+	// be satisfied to authorize a request.
+	Security []*SecurityRequirement `protobuf:"bytes,6,rep,name=security,proto3" json:"security,omitempty"`
+	// Specification extensions of the document, by name. Each name must start
+	// with "x-".
+	Extensions map[string]*structpb.Value `protobuf:"bytes,7,rep,name=extensions,proto3" json:"extensions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// The fully-qualified names of the message types that the `details` of
+	// the google.rpc.Status errors of the document may contain, e.g.
+	// "google.rpc.BadRequest". The `details` items are documented as a
+	// `oneOf` over their schemas, discriminated by `@type`, like the
+	// `google.protobuf.Any` fields with `any_types`.
+	StatusDetailTypes []string `protobuf:"bytes,8,rep,name=status_detail_types,json=statusDetailTypes,proto3" json:"status_detail_types,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *Document) Reset() {
@@ -105,6 +228,34 @@ func (x *Document) GetTags() []*Tag {
 	return nil
 }
 
+func (x *Document) GetSecuritySchemes() map[string]*SecurityScheme {
+	if x != nil {
+		return x.SecuritySchemes
+	}
+	return nil
+}
+
+func (x *Document) GetSecurity() []*SecurityRequirement {
+	if x != nil {
+		return x.Security
+	}
+	return nil
+}
+
+func (x *Document) GetExtensions() map[string]*structpb.Value {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+func (x *Document) GetStatusDetailTypes() []string {
+	if x != nil {
+		return x.StatusDetailTypes
+	}
+	return nil
+}
+
 func (x *Document) SetInfo(v *Info) {
 	x.Info = v
 }
@@ -121,6 +272,22 @@ func (x *Document) SetTags(v []*Tag) {
 	x.Tags = v
 }
 
+func (x *Document) SetSecuritySchemes(v map[string]*SecurityScheme) {
+	x.SecuritySchemes = v
+}
+
+func (x *Document) SetSecurity(v []*SecurityRequirement) {
+	x.Security = v
+}
+
+func (x *Document) SetExtensions(v map[string]*structpb.Value) {
+	x.Extensions = v
+}
+
+func (x *Document) SetStatusDetailTypes(v []string) {
+	x.StatusDetailTypes = v
+}
+
 func (x *Document) HasInfo() bool {
 	if x == nil {
 		return false
@@ -163,6 +330,26 @@ type Document_builder struct {
 	// method-level `openapiv3_operation.tags` override, since those tags
 	// would otherwise appear in the document with no metadata.
 	Tags []*Tag
+	// The security schemes that can be referenced by the security requirements
+	// of the document, its services and its operations, by name. They are
+	// emitted in the `components.securitySchemes` of the document, alongside
+	// the schemes derived from the authentication providers of the gRPC API
+	// Configuration, which they replace on a name clash.
+	SecuritySchemes map[string]*SecurityScheme
+	// A declaration of which security mechanisms can be used across the API.
+	// The list of values includes alternative security requirement objects
+	// that can be used. Only one of the security requirement objects need to
+	// be satisfied to authorize a request.
+	Security []*SecurityRequirement
+	// Specification extensions of the document, by name. Each name must start
+	// with "x-".
+	Extensions map[string]*structpb.Value
+	// The fully-qualified names of the message types that the `details` of
+	// the google.rpc.Status errors of the document may contain, e.g.
+	// "google.rpc.BadRequest". The `details` items are documented as a
+	// `oneOf` over their schemas, discriminated by `@type`, like the
+	// `google.protobuf.Any` fields with `any_types`.
+	StatusDetailTypes []string
 }
 
 func (b0 Document_builder) Build() *Document {
@@ -173,6 +360,10 @@ func (b0 Document_builder) Build() *Document {
 	x.Servers = b.Servers
 	x.ExternalDocs = b.ExternalDocs
 	x.Tags = b.Tags
+	x.SecuritySchemes = b.SecuritySchemes
+	x.Security = b.Security
+	x.Extensions = b.Extensions
+	x.StatusDetailTypes = b.StatusDetailTypes
 	return m0
 }
 
@@ -198,7 +389,10 @@ type Info struct {
 	License *License `protobuf:"bytes,6,opt,name=license,proto3" json:"license,omitempty"`
 	// The version of the OpenAPI document (which is distinct from the OpenAPI
 	// Specification version or the API implementation version).
-	Version       string `protobuf:"bytes,7,opt,name=version,proto3" json:"version,omitempty"`
+	Version string `protobuf:"bytes,7,opt,name=version,proto3" json:"version,omitempty"`
+	// Specification extensions of the info object, by name. Each name must
+	// start with "x-".
+	Extensions    map[string]*structpb.Value `protobuf:"bytes,8,rep,name=extensions,proto3" json:"extensions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -277,6 +471,13 @@ func (x *Info) GetVersion() string {
 	return ""
 }
 
+func (x *Info) GetExtensions() map[string]*structpb.Value {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
 func (x *Info) SetTitle(v string) {
 	x.Title = v
 }
@@ -305,6 +506,10 @@ func (x *Info) SetVersion(v string) {
 	x.Version = v
 }
 
+func (x *Info) SetExtensions(v map[string]*structpb.Value) {
+	x.Extensions = v
+}
+
 func (x *Info) HasContact() bool {
 	if x == nil {
 		return false
@@ -347,6 +552,9 @@ type Info_builder struct {
 	// The version of the OpenAPI document (which is distinct from the OpenAPI
 	// Specification version or the API implementation version).
 	Version string
+	// Specification extensions of the info object, by name. Each name must
+	// start with "x-".
+	Extensions map[string]*structpb.Value
 }
 
 func (b0 Info_builder) Build() *Info {
@@ -360,6 +568,7 @@ func (b0 Info_builder) Build() *Info {
 	x.Contact = b.Contact
 	x.License = b.License
 	x.Version = b.Version
+	x.Extensions = b.Extensions
 	return m0
 }
 
@@ -679,7 +888,10 @@ type Server struct {
 	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
 	// An optional string describing the host designated by the URL. CommonMark
 	// syntax MAY be used for rich text representation.
-	Description   string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Specification extensions of the server object, by name. Each name must
+	// start with "x-".
+	Extensions    map[string]*structpb.Value `protobuf:"bytes,3,rep,name=extensions,proto3" json:"extensions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -723,6 +935,13 @@ func (x *Server) GetDescription() string {
 	return ""
 }
 
+func (x *Server) GetExtensions() map[string]*structpb.Value {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
 func (x *Server) SetUrl(v string) {
 	x.Url = v
 }
@@ -731,6 +950,10 @@ func (x *Server) SetDescription(v string) {
 	x.Description = v
 }
 
+func (x *Server) SetExtensions(v map[string]*structpb.Value) {
+	x.Extensions = v
+}
+
 type Server_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
@@ -741,6 +964,9 @@ type Server_builder struct {
 	// An optional string describing the host designated by the URL. CommonMark
 	// syntax MAY be used for rich text representation.
 	Description string
+	// Specification extensions of the server object, by name. Each name must
+	// start with "x-".
+	Extensions map[string]*structpb.Value
 }
 
 func (b0 Server_builder) Build() *Server {
@@ -749,6 +975,7 @@ func (b0 Server_builder) Build() *Server {
 	_, _ = b, x
 	x.Url = b.Url
 	x.Description = b.Description
+	x.Extensions = b.Extensions
 	return m0
 }
 
@@ -780,7 +1007,39 @@ type Operation struct {
 	// An alternative `servers` array to service this operation. If a `servers`
 	// array is specified at the Path Item Object or OpenAPI Object level, it
 	// will be overridden by this value.
-	Servers       []*Server `protobuf:"bytes,7,rep,name=servers,proto3" json:"servers,omitempty"`
+	Servers []*Server `protobuf:"bytes,7,rep,name=servers,proto3" json:"servers,omitempty"`
+	// A declaration of which security mechanisms can be used for this
+	// operation. It replaces the security requirements of the service and of
+	// the gRPC API Configuration authentication rule of the method, and
+	// overrides the document-level security.
+	Security []*SecurityRequirement `protobuf:"bytes,8,rep,name=security,proto3" json:"security,omitempty"`
+	// The responses of the operation by HTTP status code: a code such as
+	// "201", a range such as "4XX", or "default". They are merged with the
+	// generated responses and the `responses` of the service by code. If any
+	// of them is a success (2XX) response, the success responses of the
+	// service and the generated 200 response are dropped.
+	Responses map[string]*Response `protobuf:"bytes,9,rep,name=responses,proto3" json:"responses,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Specification extensions of the operation, by name. Each name must start
+	// with "x-".
+	Extensions map[string]*structpb.Value `protobuf:"bytes,10,rep,name=extensions,proto3" json:"extensions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Examples of the request body, validated against the message of the
+	// body: the request message for `body: "*"`, whose path parameters are
+	// removed from the examples, or the message of the body field. Not
+	// allowed on operations without a request body or on client-streaming
+	// methods.
+	RequestExamples []*Example `protobuf:"bytes,11,rep,name=request_examples,json=requestExamples,proto3" json:"request_examples,omitempty"`
+	// Examples of the body of the generated 200 response, validated against
+	// the response message. They are dropped with the 200 response if the
+	// `responses` of the service or operation replace it. Not allowed on
+	// server-streaming methods.
+	ResponseExamples []*Example `protobuf:"bytes,12,rep,name=response_examples,json=responseExamples,proto3" json:"response_examples,omitempty"`
+	// Declares that the operation requires no security, e.g. a login or health
+	// check endpoint of an API with document-level security. It emits an
+	// empty `security` array, which replaces the security requirements of the
+	// service and of the gRPC API Configuration authentication rule of the
+	// method, and overrides the document-level security. Not allowed together
+	// with `security`.
+	NoSecurity    bool `protobuf:"varint,13,opt,name=no_security,json=noSecurity,proto3" json:"no_security,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -859,6 +1118,48 @@ func (x *Operation) GetServers() []*Server {
 	return nil
 }
 
+func (x *Operation) GetSecurity() []*SecurityRequirement {
+	if x != nil {
+		return x.Security
+	}
+	return nil
+}
+
+func (x *Operation) GetResponses() map[string]*Response {
+	if x != nil {
+		return x.Responses
+	}
+	return nil
+}
+
+func (x *Operation) GetExtensions() map[string]*structpb.Value {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+func (x *Operation) GetRequestExamples() []*Example {
+	if x != nil {
+		return x.RequestExamples
+	}
+	return nil
+}
+
+func (x *Operation) GetResponseExamples() []*Example {
+	if x != nil {
+		return x.ResponseExamples
+	}
+	return nil
+}
+
+func (x *Operation) GetNoSecurity() bool {
+	if x != nil {
+		return x.NoSecurity
+	}
+	return false
+}
+
 func (x *Operation) SetTags(v []string) {
 	x.Tags = v
 }
@@ -887,6 +1188,30 @@ func (x *Operation) SetServers(v []*Server) {
 	x.Servers = v
 }
 
+func (x *Operation) SetSecurity(v []*SecurityRequirement) {
+	x.Security = v
+}
+
+func (x *Operation) SetResponses(v map[string]*Response) {
+	x.Responses = v
+}
+
+func (x *Operation) SetExtensions(v map[string]*structpb.Value) {
+	x.Extensions = v
+}
+
+func (x *Operation) SetRequestExamples(v []*Example) {
+	x.RequestExamples = v
+}
+
+func (x *Operation) SetResponseExamples(v []*Example) {
+	x.ResponseExamples = v
+}
+
+func (x *Operation) SetNoSecurity(v bool) {
+	x.NoSecurity = v
+}
+
 func (x *Operation) HasExternalDocs() bool {
 	if x == nil {
 		return false
@@ -923,6 +1248,38 @@ type Operation_builder struct {
 	// array is specified at the Path Item Object or OpenAPI Object level, it
 	// will be overridden by this value.
 	Servers []*Server
+	// A declaration of which security mechanisms can be used for this
+	// operation. It replaces the security requirements of the service and of
+	// the gRPC API Configuration authentication rule of the method, and
+	// overrides the document-level security.
+	Security []*SecurityRequirement
+	// The responses of the operation by HTTP status code: a code such as
+	// "201", a range such as "4XX", or "default". They are merged with the
+	// generated responses and the `responses` of the service by code. If any
+	// of them is a success (2XX) response, the success responses of the
+	// service and the generated 200 response are dropped.
+	Responses map[string]*Response
+	// Specification extensions of the operation, by name. Each name must start
+	// with "x-".
+	Extensions map[string]*structpb.Value
+	// Examples of the request body, validated against the message of the
+	// body: the request message for `body: "*"`, whose path parameters are
+	// removed from the examples, or the message of the body field. Not
+	// allowed on operations without a request body or on client-streaming
+	// methods.
+	RequestExamples []*Example
+	// Examples of the body of the generated 200 response, validated against
+	// the response message. They are dropped with the 200 response if the
+	// `responses` of the service or operation replace it. Not allowed on
+	// server-streaming methods.
+	ResponseExamples []*Example
+	// Declares that the operation requires no security, e.g. a login or health
+	// check endpoint of an API with document-level security. It emits an
+	// empty `security` array, which replaces the security requirements of the
+	// service and of the gRPC API Configuration authentication rule of the
+	// method, and overrides the document-level security. Not allowed together
+	// with `security`.
+	NoSecurity bool
 }
 
 func (b0 Operation_builder) Build() *Operation {
@@ -936,49 +1293,56 @@ func (b0 Operation_builder) Build() *Operation {
 	x.OperationId = b.OperationId
 	x.Deprecated = b.Deprecated
 	x.Servers = b.Servers
+	x.Security = b.Security
+	x.Responses = b.Responses
+	x.Extensions = b.Extensions
+	x.RequestExamples = b.RequestExamples
+	x.ResponseExamples = b.ResponseExamples
+	x.NoSecurity = b.NoSecurity
 	return m0
 }
 
-// Schema is a message- or field-level override applied to the generated
-// JSON Schema. Non-empty fields replace values the generator would
-// otherwise derive from proto comments or the proto type.
-//
-// Both `openapiv3_schema` (on MessageOptions) and `openapiv3_field` (on
-// FieldOptions) use this message.
-//
-// Spec: https://spec.openapis.org/oas/v3.1.0#schema-object
-// Underlying dialect: https://json-schema.org/draft/2020-12/json-schema-core
-type Schema struct {
+// Service is a service-level override applied to the generated Operation
+// objects of every method of the service. OpenAPI has no service-level
+// object, so its fields are copied into each operation.
+type Service struct {
 	state protoimpl.MessageState `protogen:"hybrid.v1"`
-	// A preferably short string describing the purpose of the instance
-	// described by the schema.
-	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	// A string providing explanation about the purpose of the instance
-	// described by the schema.
-	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	// Declares this schema to be deprecated. Consumers SHOULD refrain from
-	// usage of the declared schema. Default value is false. Setting this to
-	// false does not un-deprecate a schema that is marked deprecated in proto
-	// (`option deprecated = true` on the message, field, or file).
-	Deprecated    bool `protobuf:"varint,3,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// A declaration of which security mechanisms can be used for the
+	// operations of this service. It overrides the document-level security,
+	// and is replaced by the security requirements of the gRPC API
+	// Configuration authentication rule or the `openapiv3_operation`
+	// annotation of a method.
+	Security []*SecurityRequirement `protobuf:"bytes,1,rep,name=security,proto3" json:"security,omitempty"`
+	// The responses common to the operations of this service, typically their
+	// error responses, by HTTP status code. They are merged with the generated
+	// responses by code, and are replaced by the `responses` of the
+	// `openapiv3_operation` annotation of a method with the same code. If any
+	// of them is a success (2XX) response, the generated 200 response is
+	// dropped.
+	Responses map[string]*Response `protobuf:"bytes,2,rep,name=responses,proto3" json:"responses,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Declares that the operations of this service require no security. It
+	// emits an empty `security` array on each of them, which overrides the
+	// document-level security, and is replaced like `security`. Not allowed
+	// together with `security`.
+	NoSecurity    bool `protobuf:"varint,3,opt,name=no_security,json=noSecurity,proto3" json:"no_security,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Schema) Reset() {
-	*x = Schema{}
+func (x *Service) Reset() {
+	*x = Service{}
 	mi := &file_protoc_gen_openapiv3_options_openapiv3_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Schema) String() string {
+func (x *Service) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Schema) ProtoMessage() {}
+func (*Service) ProtoMessage() {}
 
-func (x *Schema) ProtoReflect() protoreflect.Message {
+func (x *Service) ProtoReflect() protoreflect.Message {
 	mi := &file_protoc_gen_openapiv3_options_openapiv3_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -990,93 +1354,110 @@ func (x *Schema) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *Schema) GetTitle() string {
+func (x *Service) GetSecurity() []*SecurityRequirement {
 	if x != nil {
-		return x.Title
+		return x.Security
 	}
-	return ""
+	return nil
 }
 
-func (x *Schema) GetDescription() string {
+func (x *Service) GetResponses() map[string]*Response {
 	if x != nil {
-		return x.Description
+		return x.Responses
 	}
-	return ""
+	return nil
 }
 
-func (x *Schema) GetDeprecated() bool {
+func (x *Service) GetNoSecurity() bool {
 	if x != nil {
-		return x.Deprecated
+		return x.NoSecurity
 	}
 	return false
 }
 
-func (x *Schema) SetTitle(v string) {
-	x.Title = v
+func (x *Service) SetSecurity(v []*SecurityRequirement) {
+	x.Security = v
 }
 
-func (x *Schema) SetDescription(v string) {
-	x.Description = v
+func (x *Service) SetResponses(v map[string]*Response) {
+	x.Responses = v
 }
 
-func (x *Schema) SetDeprecated(v bool) {
-	x.Deprecated = v
+func (x *Service) SetNoSecurity(v bool) {
+	x.NoSecurity = v
 }
 
-type Schema_builder struct {
+type Service_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	// A preferably short string describing the purpose of the instance
-	// described by the schema.
-	Title string
-	// A string providing explanation about the purpose of the instance
-	// described by the schema.
-	Description string
-	// Declares this schema to be deprecated. Consumers SHOULD refrain from
-	// usage of the declared schema. Default value is false. Setting this to
-	// false does not un-deprecate a schema that is marked deprecated in proto
-	// (`option deprecated = true` on the message, field, or file).
-	Deprecated bool
-}
-
-func (b0 Schema_builder) Build() *Schema {
-	m0 := &Schema{}
+	// A declaration of which security mechanisms can be used for the
+	// operations of this service. It overrides the document-level security,
+	// and is replaced by the security requirements of the gRPC API
+	// Configuration authentication rule or the `openapiv3_operation`
+	// annotation of a method.
+	Security []*SecurityRequirement
+	// The responses common to the operations of this service, typically their
+	// error responses, by HTTP status code. They are merged with the generated
+	// responses by code, and are replaced by the `responses` of the
+	// `openapiv3_operation` annotation of a method with the same code. If any
+	// of them is a success (2XX) response, the generated 200 response is
+	// dropped.
+	Responses map[string]*Response
+	// Declares that the operations of this service require no security. It
+	// emits an empty `security` array on each of them, which overrides the
+	// document-level security, and is replaced like `security`. Not allowed
+	// together with `security`.
+	NoSecurity bool
+}
+
+func (b0 Service_builder) Build() *Service {
+	m0 := &Service{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.Title = b.Title
-	x.Description = b.Description
-	x.Deprecated = b.Deprecated
+	x.Security = b.Security
+	x.Responses = b.Responses
+	x.NoSecurity = b.NoSecurity
 	return m0
 }
 
-// ExternalDocs is a link to external documentation.
+// Response describes a single response of an operation.
 //
-// Spec: https://spec.openapis.org/oas/v3.1.0#external-documentation-object
-type ExternalDocs struct {
+// Spec: https://spec.openapis.org/oas/v3.1.0#response-object
+type Response struct {
 	state protoimpl.MessageState `protogen:"hybrid.v1"`
-	// A description of the target documentation. CommonMark syntax MAY be used
-	// for rich text representation.
+	// A description of the response. CommonMark syntax MAY be used for rich
+	// text representation. Defaults to the reason phrase of the status code;
+	// required for status code ranges and "default".
 	Description string `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
-	// The URL for the target documentation. This MUST be in the form of a URL.
-	Url           string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// The fully-qualified name of the proto message of the response body,
+	// e.g. "google.rpc.Status". The response has no body if it is empty.
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// The media types of the response body. Defaults to "application/json".
+	// Ignored if `message` is empty.
+	ContentTypes []string `protobuf:"bytes,3,rep,name=content_types,json=contentTypes,proto3" json:"content_types,omitempty"`
+	// The headers of the response, by name.
+	Headers map[string]*Header `protobuf:"bytes,4,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Examples of the response body, validated against `message`. Only
+	// allowed if `message` is set.
+	Examples      []*Example `protobuf:"bytes,5,rep,name=examples,proto3" json:"examples,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExternalDocs) Reset() {
-	*x = ExternalDocs{}
+func (x *Response) Reset() {
+	*x = Response{}
 	mi := &file_protoc_gen_openapiv3_options_openapiv3_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExternalDocs) String() string {
+func (x *Response) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExternalDocs) ProtoMessage() {}
+func (*Response) ProtoMessage() {}
 
-func (x *ExternalDocs) ProtoReflect() protoreflect.Message {
+func (x *Response) ProtoReflect() protoreflect.Message {
 	mi := &file_protoc_gen_openapiv3_options_openapiv3_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1088,79 +1469,135 @@ func (x *ExternalDocs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *ExternalDocs) GetDescription() string {
+func (x *Response) GetDescription() string {
 	if x != nil {
 		return x.Description
 	}
 	return ""
 }
 
-func (x *ExternalDocs) GetUrl() string {
+func (x *Response) GetMessage() string {
 	if x != nil {
-		return x.Url
+		return x.Message
 	}
 	return ""
 }
 
-func (x *ExternalDocs) SetDescription(v string) {
+func (x *Response) GetContentTypes() []string {
+	if x != nil {
+		return x.ContentTypes
+	}
+	return nil
+}
+
+func (x *Response) GetHeaders() map[string]*Header {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *Response) GetExamples() []*Example {
+	if x != nil {
+		return x.Examples
+	}
+	return nil
+}
+
+func (x *Response) SetDescription(v string) {
 	x.Description = v
 }
 
-func (x *ExternalDocs) SetUrl(v string) {
-	x.Url = v
+func (x *Response) SetMessage(v string) {
+	x.Message = v
 }
 
-type ExternalDocs_builder struct {
-	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+func (x *Response) SetContentTypes(v []string) {
+	x.ContentTypes = v
+}
 
-	// A description of the target documentation. CommonMark syntax MAY be used
-	// for rich text representation.
-	Description string
-	// The URL for the target documentation. This MUST be in the form of a URL.
-	Url string
+func (x *Response) SetHeaders(v map[string]*Header) {
+	x.Headers = v
 }
 
-func (b0 ExternalDocs_builder) Build() *ExternalDocs {
-	m0 := &ExternalDocs{}
+func (x *Response) SetExamples(v []*Example) {
+	x.Examples = v
+}
+
+type Response_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// A description of the response. CommonMark syntax MAY be used for rich
+	// text representation. Defaults to the reason phrase of the status code;
+	// required for status code ranges and "default".
+	Description string
+	// The fully-qualified name of the proto message of the response body,
+	// e.g. "google.rpc.Status". The response has no body if it is empty.
+	Message string
+	// The media types of the response body. Defaults to "application/json".
+	// Ignored if `message` is empty.
+	ContentTypes []string
+	// The headers of the response, by name.
+	Headers map[string]*Header
+	// Examples of the response body, validated against `message`. Only
+	// allowed if `message` is set.
+	Examples []*Example
+}
+
+func (b0 Response_builder) Build() *Response {
+	m0 := &Response{}
 	b, x := &b0, m0
 	_, _ = b, x
 	x.Description = b.Description
-	x.Url = b.Url
+	x.Message = b.Message
+	x.ContentTypes = b.ContentTypes
+	x.Headers = b.Headers
+	x.Examples = b.Examples
 	return m0
 }
 
-// Tag adds metadata to a single tag that is used by the Operation Object. It
-// is not mandatory to have a Tag Object per tag defined in the Operation
-// Object instances.
+// Example is an example of a message, e.g. of a request or response body.
+// It is validated against the message descriptor, and emitted in the JSON
+// form of the message written by protojson, as the gateway does.
 //
-// Spec: https://spec.openapis.org/oas/v3.1.0#tag-object
-type Tag struct {
+// Spec: https://spec.openapis.org/oas/v3.1.0#example-object
+type Example struct {
 	state protoimpl.MessageState `protogen:"hybrid.v1"`
-	// The name of the tag.
+	// The name of the example in the `examples` of a media type. Defaults to
+	// "example" followed by the 1-based position of the example, e.g.
+	// "example1". Ignored for the examples of a schema.
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// A description for the tag. CommonMark syntax MAY be used for rich text
-	// representation.
-	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	// Additional external documentation for this tag.
-	ExternalDocs  *ExternalDocs `protobuf:"bytes,3,opt,name=external_docs,json=externalDocs,proto3" json:"external_docs,omitempty"`
+	// Short description for the example.
+	Summary string `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary,omitempty"`
+	// Long description for the example. CommonMark syntax MAY be used for
+	// rich text representation.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// The value of the example.
+	//
+	// Types that are valid to be assigned to Value:
+	//
+	//	*Example_Json
+	//	*Example_Textproto
+	//	*Example_File
+	Value         isExample_Value `protobuf_oneof:"value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Tag) Reset() {
-	*x = Tag{}
+func (x *Example) Reset() {
+	*x = Example{}
 	mi := &file_protoc_gen_openapiv3_options_openapiv3_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Tag) String() string {
+func (x *Example) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Tag) ProtoMessage() {}
+func (*Example) ProtoMessage() {}
 
-func (x *Tag) ProtoReflect() protoreflect.Message {
+func (x *Example) ProtoReflect() protoreflect.Message {
 	mi := &file_protoc_gen_openapiv3_options_openapiv3_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1172,69 +1609,1660 @@ func (x *Tag) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *Tag) GetName() string {
+func (x *Example) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *Tag) GetDescription() string {
+func (x *Example) GetSummary() string {
 	if x != nil {
-		return x.Description
+		return x.Summary
 	}
 	return ""
 }
 
-func (x *Tag) GetExternalDocs() *ExternalDocs {
+func (x *Example) GetDescription() string {
 	if x != nil {
-		return x.ExternalDocs
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Example) GetValue() isExample_Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *Example) GetJson() string {
+	if x != nil {
+		if x, ok := x.Value.(*Example_Json); ok {
+			return x.Json
+		}
+	}
+	return ""
+}
+
+func (x *Example) GetTextproto() string {
+	if x != nil {
+		if x, ok := x.Value.(*Example_Textproto); ok {
+			return x.Textproto
+		}
+	}
+	return ""
+}
+
+func (x *Example) GetFile() string {
+	if x != nil {
+		if x, ok := x.Value.(*Example_File); ok {
+			return x.File
+		}
+	}
+	return ""
+}
+
+func (x *Example) SetName(v string) {
+	x.Name = v
+}
+
+func (x *Example) SetSummary(v string) {
+	x.Summary = v
+}
+
+func (x *Example) SetDescription(v string) {
+	x.Description = v
+}
+
+func (x *Example) SetJson(v string) {
+	x.Value = &Example_Json{v}
+}
+
+func (x *Example) SetTextproto(v string) {
+	x.Value = &Example_Textproto{v}
+}
+
+func (x *Example) SetFile(v string) {
+	x.Value = &Example_File{v}
+}
+
+func (x *Example) HasValue() bool {
+	if x == nil {
+		return false
+	}
+	return x.Value != nil
+}
+
+func (x *Example) HasJson() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.Value.(*Example_Json)
+	return ok
+}
+
+func (x *Example) HasTextproto() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.Value.(*Example_Textproto)
+	return ok
+}
+
+func (x *Example) HasFile() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.Value.(*Example_File)
+	return ok
+}
+
+func (x *Example) ClearValue() {
+	x.Value = nil
+}
+
+func (x *Example) ClearJson() {
+	if _, ok := x.Value.(*Example_Json); ok {
+		x.Value = nil
+	}
+}
+
+func (x *Example) ClearTextproto() {
+	if _, ok := x.Value.(*Example_Textproto); ok {
+		x.Value = nil
+	}
+}
+
+func (x *Example) ClearFile() {
+	if _, ok := x.Value.(*Example_File); ok {
+		x.Value = nil
+	}
+}
+
+const Example_Value_not_set_case case_Example_Value = 0
+const Example_Json_case case_Example_Value = 4
+const Example_Textproto_case case_Example_Value = 5
+const Example_File_case case_Example_Value = 6
+
+func (x *Example) WhichValue() case_Example_Value {
+	if x == nil {
+		return Example_Value_not_set_case
+	}
+	switch x.Value.(type) {
+	case *Example_Json:
+		return Example_Json_case
+	case *Example_Textproto:
+		return Example_Textproto_case
+	case *Example_File:
+		return Example_File_case
+	default:
+		return Example_Value_not_set_case
+	}
+}
+
+type Example_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The name of the example in the `examples` of a media type. Defaults to
+	// "example" followed by the 1-based position of the example, e.g.
+	// "example1". Ignored for the examples of a schema.
+	Name string
+	// Short description for the example.
+	Summary string
+	// Long description for the example. CommonMark syntax MAY be used for
+	// rich text representation.
+	Description string
+	// The value of the example.
+
+	// Fields of oneof Value:
+	// The message in the protobuf JSON format, e.g.
+	// `{"displayName": "Alice"}`.
+	Json *string
+	// The message in the protobuf text format, e.g.
+	// `display_name: "Alice"`.
+	Textproto *string
+	// The path of a file holding the message, relative to the working
+	// directory of the plugin: in the protobuf JSON format if its name ends
+	// with ".json", in the protobuf text format if it ends with
+	// ".textproto", ".txtpb" or ".pbtxt".
+	File *string
+	// -- end of Value
+}
+
+func (b0 Example_builder) Build() *Example {
+	m0 := &Example{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Name = b.Name
+	x.Summary = b.Summary
+	x.Description = b.Description
+	if b.Json != nil {
+		x.Value = &Example_Json{*b.Json}
+	}
+	if b.Textproto != nil {
+		x.Value = &Example_Textproto{*b.Textproto}
+	}
+	if b.File != nil {
+		x.Value = &Example_File{*b.File}
+	}
+	return m0
+}
+
+type case_Example_Value protoreflect.FieldNumber
+
+func (x case_Example_Value) String() string {
+	md := file_protoc_gen_openapiv3_options_openapiv3_proto_msgTypes[8].Descriptor()
+	if x == 0 {
+		return "not set"
+	}
+	return protoimpl.X.MessageFieldStringOf(md, protoreflect.FieldNumber(x))
+}
+
+type isExample_Value interface {
+	isExample_Value()
+}
+
+type Example_Json struct {
+	// The message in the protobuf JSON format, e.g.
+	// `{"displayName": "Alice"}`.
+	Json string `protobuf:"bytes,4,opt,name=json,proto3,oneof"`
+}
+
+type Example_Textproto struct {
+	// The message in the protobuf text format, e.g.
+	// `display_name: "Alice"`.
+	Textproto string `protobuf:"bytes,5,opt,name=textproto,proto3,oneof"`
+}
+
+type Example_File struct {
+	// The path of a file holding the message, relative to the working
+	// directory of the plugin: in the protobuf JSON format if its name ends
+	// with ".json", in the protobuf text format if it ends with
+	// ".textproto", ".txtpb" or ".pbtxt".
+	File string `protobuf:"bytes,6,opt,name=file,proto3,oneof"`
+}
+
+func (*Example_Json) isExample_Value() {}
+
+func (*Example_Textproto) isExample_Value() {}
+
+func (*Example_File) isExample_Value() {}
+
+// Header describes a single header of a response.
+//
+// Spec: https://spec.openapis.org/oas/v3.1.0#header-object
+type Header struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	// A brief description of the header. CommonMark syntax MAY be used for
+	// rich text representation.
+	Description string `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	// The JSON Schema type of the header value: "string", "number",
+	// "integer" or "boolean". Defaults to "string".
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// The format of the header value, e.g. "int64" or "date-time".
+	Format string `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	// Determines whether the header is always sent.
+	Required      bool `protobuf:"varint,4,opt,name=required,proto3" json:"required,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Header) Reset() {
+	*x = Header{}
+	mi := &file_protoc_gen_openapiv3_options_openapiv3_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Header) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Header) ProtoMessage() {}
+
+func (x *Header) ProtoReflect() protoreflect.Message {
+	mi := &file_protoc_gen_openapiv3_options_openapiv3_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Header) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Header) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Header) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *Header) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *Header) SetDescription(v string) {
+	x.Description = v
+}
+
+func (x *Header) SetType(v string) {
+	x.Type = v
+}
+
+func (x *Header) SetFormat(v string) {
+	x.Format = v
+}
+
+func (x *Header) SetRequired(v bool) {
+	x.Required = v
+}
+
+type Header_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// A brief description of the header. CommonMark syntax MAY be used for
+	// rich text representation.
+	Description string
+	// The JSON Schema type of the header value: "string", "number",
+	// "integer" or "boolean". Defaults to "string".
+	Type string
+	// The format of the header value, e.g. "int64" or "date-time".
+	Format string
+	// Determines whether the header is always sent.
+	Required bool
+}
+
+func (b0 Header_builder) Build() *Header {
+	m0 := &Header{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Description = b.Description
+	x.Type = b.Type
+	x.Format = b.Format
+	x.Required = b.Required
+	return m0
+}
+
+// Schema is a message- or field-level override applied to the generated
+// JSON Schema. Non-empty fields replace values the generator would
+// otherwise derive from proto comments or the proto type. On a repeated
+// field, the string and numeric validation keywords (`format`, `pattern`,
+// `min_length`, `max_length`, `minimum`, `maximum` and `multiple_of`) apply
+// to its items.
+//
+// Both `openapiv3_schema` (on MessageOptions) and `openapiv3_field` (on
+// FieldOptions) use this message.
+//
+// Spec: https://spec.openapis.org/oas/v3.1.0#schema-object
+// Underlying dialect: https://json-schema.org/draft/2020-12/json-schema-core
+type Schema struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	// A preferably short string describing the purpose of the instance
+	// described by the schema.
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// A string providing explanation about the purpose of the instance
+	// described by the schema.
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Declares this schema to be deprecated. Consumers SHOULD refrain from
+	// usage of the declared schema. Default value is false. Setting this to
+	// false does not un-deprecate a schema that is marked deprecated in proto
+	// (`option deprecated = true` on the message, field, or file).
+	Deprecated bool `protobuf:"varint,3,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// The format of the instance, e.g. "uuid", "email" or "date-time". It
+	// replaces the format derived from the proto type or from the
+	// `google.api.field_info` annotation.
+	Format string `protobuf:"bytes,4,opt,name=format,proto3" json:"format,omitempty"`
+	// A regular expression the instance must match, per ECMA-262.
+	Pattern string `protobuf:"bytes,5,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	// The minimum length of a string instance.
+	MinLength *uint64 `protobuf:"varint,6,opt,name=min_length,json=minLength,proto3,oneof" json:"min_length,omitempty"`
+	// The maximum length of a string instance.
+	MaxLength *uint64 `protobuf:"varint,7,opt,name=max_length,json=maxLength,proto3,oneof" json:"max_length,omitempty"`
+	// The inclusive lower limit of a numeric instance.
+	Minimum *float64 `protobuf:"fixed64,8,opt,name=minimum,proto3,oneof" json:"minimum,omitempty"`
+	// The inclusive upper limit of a numeric instance.
+	Maximum *float64 `protobuf:"fixed64,9,opt,name=maximum,proto3,oneof" json:"maximum,omitempty"`
+	// A numeric instance must be a multiple of this strictly positive number.
+	MultipleOf *float64 `protobuf:"fixed64,10,opt,name=multiple_of,json=multipleOf,proto3,oneof" json:"multiple_of,omitempty"`
+	// The minimum number of items of an array instance.
+	MinItems *uint64 `protobuf:"varint,11,opt,name=min_items,json=minItems,proto3,oneof" json:"min_items,omitempty"`
+	// The maximum number of items of an array instance.
+	MaxItems *uint64 `protobuf:"varint,12,opt,name=max_items,json=maxItems,proto3,oneof" json:"max_items,omitempty"`
+	// Whether the items of an array instance must be unique.
+	UniqueItems bool `protobuf:"varint,13,opt,name=unique_items,json=uniqueItems,proto3" json:"unique_items,omitempty"`
+	// The default value of the instance, encoded as JSON: `"42"` for a
+	// number, `"\"foo\""` for a string.
+	Default string `protobuf:"bytes,14,opt,name=default,proto3" json:"default,omitempty"`
+	// Examples of instances, each encoded as JSON like `default`.
+	Examples []string `protobuf:"bytes,15,rep,name=examples,proto3" json:"examples,omitempty"`
+	// Declares the instance to be read only: it is ignored in requests.
+	// Setting this to false does not clear a flag derived from the
+	// `OUTPUT_ONLY` field behavior.
+	ReadOnly bool `protobuf:"varint,16,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+	// Declares the instance to be write only: it is never returned in
+	// responses. Setting this to false does not clear a flag derived from the
+	// `INPUT_ONLY` field behavior.
+	WriteOnly bool `protobuf:"varint,17,opt,name=write_only,json=writeOnly,proto3" json:"write_only,omitempty"`
+	// Specification extensions of the schema, by name. Each name must start
+	// with "x-".
+	Extensions map[string]*structpb.Value `protobuf:"bytes,18,rep,name=extensions,proto3" json:"extensions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// The fully-qualified names of the message types that a
+	// `google.protobuf.Any` field, or the items of a repeated one, may
+	// contain, e.g. "acme.events.v1.Created". The field is documented as a
+	// `oneOf` over their component schemas, each extended with a constant
+	// `@type`, with a `discriminator` mapping the type URLs to the schemas.
+	// Only allowed on `google.protobuf.Any` fields.
+	AnyTypes []string `protobuf:"bytes,19,rep,name=any_types,json=anyTypes,proto3" json:"any_types,omitempty"`
+	// Examples of the message, validated against the message descriptor and
+	// appended to `examples`. Only allowed in `openapiv3_schema`.
+	MessageExamples []*Example `protobuf:"bytes,20,rep,name=message_examples,json=messageExamples,proto3" json:"message_examples,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Schema) Reset() {
+	*x = Schema{}
+	mi := &file_protoc_gen_openapiv3_options_openapiv3_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Schema) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Schema) ProtoMessage() {}
+
+func (x *Schema) ProtoReflect() protoreflect.Message {
+	mi := &file_protoc_gen_openapiv3_options_openapiv3_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Schema) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Schema) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Schema) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+func (x *Schema) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *Schema) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *Schema) GetMinLength() uint64 {
+	if x != nil && x.MinLength != nil {
+		return *x.MinLength
+	}
+	return 0
+}
+
+func (x *Schema) GetMaxLength() uint64 {
+	if x != nil && x.MaxLength != nil {
+		return *x.MaxLength
+	}
+	return 0
+}
+
+func (x *Schema) GetMinimum() float64 {
+	if x != nil && x.Minimum != nil {
+		return *x.Minimum
+	}
+	return 0
+}
+
+func (x *Schema) GetMaximum() float64 {
+	if x != nil && x.Maximum != nil {
+		return *x.Maximum
+	}
+	return 0
+}
+
+func (x *Schema) GetMultipleOf() float64 {
+	if x != nil && x.MultipleOf != nil {
+		return *x.MultipleOf
+	}
+	return 0
+}
+
+func (x *Schema) GetMinItems() uint64 {
+	if x != nil && x.MinItems != nil {
+		return *x.MinItems
+	}
+	return 0
+}
+
+func (x *Schema) GetMaxItems() uint64 {
+	if x != nil && x.MaxItems != nil {
+		return *x.MaxItems
+	}
+	return 0
+}
+
+func (x *Schema) GetUniqueItems() bool {
+	if x != nil {
+		return x.UniqueItems
+	}
+	return false
+}
+
+func (x *Schema) GetDefault() string {
+	if x != nil {
+		return x.Default
+	}
+	return ""
+}
+
+func (x *Schema) GetExamples() []string {
+	if x != nil {
+		return x.Examples
+	}
+	return nil
+}
+
+func (x *Schema) GetReadOnly() bool {
+	if x != nil {
+		return x.ReadOnly
+	}
+	return false
+}
+
+func (x *Schema) GetWriteOnly() bool {
+	if x != nil {
+		return x.WriteOnly
+	}
+	return false
+}
+
+func (x *Schema) GetExtensions() map[string]*structpb.Value {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+func (x *Schema) GetAnyTypes() []string {
+	if x != nil {
+		return x.AnyTypes
+	}
+	return nil
+}
+
+func (x *Schema) GetMessageExamples() []*Example {
+	if x != nil {
+		return x.MessageExamples
+	}
+	return nil
+}
+
+func (x *Schema) SetTitle(v string) {
+	x.Title = v
+}
+
+func (x *Schema) SetDescription(v string) {
+	x.Description = v
+}
+
+func (x *Schema) SetDeprecated(v bool) {
+	x.Deprecated = v
+}
+
+func (x *Schema) SetFormat(v string) {
+	x.Format = v
+}
+
+func (x *Schema) SetPattern(v string) {
+	x.Pattern = v
+}
+
+func (x *Schema) SetMinLength(v uint64) {
+	x.MinLength = &v
+}
+
+func (x *Schema) SetMaxLength(v uint64) {
+	x.MaxLength = &v
+}
+
+func (x *Schema) SetMinimum(v float64) {
+	x.Minimum = &v
+}
+
+func (x *Schema) SetMaximum(v float64) {
+	x.Maximum = &v
+}
+
+func (x *Schema) SetMultipleOf(v float64) {
+	x.MultipleOf = &v
+}
+
+func (x *Schema) SetMinItems(v uint64) {
+	x.MinItems = &v
+}
+
+func (x *Schema) SetMaxItems(v uint64) {
+	x.MaxItems = &v
+}
+
+func (x *Schema) SetUniqueItems(v bool) {
+	x.UniqueItems = v
+}
+
+func (x *Schema) SetDefault(v string) {
+	x.Default = v
+}
+
+func (x *Schema) SetExamples(v []string) {
+	x.Examples = v
+}
+
+func (x *Schema) SetReadOnly(v bool) {
+	x.ReadOnly = v
+}
+
+func (x *Schema) SetWriteOnly(v bool) {
+	x.WriteOnly = v
+}
+
+func (x *Schema) SetExtensions(v map[string]*structpb.Value) {
+	x.Extensions = v
+}
+
+func (x *Schema) SetAnyTypes(v []string) {
+	x.AnyTypes = v
+}
+
+func (x *Schema) SetMessageExamples(v []*Example) {
+	x.MessageExamples = v
+}
+
+func (x *Schema) HasMinLength() bool {
+	if x == nil {
+		return false
+	}
+	return x.MinLength != nil
+}
+
+func (x *Schema) HasMaxLength() bool {
+	if x == nil {
+		return false
+	}
+	return x.MaxLength != nil
+}
+
+func (x *Schema) HasMinimum() bool {
+	if x == nil {
+		return false
+	}
+	return x.Minimum != nil
+}
+
+func (x *Schema) HasMaximum() bool {
+	if x == nil {
+		return false
+	}
+	return x.Maximum != nil
+}
+
+func (x *Schema) HasMultipleOf() bool {
+	if x == nil {
+		return false
+	}
+	return x.MultipleOf != nil
+}
+
+func (x *Schema) HasMinItems() bool {
+	if x == nil {
+		return false
+	}
+	return x.MinItems != nil
+}
+
+func (x *Schema) HasMaxItems() bool {
+	if x == nil {
+		return false
+	}
+	return x.MaxItems != nil
+}
+
+func (x *Schema) ClearMinLength() {
+	x.MinLength = nil
+}
+
+func (x *Schema) ClearMaxLength() {
+	x.MaxLength = nil
+}
+
+func (x *Schema) ClearMinimum() {
+	x.Minimum = nil
+}
+
+func (x *Schema) ClearMaximum() {
+	x.Maximum = nil
+}
+
+func (x *Schema) ClearMultipleOf() {
+	x.MultipleOf = nil
+}
+
+func (x *Schema) ClearMinItems() {
+	x.MinItems = nil
+}
+
+func (x *Schema) ClearMaxItems() {
+	x.MaxItems = nil
+}
+
+type Schema_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// A preferably short string describing the purpose of the instance
+	// described by the schema.
+	Title string
+	// A string providing explanation about the purpose of the instance
+	// described by the schema.
+	Description string
+	// Declares this schema to be deprecated. Consumers SHOULD refrain from
+	// usage of the declared schema. Default value is false. Setting this to
+	// false does not un-deprecate a schema that is marked deprecated in proto
+	// (`option deprecated = true` on the message, field, or file).
+	Deprecated bool
+	// The format of the instance, e.g. "uuid", "email" or "date-time". It
+	// replaces the format derived from the proto type or from the
+	// `google.api.field_info` annotation.
+	Format string
+	// A regular expression the instance must match, per ECMA-262.
+	Pattern string
+	// The minimum length of a string instance.
+	MinLength *uint64
+	// The maximum length of a string instance.
+	MaxLength *uint64
+	// The inclusive lower limit of a numeric instance.
+	Minimum *float64
+	// The inclusive upper limit of a numeric instance.
+	Maximum *float64
+	// A numeric instance must be a multiple of this strictly positive number.
+	MultipleOf *float64
+	// The minimum number of items of an array instance.
+	MinItems *uint64
+	// The maximum number of items of an array instance.
+	MaxItems *uint64
+	// Whether the items of an array instance must be unique.
+	UniqueItems bool
+	// The default value of the instance, encoded as JSON: `"42"` for a
+	// number, `"\"foo\""` for a string.
+	Default string
+	// Examples of instances, each encoded as JSON like `default`.
+	Examples []string
+	// Declares the instance to be read only: it is ignored in requests.
+	// Setting this to false does not clear a flag derived from the
+	// `OUTPUT_ONLY` field behavior.
+	ReadOnly bool
+	// Declares the instance to be write only: it is never returned in
+	// responses. Setting this to false does not clear a flag derived from the
+	// `INPUT_ONLY` field behavior.
+	WriteOnly bool
+	// Specification extensions of the schema, by name. Each name must start
+	// with "x-".
+	Extensions map[string]*structpb.Value
+	// The fully-qualified names of the message types that a
+	// `google.protobuf.Any` field, or the items of a repeated one, may
+	// contain, e.g. "acme.events.v1.Created". The field is documented as a
+	// `oneOf` over their component schemas, each extended with a constant
+	// `@type`, with a `discriminator` mapping the type URLs to the schemas.
+	// Only allowed on `google.protobuf.Any` fields.
+	AnyTypes []string
+	// Examples of the message, validated against the message descriptor and
+	// appended to `examples`. Only allowed in `openapiv3_schema`.
+	MessageExamples []*Example
+}
+
+func (b0 Schema_builder) Build() *Schema {
+	m0 := &Schema{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Title = b.Title
+	x.Description = b.Description
+	x.Deprecated = b.Deprecated
+	x.Format = b.Format
+	x.Pattern = b.Pattern
+	x.MinLength = b.MinLength
+	x.MaxLength = b.MaxLength
+	x.Minimum = b.Minimum
+	x.Maximum = b.Maximum
+	x.MultipleOf = b.MultipleOf
+	x.MinItems = b.MinItems
+	x.MaxItems = b.MaxItems
+	x.UniqueItems = b.UniqueItems
+	x.Default = b.Default
+	x.Examples = b.Examples
+	x.ReadOnly = b.ReadOnly
+	x.WriteOnly = b.WriteOnly
+	x.Extensions = b.Extensions
+	x.AnyTypes = b.AnyTypes
+	x.MessageExamples = b.MessageExamples
+	return m0
+}
+
+// ExternalDocs is a link to external documentation.
+//
+// Spec: https://spec.openapis.org/oas/v3.1.0#external-documentation-object
+type ExternalDocs struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	// A description of the target documentation. CommonMark syntax MAY be used
+	// for rich text representation.
+	Description string `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	// The URL for the target documentation. This MUST be in the form of a URL.
+	Url           string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExternalDocs) Reset() {
+	*x = ExternalDocs{}
+	mi := &file_protoc_gen_openapiv3_options_openapiv3_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExternalDocs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExternalDocs) ProtoMessage() {}
+
+func (x *ExternalDocs) ProtoReflect() protoreflect.Message {
+	mi := &file_protoc_gen_openapiv3_options_openapiv3_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ExternalDocs) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ExternalDocs) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ExternalDocs) SetDescription(v string) {
+	x.Description = v
+}
+
+func (x *ExternalDocs) SetUrl(v string) {
+	x.Url = v
+}
+
+type ExternalDocs_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// A description of the target documentation. CommonMark syntax MAY be used
+	// for rich text representation.
+	Description string
+	// The URL for the target documentation. This MUST be in the form of a URL.
+	Url string
+}
+
+func (b0 ExternalDocs_builder) Build() *ExternalDocs {
+	m0 := &ExternalDocs{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Description = b.Description
+	x.Url = b.Url
+	return m0
+}
+
+// Tag adds metadata to a single tag that is used by the Operation Object. It
+// is not mandatory to have a Tag Object per tag defined in the Operation
+// Object instances.
+//
+// Spec: https://spec.openapis.org/oas/v3.1.0#tag-object
+type Tag struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	// The name of the tag.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// A description for the tag. CommonMark syntax MAY be used for rich text
+	// representation.
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Additional external documentation for this tag.
+	ExternalDocs *ExternalDocs `protobuf:"bytes,3,opt,name=external_docs,json=externalDocs,proto3" json:"external_docs,omitempty"`
+	// Specification extensions of the tag object, by name. Each name must
+	// start with "x-".
+	Extensions    map[string]*structpb.Value `protobuf:"bytes,4,rep,name=extensions,proto3" json:"extensions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tag) Reset() {
+	*x = Tag{}
+	mi := &file_protoc_gen_openapiv3_options_openapiv3_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tag) ProtoMessage() {}
+
+func (x *Tag) ProtoReflect() protoreflect.Message {
+	mi := &file_protoc_gen_openapiv3_options_openapiv3_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Tag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Tag) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Tag) GetExternalDocs() *ExternalDocs {
+	if x != nil {
+		return x.ExternalDocs
+	}
+	return nil
+}
+
+func (x *Tag) GetExtensions() map[string]*structpb.Value {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+func (x *Tag) SetName(v string) {
+	x.Name = v
+}
+
+func (x *Tag) SetDescription(v string) {
+	x.Description = v
+}
+
+func (x *Tag) SetExternalDocs(v *ExternalDocs) {
+	x.ExternalDocs = v
+}
+
+func (x *Tag) SetExtensions(v map[string]*structpb.Value) {
+	x.Extensions = v
+}
+
+func (x *Tag) HasExternalDocs() bool {
+	if x == nil {
+		return false
+	}
+	return x.ExternalDocs != nil
+}
+
+func (x *Tag) ClearExternalDocs() {
+	x.ExternalDocs = nil
+}
+
+type Tag_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The name of the tag.
+	Name string
+	// A description for the tag. CommonMark syntax MAY be used for rich text
+	// representation.
+	Description string
+	// Additional external documentation for this tag.
+	ExternalDocs *ExternalDocs
+	// Specification extensions of the tag object, by name. Each name must
+	// start with "x-".
+	Extensions map[string]*structpb.Value
+}
+
+func (b0 Tag_builder) Build() *Tag {
+	m0 := &Tag{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Name = b.Name
+	x.Description = b.Description
+	x.ExternalDocs = b.ExternalDocs
+	x.Extensions = b.Extensions
+	return m0
+}
+
+// SecurityScheme defines a security scheme that can be used by the
+// operations.
+//
+// Spec: https://spec.openapis.org/oas/v3.1.0#security-scheme-object
+type SecurityScheme struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	// The type of the security scheme. Required.
+	Type SecurityScheme_Type `protobuf:"varint,1,opt,name=type,proto3,enum=grpc.gateway.protoc_gen_openapiv3.options.SecurityScheme_Type" json:"type,omitempty"`
+	// A description for security scheme. CommonMark syntax MAY be used for
+	// rich text representation.
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// The name of the header, query or cookie parameter to be used. Required
+	// for TYPE_API_KEY.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// The location of the API key. Required for TYPE_API_KEY.
+	In SecurityScheme_In `protobuf:"varint,4,opt,name=in,proto3,enum=grpc.gateway.protoc_gen_openapiv3.options.SecurityScheme_In" json:"in,omitempty"`
+	// The name of the HTTP Authorization scheme to be used in the
+	// Authorization header as defined in RFC 7235, e.g. "basic" or "bearer".
+	// Required for TYPE_HTTP.
+	Scheme string `protobuf:"bytes,5,opt,name=scheme,proto3" json:"scheme,omitempty"`
+	// A hint to the client to identify how the bearer token is formatted,
+	// e.g. "JWT". Only used with the "bearer" HTTP scheme.
+	BearerFormat string `protobuf:"bytes,6,opt,name=bearer_format,json=bearerFormat,proto3" json:"bearer_format,omitempty"`
+	// An object containing configuration information for the flow types
+	// supported. Required for TYPE_OAUTH2.
+	Flows *OAuthFlows `protobuf:"bytes,7,opt,name=flows,proto3" json:"flows,omitempty"`
+	// OpenId Connect URL to discover OAuth2 configuration values. This MUST be
+	// in the form of a URL. Required for TYPE_OPEN_ID_CONNECT.
+	OpenIdConnectUrl string `protobuf:"bytes,8,opt,name=open_id_connect_url,json=openIdConnectUrl,proto3" json:"open_id_connect_url,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SecurityScheme) Reset() {
+	*x = SecurityScheme{}
+	mi := &file_protoc_gen_openapiv3_options_openapiv3_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecurityScheme) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecurityScheme) ProtoMessage() {}
+
+func (x *SecurityScheme) ProtoReflect() protoreflect.Message {
+	mi := &file_protoc_gen_openapiv3_options_openapiv3_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SecurityScheme) GetType() SecurityScheme_Type {
+	if x != nil {
+		return x.Type
+	}
+	return SecurityScheme_TYPE_UNSPECIFIED
+}
+
+func (x *SecurityScheme) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SecurityScheme) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SecurityScheme) GetIn() SecurityScheme_In {
+	if x != nil {
+		return x.In
+	}
+	return SecurityScheme_IN_UNSPECIFIED
+}
+
+func (x *SecurityScheme) GetScheme() string {
+	if x != nil {
+		return x.Scheme
+	}
+	return ""
+}
+
+func (x *SecurityScheme) GetBearerFormat() string {
+	if x != nil {
+		return x.BearerFormat
+	}
+	return ""
+}
+
+func (x *SecurityScheme) GetFlows() *OAuthFlows {
+	if x != nil {
+		return x.Flows
+	}
+	return nil
+}
+
+func (x *SecurityScheme) GetOpenIdConnectUrl() string {
+	if x != nil {
+		return x.OpenIdConnectUrl
+	}
+	return ""
+}
+
+func (x *SecurityScheme) SetType(v SecurityScheme_Type) {
+	x.Type = v
+}
+
+func (x *SecurityScheme) SetDescription(v string) {
+	x.Description = v
+}
+
+func (x *SecurityScheme) SetName(v string) {
+	x.Name = v
+}
+
+func (x *SecurityScheme) SetIn(v SecurityScheme_In) {
+	x.In = v
+}
+
+func (x *SecurityScheme) SetScheme(v string) {
+	x.Scheme = v
+}
+
+func (x *SecurityScheme) SetBearerFormat(v string) {
+	x.BearerFormat = v
+}
+
+func (x *SecurityScheme) SetFlows(v *OAuthFlows) {
+	x.Flows = v
+}
+
+func (x *SecurityScheme) SetOpenIdConnectUrl(v string) {
+	x.OpenIdConnectUrl = v
+}
+
+func (x *SecurityScheme) HasFlows() bool {
+	if x == nil {
+		return false
+	}
+	return x.Flows != nil
+}
+
+func (x *SecurityScheme) ClearFlows() {
+	x.Flows = nil
+}
+
+type SecurityScheme_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The type of the security scheme. Required.
+	Type SecurityScheme_Type
+	// A description for security scheme. CommonMark syntax MAY be used for
+	// rich text representation.
+	Description string
+	// The name of the header, query or cookie parameter to be used. Required
+	// for TYPE_API_KEY.
+	Name string
+	// The location of the API key. Required for TYPE_API_KEY.
+	In SecurityScheme_In
+	// The name of the HTTP Authorization scheme to be used in the
+	// Authorization header as defined in RFC 7235, e.g. "basic" or "bearer".
+	// Required for TYPE_HTTP.
+	Scheme string
+	// A hint to the client to identify how the bearer token is formatted,
+	// e.g. "JWT". Only used with the "bearer" HTTP scheme.
+	BearerFormat string
+	// An object containing configuration information for the flow types
+	// supported. Required for TYPE_OAUTH2.
+	Flows *OAuthFlows
+	// OpenId Connect URL to discover OAuth2 configuration values. This MUST be
+	// in the form of a URL. Required for TYPE_OPEN_ID_CONNECT.
+	OpenIdConnectUrl string
+}
+
+func (b0 SecurityScheme_builder) Build() *SecurityScheme {
+	m0 := &SecurityScheme{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Type = b.Type
+	x.Description = b.Description
+	x.Name = b.Name
+	x.In = b.In
+	x.Scheme = b.Scheme
+	x.BearerFormat = b.BearerFormat
+	x.Flows = b.Flows
+	x.OpenIdConnectUrl = b.OpenIdConnectUrl
+	return m0
+}
+
+// OAuthFlows allows configuration of the supported OAuth Flows. At least one
+// flow must be set.
+//
+// Spec: https://spec.openapis.org/oas/v3.1.0#oauth-flows-object
+type OAuthFlows struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	// Configuration for the OAuth Implicit flow.
+	Implicit *OAuthFlow `protobuf:"bytes,1,opt,name=implicit,proto3" json:"implicit,omitempty"`
+	// Configuration for the OAuth Resource Owner Password flow.
+	Password *OAuthFlow `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	// Configuration for the OAuth Client Credentials flow.
+	ClientCredentials *OAuthFlow `protobuf:"bytes,3,opt,name=client_credentials,json=clientCredentials,proto3" json:"client_credentials,omitempty"`
+	// Configuration for the OAuth Authorization Code flow.
+	AuthorizationCode *OAuthFlow `protobuf:"bytes,4,opt,name=authorization_code,json=authorizationCode,proto3" json:"authorization_code,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *OAuthFlows) Reset() {
+	*x = OAuthFlows{}
+	mi := &file_protoc_gen_openapiv3_options_openapiv3_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OAuthFlows) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OAuthFlows) ProtoMessage() {}
+
+func (x *OAuthFlows) ProtoReflect() protoreflect.Message {
+	mi := &file_protoc_gen_openapiv3_options_openapiv3_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *OAuthFlows) GetImplicit() *OAuthFlow {
+	if x != nil {
+		return x.Implicit
 	}
 	return nil
 }
 
-func (x *Tag) SetName(v string) {
-	x.Name = v
+func (x *OAuthFlows) GetPassword() *OAuthFlow {
+	if x != nil {
+		return x.Password
+	}
+	return nil
 }
 
-func (x *Tag) SetDescription(v string) {
-	x.Description = v
+func (x *OAuthFlows) GetClientCredentials() *OAuthFlow {
+	if x != nil {
+		return x.ClientCredentials
+	}
+	return nil
 }
 
-func (x *Tag) SetExternalDocs(v *ExternalDocs) {
-	x.ExternalDocs = v
+func (x *OAuthFlows) GetAuthorizationCode() *OAuthFlow {
+	if x != nil {
+		return x.AuthorizationCode
+	}
+	return nil
 }
 
-func (x *Tag) HasExternalDocs() bool {
+func (x *OAuthFlows) SetImplicit(v *OAuthFlow) {
+	x.Implicit = v
+}
+
+func (x *OAuthFlows) SetPassword(v *OAuthFlow) {
+	x.Password = v
+}
+
+func (x *OAuthFlows) SetClientCredentials(v *OAuthFlow) {
+	x.ClientCredentials = v
+}
+
+func (x *OAuthFlows) SetAuthorizationCode(v *OAuthFlow) {
+	x.AuthorizationCode = v
+}
+
+func (x *OAuthFlows) HasImplicit() bool {
 	if x == nil {
 		return false
 	}
-	return x.ExternalDocs != nil
+	return x.Implicit != nil
 }
 
-func (x *Tag) ClearExternalDocs() {
-	x.ExternalDocs = nil
+func (x *OAuthFlows) HasPassword() bool {
+	if x == nil {
+		return false
+	}
+	return x.Password != nil
 }
 
-type Tag_builder struct {
+func (x *OAuthFlows) HasClientCredentials() bool {
+	if x == nil {
+		return false
+	}
+	return x.ClientCredentials != nil
+}
+
+func (x *OAuthFlows) HasAuthorizationCode() bool {
+	if x == nil {
+		return false
+	}
+	return x.AuthorizationCode != nil
+}
+
+func (x *OAuthFlows) ClearImplicit() {
+	x.Implicit = nil
+}
+
+func (x *OAuthFlows) ClearPassword() {
+	x.Password = nil
+}
+
+func (x *OAuthFlows) ClearClientCredentials() {
+	x.ClientCredentials = nil
+}
+
+func (x *OAuthFlows) ClearAuthorizationCode() {
+	x.AuthorizationCode = nil
+}
+
+type OAuthFlows_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	// The name of the tag.
-	Name string
-	// A description for the tag. CommonMark syntax MAY be used for rich text
-	// representation.
-	Description string
-	// Additional external documentation for this tag.
-	ExternalDocs *ExternalDocs
+	// Configuration for the OAuth Implicit flow.
+	Implicit *OAuthFlow
+	// Configuration for the OAuth Resource Owner Password flow.
+	Password *OAuthFlow
+	// Configuration for the OAuth Client Credentials flow.
+	ClientCredentials *OAuthFlow
+	// Configuration for the OAuth Authorization Code flow.
+	AuthorizationCode *OAuthFlow
 }
 
-func (b0 Tag_builder) Build() *Tag {
-	m0 := &Tag{}
+func (b0 OAuthFlows_builder) Build() *OAuthFlows {
+	m0 := &OAuthFlows{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.Name = b.Name
-	x.Description = b.Description
-	x.ExternalDocs = b.ExternalDocs
+	x.Implicit = b.Implicit
+	x.Password = b.Password
+	x.ClientCredentials = b.ClientCredentials
+	x.AuthorizationCode = b.AuthorizationCode
+	return m0
+}
+
+// OAuthFlow is the configuration details for a supported OAuth Flow.
+//
+// Spec: https://spec.openapis.org/oas/v3.1.0#oauth-flow-object
+type OAuthFlow struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	// The authorization URL to be used for this flow. Required for the
+	// implicit and authorization_code flows.
+	AuthorizationUrl string `protobuf:"bytes,1,opt,name=authorization_url,json=authorizationUrl,proto3" json:"authorization_url,omitempty"`
+	// The token URL to be used for this flow. Required for the password,
+	// client_credentials and authorization_code flows.
+	TokenUrl string `protobuf:"bytes,2,opt,name=token_url,json=tokenUrl,proto3" json:"token_url,omitempty"`
+	// The URL to be used for obtaining refresh tokens.
+	RefreshUrl string `protobuf:"bytes,3,opt,name=refresh_url,json=refreshUrl,proto3" json:"refresh_url,omitempty"`
+	// The available scopes for the OAuth2 security scheme. A map between the
+	// scope name and a short description for it.
+	Scopes        map[string]string `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OAuthFlow) Reset() {
+	*x = OAuthFlow{}
+	mi := &file_protoc_gen_openapiv3_options_openapiv3_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OAuthFlow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OAuthFlow) ProtoMessage() {}
+
+func (x *OAuthFlow) ProtoReflect() protoreflect.Message {
+	mi := &file_protoc_gen_openapiv3_options_openapiv3_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *OAuthFlow) GetAuthorizationUrl() string {
+	if x != nil {
+		return x.AuthorizationUrl
+	}
+	return ""
+}
+
+func (x *OAuthFlow) GetTokenUrl() string {
+	if x != nil {
+		return x.TokenUrl
+	}
+	return ""
+}
+
+func (x *OAuthFlow) GetRefreshUrl() string {
+	if x != nil {
+		return x.RefreshUrl
+	}
+	return ""
+}
+
+func (x *OAuthFlow) GetScopes() map[string]string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *OAuthFlow) SetAuthorizationUrl(v string) {
+	x.AuthorizationUrl = v
+}
+
+func (x *OAuthFlow) SetTokenUrl(v string) {
+	x.TokenUrl = v
+}
+
+func (x *OAuthFlow) SetRefreshUrl(v string) {
+	x.RefreshUrl = v
+}
+
+func (x *OAuthFlow) SetScopes(v map[string]string) {
+	x.Scopes = v
+}
+
+type OAuthFlow_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// The authorization URL to be used for this flow. Required for the
+	// implicit and authorization_code flows.
+	AuthorizationUrl string
+	// The token URL to be used for this flow. Required for the password,
+	// client_credentials and authorization_code flows.
+	TokenUrl string
+	// The URL to be used for obtaining refresh tokens.
+	RefreshUrl string
+	// The available scopes for the OAuth2 security scheme. A map between the
+	// scope name and a short description for it.
+	Scopes map[string]string
+}
+
+func (b0 OAuthFlow_builder) Build() *OAuthFlow {
+	m0 := &OAuthFlow{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.AuthorizationUrl = b.AuthorizationUrl
+	x.TokenUrl = b.TokenUrl
+	x.RefreshUrl = b.RefreshUrl
+	x.Scopes = b.Scopes
+	return m0
+}
+
+// SecurityRequirement lists the required security schemes to execute an
+// operation. All the schemes of a requirement must be satisfied to authorize
+// a request. An empty requirement makes the security optional.
+//
+// Spec: https://spec.openapis.org/oas/v3.1.0#security-requirement-object
+type SecurityRequirement struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	// Each name must correspond to a security scheme declared in the
+	// `security_schemes` of the document or derived from an authentication
+	// provider of the gRPC API Configuration.
+	SecurityRequirement map[string]*SecurityRequirement_SecurityRequirementValue `protobuf:"bytes,1,rep,name=security_requirement,json=securityRequirement,proto3" json:"security_requirement,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *SecurityRequirement) Reset() {
+	*x = SecurityRequirement{}
+	mi := &file_protoc_gen_openapiv3_options_openapiv3_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecurityRequirement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecurityRequirement) ProtoMessage() {}
+
+func (x *SecurityRequirement) ProtoReflect() protoreflect.Message {
+	mi := &file_protoc_gen_openapiv3_options_openapiv3_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SecurityRequirement) GetSecurityRequirement() map[string]*SecurityRequirement_SecurityRequirementValue {
+	if x != nil {
+		return x.SecurityRequirement
+	}
+	return nil
+}
+
+func (x *SecurityRequirement) SetSecurityRequirement(v map[string]*SecurityRequirement_SecurityRequirementValue) {
+	x.SecurityRequirement = v
+}
+
+type SecurityRequirement_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Each name must correspond to a security scheme declared in the
+	// `security_schemes` of the document or derived from an authentication
+	// provider of the gRPC API Configuration.
+	SecurityRequirement map[string]*SecurityRequirement_SecurityRequirementValue
+}
+
+func (b0 SecurityRequirement_builder) Build() *SecurityRequirement {
+	m0 := &SecurityRequirement{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.SecurityRequirement = b.SecurityRequirement
+	return m0
+}
+
+// The scopes required by a security scheme.
+type SecurityRequirement_SecurityRequirementValue struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	// If the security scheme is of type TYPE_OAUTH2 or TYPE_OPEN_ID_CONNECT,
+	// the names of the scopes required for the execution. OAuth2 scopes must
+	// be declared by a flow of the scheme. For other security scheme types,
+	// the names of the roles required for the execution.
+	Scope         []string `protobuf:"bytes,1,rep,name=scope,proto3" json:"scope,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecurityRequirement_SecurityRequirementValue) Reset() {
+	*x = SecurityRequirement_SecurityRequirementValue{}
+	mi := &file_protoc_gen_openapiv3_options_openapiv3_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecurityRequirement_SecurityRequirementValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecurityRequirement_SecurityRequirementValue) ProtoMessage() {}
+
+func (x *SecurityRequirement_SecurityRequirementValue) ProtoReflect() protoreflect.Message {
+	mi := &file_protoc_gen_openapiv3_options_openapiv3_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SecurityRequirement_SecurityRequirementValue) GetScope() []string {
+	if x != nil {
+		return x.Scope
+	}
+	return nil
+}
+
+func (x *SecurityRequirement_SecurityRequirementValue) SetScope(v []string) {
+	x.Scope = v
+}
+
+type SecurityRequirement_SecurityRequirementValue_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// If the security scheme is of type TYPE_OAUTH2 or TYPE_OPEN_ID_CONNECT,
+	// the names of the scopes required for the execution. OAuth2 scopes must
+	// be declared by a flow of the scheme. For other security scheme types,
+	// the names of the roles required for the execution.
+	Scope []string
+}
+
+func (b0 SecurityRequirement_SecurityRequirementValue_builder) Build() *SecurityRequirement_SecurityRequirementValue {
+	m0 := &SecurityRequirement_SecurityRequirementValue{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Scope = b.Scope
 	return m0
 }
 
@@ -1246,136 +3274,536 @@ var file_protoc_gen_openapiv3_options_openapiv3_proto_rawDesc = []byte{
 	0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x33, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x29,
 	0x67, 0x72, 0x70, 0x63, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x63, 0x5f, 0x67, 0x65, 0x6e, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76,
-	0x33, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xbe, 0x02, 0x0a, 0x08, 0x44, 0x6f,
-	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x43, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x67, 0x61, 0x74, 0x65,
-	0x77, 0x61, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x5f, 0x67, 0x65, 0x6e, 0x5f, 0x6f,
-	0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x33, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x2e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x4b, 0x0a, 0x07, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x67,
-	0x72, 0x70, 0x63, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x63, 0x5f, 0x67, 0x65, 0x6e, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x33,
-	0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52,
-	0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x12, 0x5c, 0x0a, 0x0d, 0x65, 0x78, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x64, 0x6f, 0x63, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x37, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x70,
+	0x33, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xfa, 0x06, 0x0a, 0x08, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x43, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x5f, 0x67, 0x65, 0x6e, 0x5f, 0x6f, 0x70, 0x65,
+	0x6e, 0x61, 0x70, 0x69, 0x76, 0x33, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x4b, 0x0a, 0x07, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x5f, 0x67, 0x65, 0x6e, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x33, 0x2e, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x07, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x12, 0x5c, 0x0a, 0x0d, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x5f, 0x64, 0x6f, 0x63, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x5f, 0x67, 0x65, 0x6e, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76,
+	0x33, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x44, 0x6f, 0x63, 0x73, 0x52, 0x0c, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x44, 0x6f, 0x63, 0x73, 0x12, 0x42, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x5f, 0x67, 0x65, 0x6e, 0x5f, 0x6f, 0x70, 0x65,
+	0x6e, 0x61, 0x70, 0x69, 0x76, 0x33, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x54,
+	0x61, 0x67, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x73, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x48, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x5f, 0x67, 0x65, 0x6e, 0x5f, 0x6f, 0x70, 0x65,
+	0x6e, 0x61, 0x70, 0x69, 0x76, 0x33, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x73, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x73, 0x12, 0x5a, 0x0a,
+	0x08, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x3e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x5f, 0x67, 0x65, 0x6e, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70,
-	0x69, 0x76, 0x33, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x45, 0x78, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x44, 0x6f, 0x63, 0x73, 0x52, 0x0c, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x44, 0x6f, 0x63, 0x73, 0x12, 0x42, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x04,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x67, 0x61, 0x74, 0x65,
+	0x69, 0x76, 0x33, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x08, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x12, 0x63, 0x0a, 0x0a, 0x65, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x43, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x5f, 0x67, 0x65, 0x6e, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76,
+	0x33, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2e,
+	0x0a, 0x13, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x73, 0x1a, 0x7d,
+	0x0a, 0x14, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x4f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x67,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x5f, 0x67, 0x65,
+	0x6e, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x33, 0x2e, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x55, 0x0a,
+	0x0f, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0xf0, 0x03, 0x0a, 0x04, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x28, 0x0a, 0x10, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x5f, 0x6f, 0x66, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x72, 0x6d, 0x73,
+	0x4f, 0x66, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4c, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x5f, 0x67, 0x65, 0x6e, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x33, 0x2e, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x4c, 0x0a, 0x07, 0x6c, 0x69, 0x63, 0x65, 0x6e,
+	0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x5f, 0x67,
+	0x65, 0x6e, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x33, 0x2e, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x52, 0x07, 0x6c, 0x69,
+	0x63, 0x65, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x5f, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x5f, 0x67, 0x65, 0x6e, 0x5f, 0x6f, 0x70,
+	0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x33, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x1a, 0x55, 0x0a, 0x0f, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x45, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x5d,
+	0x0a, 0x07, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a,
+	0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12,
+	0x12, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03,
+	0x75, 0x72, 0x6c, 0x42, 0x08, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0xf6, 0x01,
+	0x0a, 0x06, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x61, 0x0a, 0x0a,
+	0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x41, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x5f, 0x67, 0x65, 0x6e, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x61,
+	0x70, 0x69, 0x76, 0x33, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x1a,
+	0x55, 0x0a, 0x0f, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x99, 0x08, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5c, 0x0a, 0x0d, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x5f, 0x64, 0x6f, 0x63, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x5f, 0x67, 0x65, 0x6e, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x33, 0x2e,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x44, 0x6f, 0x63, 0x73, 0x52, 0x0c, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x6f,
+	0x63, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61,
+	0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65,
+	0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x4b, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x67, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x5f, 0x67, 0x65, 0x6e,
+	0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x33, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x73, 0x12, 0x5a, 0x0a, 0x08, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x67, 0x61, 0x74, 0x65,
 	0x77, 0x61, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x5f, 0x67, 0x65, 0x6e, 0x5f, 0x6f,
 	0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x33, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x2e, 0x54, 0x61, 0x67, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0xb8, 0x02, 0x0a, 0x04, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x6d,
-	0x6d, 0x61, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d,
-	0x61, 0x72, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x5f, 0x6f,
-	0x66, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0e, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x4f, 0x66, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x4c, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x32, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e,
+	0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x12, 0x61,
+	0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x43, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x5f, 0x67, 0x65, 0x6e, 0x5f, 0x6f, 0x70, 0x65, 0x6e,
+	0x61, 0x70, 0x69, 0x76, 0x33, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x73, 0x12, 0x64, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x44, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x67, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x5f, 0x67, 0x65, 0x6e, 0x5f,
+	0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x33, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x45, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x65, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x5d, 0x0a, 0x10, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x32, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x5f, 0x67, 0x65, 0x6e, 0x5f, 0x6f, 0x70, 0x65, 0x6e,
+	0x61, 0x70, 0x69, 0x76, 0x33, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x45, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x5f, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x32, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x5f, 0x67, 0x65, 0x6e, 0x5f, 0x6f, 0x70, 0x65, 0x6e,
+	0x61, 0x70, 0x69, 0x76, 0x33, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x45, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x10, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x6f, 0x5f, 0x73, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6e, 0x6f,
+	0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x1a, 0x71, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x49, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x5f, 0x67, 0x65, 0x6e, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x33, 0x2e,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x55, 0x0a, 0x0f, 0x45,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0xda, 0x02, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5a,
+	0x0a, 0x08, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x3e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x5f, 0x67, 0x65, 0x6e, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x61,
-	0x70, 0x69, 0x76, 0x33, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x43, 0x6f, 0x6e,
-	0x74, 0x61, 0x63, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x4c, 0x0a,
-	0x07, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32,
+	0x70, 0x69, 0x76, 0x33, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x08, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x12, 0x5f, 0x0a, 0x09, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x41, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x5f, 0x67, 0x65, 0x6e, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76,
+	0x33, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e,
+	0x6f, 0x5f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0a, 0x6e, 0x6f, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x1a, 0x71, 0x0a, 0x0e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x49, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x33, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x5f, 0x67, 0x65, 0x6e, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70,
+	0x69, 0x76, 0x33, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x86, 0x03, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x5a, 0x0a,
+	0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x40,
 	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x63, 0x5f, 0x67, 0x65, 0x6e, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69,
-	0x76, 0x33, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4c, 0x69, 0x63, 0x65, 0x6e,
-	0x73, 0x65, 0x52, 0x07, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x45, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x5d, 0x0a, 0x07,
-	0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0a, 0x69,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x00, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x12, 0x0a,
-	0x03, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x75, 0x72,
-	0x6c, 0x42, 0x08, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x3c, 0x0a, 0x06, 0x53,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc9, 0x02, 0x0a, 0x09, 0x4f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73,
-	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75,
-	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5c, 0x0a, 0x0d, 0x65, 0x78, 0x74, 0x65, 0x72,
-	0x6e, 0x61, 0x6c, 0x5f, 0x64, 0x6f, 0x63, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37,
+	0x76, 0x33, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x4e, 0x0a, 0x08, 0x65, 0x78, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x5f, 0x67, 0x65, 0x6e, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x33, 0x2e,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52,
+	0x08, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x1a, 0x6d, 0x0a, 0x0c, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x47, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x5f, 0x67, 0x65, 0x6e, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x33, 0x2e, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xae, 0x01, 0x0a, 0x07, 0x45, 0x78, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x09, 0x74, 0x65,
+	0x78, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x09, 0x74, 0x65, 0x78, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x14, 0x0a, 0x04, 0x66, 0x69,
+	0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65,
+	0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x72, 0x0a, 0x06, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0xaf, 0x07,
+	0x0a, 0x06, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74,
+	0x65, 0x72, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65,
+	0x72, 0x6e, 0x12, 0x22, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x09, 0x6d, 0x61,
+	0x78, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x69,
+	0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x48, 0x02, 0x52, 0x07, 0x6d,
+	0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x61, 0x78,
+	0x69, 0x6d, 0x75, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x48, 0x03, 0x52, 0x07, 0x6d, 0x61,
+	0x78, 0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x70, 0x6c, 0x65, 0x5f, 0x6f, 0x66, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x48, 0x04, 0x52,
+	0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x4f, 0x66, 0x88, 0x01, 0x01, 0x12, 0x20,
+	0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x04, 0x48, 0x05, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x88, 0x01, 0x01,
+	0x12, 0x20, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x04, 0x48, 0x06, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x88,
+	0x01, 0x01, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65,
+	0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x72,
+	0x65, 0x61, 0x64, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x72, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x72, 0x69, 0x74,
+	0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x77, 0x72,
+	0x69, 0x74, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x61, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x5f, 0x67, 0x65, 0x6e, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x33, 0x2e,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x45,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a,
+	0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6e,
+	0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x61,
+	0x6e, 0x79, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x5d, 0x0a, 0x10, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x32, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x5f, 0x67, 0x65, 0x6e, 0x5f, 0x6f, 0x70, 0x65, 0x6e,
+	0x61, 0x70, 0x69, 0x76, 0x33, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x45, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x0f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x45, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x1a, 0x55, 0x0a, 0x0f, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0d, 0x0a,
+	0x0b, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x42, 0x0d, 0x0a, 0x0b,
+	0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
+	0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x61, 0x78, 0x69,
+	0x6d, 0x75, 0x6d, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65,
+	0x5f, 0x6f, 0x66, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22,
+	0x42, 0x0a, 0x0c, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x6f, 0x63, 0x73, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x72, 0x6c, 0x22, 0xd0, 0x02, 0x0a, 0x03, 0x54, 0x61, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x5c, 0x0a, 0x0d, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x64, 0x6f,
+	0x63, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x5f, 0x67,
+	0x65, 0x6e, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x33, 0x2e, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x6f, 0x63,
+	0x73, 0x52, 0x0c, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x6f, 0x63, 0x73, 0x12,
+	0x5e, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x5f, 0x67, 0x65, 0x6e, 0x5f, 0x6f, 0x70,
+	0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x33, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x54, 0x61, 0x67, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x1a,
+	0x55, 0x0a, 0x0f, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xe6, 0x04, 0x0a, 0x0e, 0x53, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x52, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x67,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x5f, 0x67, 0x65,
+	0x6e, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x33, 0x2e, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x65, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x4c, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x3c, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x5f, 0x67, 0x65, 0x6e, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70,
+	0x69, 0x76, 0x33, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x2e, 0x49, 0x6e, 0x52, 0x02, 0x69,
+	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x65, 0x61,
+	0x72, 0x65, 0x72, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x62, 0x65, 0x61, 0x72, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x4b,
+	0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x5f, 0x67, 0x65, 0x6e, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76,
+	0x33, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x46,
+	0x6c, 0x6f, 0x77, 0x73, 0x52, 0x05, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x12, 0x2d, 0x0a, 0x13, 0x6f,
+	0x70, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5f, 0x75,
+	0x72, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6f, 0x70, 0x65, 0x6e, 0x49, 0x64,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x55, 0x72, 0x6c, 0x22, 0x7d, 0x0a, 0x04, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x41, 0x50, 0x49, 0x5f, 0x4b, 0x45, 0x59, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x48, 0x54, 0x54, 0x50, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x4d, 0x55, 0x54, 0x55, 0x41, 0x4c, 0x5f, 0x54, 0x4c, 0x53, 0x10, 0x03, 0x12, 0x0f,
+	0x0a, 0x0b, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x41, 0x55, 0x54, 0x48, 0x32, 0x10, 0x04, 0x12,
+	0x18, 0x0a, 0x14, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x5f, 0x49, 0x44, 0x5f,
+	0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x10, 0x05, 0x22, 0x44, 0x0a, 0x02, 0x49, 0x6e, 0x12,
+	0x12, 0x0a, 0x0e, 0x49, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x4e, 0x5f, 0x51, 0x55, 0x45, 0x52, 0x59, 0x10,
+	0x01, 0x12, 0x0d, 0x0a, 0x09, 0x49, 0x4e, 0x5f, 0x48, 0x45, 0x41, 0x44, 0x45, 0x52, 0x10, 0x02,
+	0x12, 0x0d, 0x0a, 0x09, 0x49, 0x4e, 0x5f, 0x43, 0x4f, 0x4f, 0x4b, 0x49, 0x45, 0x10, 0x03, 0x22,
+	0xfa, 0x02, 0x0a, 0x0a, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x46, 0x6c, 0x6f, 0x77, 0x73, 0x12, 0x50,
+	0x0a, 0x08, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x34, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x5f, 0x67, 0x65, 0x6e, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x61,
+	0x70, 0x69, 0x76, 0x33, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4f, 0x41, 0x75,
+	0x74, 0x68, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x08, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74,
+	0x12, 0x50, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x34, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x5f, 0x67, 0x65, 0x6e, 0x5f, 0x6f, 0x70, 0x65,
+	0x6e, 0x61, 0x70, 0x69, 0x76, 0x33, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4f,
+	0x41, 0x75, 0x74, 0x68, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x12, 0x63, 0x0a, 0x12, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x72, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34,
 	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x63, 0x5f, 0x67, 0x65, 0x6e, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69,
-	0x76, 0x33, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72,
-	0x6e, 0x61, 0x6c, 0x44, 0x6f, 0x63, 0x73, 0x52, 0x0c, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x44, 0x6f, 0x63, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72,
-	0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65,
-	0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x4b, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x67, 0x72, 0x70, 0x63,
-	0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x5f,
-	0x67, 0x65, 0x6e, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x33, 0x2e, 0x6f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x07, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x73, 0x22, 0x60, 0x0a, 0x06, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12,
-	0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65,
-	0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x70,
-	0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x22, 0x42, 0x0a, 0x0c, 0x45, 0x78, 0x74, 0x65, 0x72,
-	0x6e, 0x61, 0x6c, 0x44, 0x6f, 0x63, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x99, 0x01, 0x0a, 0x03,
-	0x54, 0x61, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5c, 0x0a, 0x0d, 0x65, 0x78, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x64, 0x6f, 0x63, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x37, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x5f, 0x67, 0x65, 0x6e, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x61,
-	0x70, 0x69, 0x76, 0x33, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x45, 0x78, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x6f, 0x63, 0x73, 0x52, 0x0c, 0x65, 0x78, 0x74, 0x65, 0x72,
-	0x6e, 0x61, 0x6c, 0x44, 0x6f, 0x63, 0x73, 0x42, 0x48, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2d, 0x65, 0x63, 0x6f, 0x73, 0x79,
-	0x73, 0x74, 0x65, 0x6d, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2d, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
-	0x79, 0x2f, 0x76, 0x32, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d,
-	0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x33, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var file_protoc_gen_openapiv3_options_openapiv3_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+	0x76, 0x33, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4f, 0x41, 0x75, 0x74, 0x68,
+	0x46, 0x6c, 0x6f, 0x77, 0x52, 0x11, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x63, 0x0a, 0x12, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x5f, 0x67, 0x65, 0x6e, 0x5f, 0x6f, 0x70,
+	0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x33, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x4f, 0x41, 0x75, 0x74, 0x68, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x11, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x8b, 0x02, 0x0a,
+	0x09, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x46, 0x6c, 0x6f, 0x77, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x55, 0x72, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x55, 0x72, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f,
+	0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x55, 0x72, 0x6c, 0x12, 0x58, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x67, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x5f, 0x67, 0x65, 0x6e, 0x5f,
+	0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x33, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x46, 0x6c, 0x6f, 0x77, 0x2e, 0x53, 0x63, 0x6f, 0x70,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x1a,
+	0x39, 0x0a, 0x0b, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xf6, 0x02, 0x0a, 0x13, 0x53,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x8a, 0x01, 0x0a, 0x14, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x57, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x5f, 0x67, 0x65, 0x6e, 0x5f, 0x6f, 0x70, 0x65, 0x6e,
+	0x61, 0x70, 0x69, 0x76, 0x33, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x13, 0x73, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x1a,
+	0x30, 0x0a, 0x18, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x63, 0x6f, 0x70, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70,
+	0x65, 0x1a, 0x9f, 0x01, 0x0a, 0x18, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x6d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x57, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x5f, 0x67, 0x65, 0x6e, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70,
+	0x69, 0x76, 0x33, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x42, 0x48, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2d, 0x65, 0x63, 0x6f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x2f, 0x67, 0x72, 0x70, 0x63, 0x2d, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2f, 0x76, 0x32,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e,
+	0x61, 0x70, 0x69, 0x76, 0x33, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var file_protoc_gen_openapiv3_options_openapiv3_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_protoc_gen_openapiv3_options_openapiv3_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_protoc_gen_openapiv3_options_openapiv3_proto_goTypes = []any{
-	(*Document)(nil),     // 0: grpc.gateway.protoc_gen_openapiv3.options.Document
-	(*Info)(nil),         // 1: grpc.gateway.protoc_gen_openapiv3.options.Info
-	(*Contact)(nil),      // 2: grpc.gateway.protoc_gen_openapiv3.options.Contact
-	(*License)(nil),      // 3: grpc.gateway.protoc_gen_openapiv3.options.License
-	(*Server)(nil),       // 4: grpc.gateway.protoc_gen_openapiv3.options.Server
-	(*Operation)(nil),    // 5: grpc.gateway.protoc_gen_openapiv3.options.Operation
-	(*Schema)(nil),       // 6: grpc.gateway.protoc_gen_openapiv3.options.Schema
-	(*ExternalDocs)(nil), // 7: grpc.gateway.protoc_gen_openapiv3.options.ExternalDocs
-	(*Tag)(nil),          // 8: grpc.gateway.protoc_gen_openapiv3.options.Tag
+	(SecurityScheme_Type)(0),    // 0: grpc.gateway.protoc_gen_openapiv3.options.SecurityScheme.Type
+	(SecurityScheme_In)(0),      // 1: grpc.gateway.protoc_gen_openapiv3.options.SecurityScheme.In
+	(*Document)(nil),            // 2: grpc.gateway.protoc_gen_openapiv3.options.Document
+	(*Info)(nil),                // 3: grpc.gateway.protoc_gen_openapiv3.options.Info
+	(*Contact)(nil),             // 4: grpc.gateway.protoc_gen_openapiv3.options.Contact
+	(*License)(nil),             // 5: grpc.gateway.protoc_gen_openapiv3.options.License
+	(*Server)(nil),              // 6: grpc.gateway.protoc_gen_openapiv3.options.Server
+	(*Operation)(nil),           // 7: grpc.gateway.protoc_gen_openapiv3.options.Operation
+	(*Service)(nil),             // 8: grpc.gateway.protoc_gen_openapiv3.options.Service
+	(*Response)(nil),            // 9: grpc.gateway.protoc_gen_openapiv3.options.Response
+	(*Example)(nil),             // 10: grpc.gateway.protoc_gen_openapiv3.options.Example
+	(*Header)(nil),              // 11: grpc.gateway.protoc_gen_openapiv3.options.Header
+	(*Schema)(nil),              // 12: grpc.gateway.protoc_gen_openapiv3.options.Schema
+	(*ExternalDocs)(nil),        // 13: grpc.gateway.protoc_gen_openapiv3.options.ExternalDocs
+	(*Tag)(nil),                 // 14: grpc.gateway.protoc_gen_openapiv3.options.Tag
+	(*SecurityScheme)(nil),      // 15: grpc.gateway.protoc_gen_openapiv3.options.SecurityScheme
+	(*OAuthFlows)(nil),          // 16: grpc.gateway.protoc_gen_openapiv3.options.OAuthFlows
+	(*OAuthFlow)(nil),           // 17: grpc.gateway.protoc_gen_openapiv3.options.OAuthFlow
+	(*SecurityRequirement)(nil), // 18: grpc.gateway.protoc_gen_openapiv3.options.SecurityRequirement
+	nil,                         // 19: grpc.gateway.protoc_gen_openapiv3.options.Document.SecuritySchemesEntry
+	nil,                         // 20: grpc.gateway.protoc_gen_openapiv3.options.Document.ExtensionsEntry
+	nil,                         // 21: grpc.gateway.protoc_gen_openapiv3.options.Info.ExtensionsEntry
+	nil,                         // 22: grpc.gateway.protoc_gen_openapiv3.options.Server.ExtensionsEntry
+	nil,                         // 23: grpc.gateway.protoc_gen_openapiv3.options.Operation.ResponsesEntry
+	nil,                         // 24: grpc.gateway.protoc_gen_openapiv3.options.Operation.ExtensionsEntry
+	nil,                         // 25: grpc.gateway.protoc_gen_openapiv3.options.Service.ResponsesEntry
+	nil,                         // 26: grpc.gateway.protoc_gen_openapiv3.options.Response.HeadersEntry
+	nil,                         // 27: grpc.gateway.protoc_gen_openapiv3.options.Schema.ExtensionsEntry
+	nil,                         // 28: grpc.gateway.protoc_gen_openapiv3.options.Tag.ExtensionsEntry
+	nil,                         // 29: grpc.gateway.protoc_gen_openapiv3.options.OAuthFlow.ScopesEntry
+	(*SecurityRequirement_SecurityRequirementValue)(nil), // 30: grpc.gateway.protoc_gen_openapiv3.options.SecurityRequirement.SecurityRequirementValue
+	nil,                    // 31: grpc.gateway.protoc_gen_openapiv3.options.SecurityRequirement.SecurityRequirementEntry
+	(*structpb.Value)(nil), // 32: google.protobuf.Value
 }
 var file_protoc_gen_openapiv3_options_openapiv3_proto_depIdxs = []int32{
-	1, // 0: grpc.gateway.protoc_gen_openapiv3.options.Document.info:type_name -> grpc.gateway.protoc_gen_openapiv3.options.Info
-	4, // 1: grpc.gateway.protoc_gen_openapiv3.options.Document.servers:type_name -> grpc.gateway.protoc_gen_openapiv3.options.Server
-	7, // 2: grpc.gateway.protoc_gen_openapiv3.options.Document.external_docs:type_name -> grpc.gateway.protoc_gen_openapiv3.options.ExternalDocs
-	8, // 3: grpc.gateway.protoc_gen_openapiv3.options.Document.tags:type_name -> grpc.gateway.protoc_gen_openapiv3.options.Tag
-	2, // 4: grpc.gateway.protoc_gen_openapiv3.options.Info.contact:type_name -> grpc.gateway.protoc_gen_openapiv3.options.Contact
-	3, // 5: grpc.gateway.protoc_gen_openapiv3.options.Info.license:type_name -> grpc.gateway.protoc_gen_openapiv3.options.License
-	7, // 6: grpc.gateway.protoc_gen_openapiv3.options.Operation.external_docs:type_name -> grpc.gateway.protoc_gen_openapiv3.options.ExternalDocs
-	4, // 7: grpc.gateway.protoc_gen_openapiv3.options.Operation.servers:type_name -> grpc.gateway.protoc_gen_openapiv3.options.Server
-	7, // 8: grpc.gateway.protoc_gen_openapiv3.options.Tag.external_docs:type_name -> grpc.gateway.protoc_gen_openapiv3.options.ExternalDocs
-	9, // [9:9] is the sub-list for method output_type
-	9, // [9:9] is the sub-list for method input_type
-	9, // [9:9] is the sub-list for extension type_name
-	9, // [9:9] is the sub-list for extension extendee
-	0, // [0:9] is the sub-list for field type_name
+	3,  // 0: grpc.gateway.protoc_gen_openapiv3.options.Document.info:type_name -> grpc.gateway.protoc_gen_openapiv3.options.Info
+	6,  // 1: grpc.gateway.protoc_gen_openapiv3.options.Document.servers:type_name -> grpc.gateway.protoc_gen_openapiv3.options.Server
+	13, // 2: grpc.gateway.protoc_gen_openapiv3.options.Document.external_docs:type_name -> grpc.gateway.protoc_gen_openapiv3.options.ExternalDocs
+	14, // 3: grpc.gateway.protoc_gen_openapiv3.options.Document.tags:type_name -> grpc.gateway.protoc_gen_openapiv3.options.Tag
+	19, // 4: grpc.gateway.protoc_gen_openapiv3.options.Document.security_schemes:type_name -> grpc.gateway.protoc_gen_openapiv3.options.Document.SecuritySchemesEntry
+	18, // 5: grpc.gateway.protoc_gen_openapiv3.options.Document.security:type_name -> grpc.gateway.protoc_gen_openapiv3.options.SecurityRequirement
+	20, // 6: grpc.gateway.protoc_gen_openapiv3.options.Document.extensions:type_name -> grpc.gateway.protoc_gen_openapiv3.options.Document.ExtensionsEntry
+	4,  // 7: grpc.gateway.protoc_gen_openapiv3.options.Info.contact:type_name -> grpc.gateway.protoc_gen_openapiv3.options.Contact
+	5,  // 8: grpc.gateway.protoc_gen_openapiv3.options.Info.license:type_name -> grpc.gateway.protoc_gen_openapiv3.options.License
+	21, // 9: grpc.gateway.protoc_gen_openapiv3.options.Info.extensions:type_name -> grpc.gateway.protoc_gen_openapiv3.options.Info.ExtensionsEntry
+	22, // 10: grpc.gateway.protoc_gen_openapiv3.options.Server.extensions:type_name -> grpc.gateway.protoc_gen_openapiv3.options.Server.ExtensionsEntry
+	13, // 11: grpc.gateway.protoc_gen_openapiv3.options.Operation.external_docs:type_name -> grpc.gateway.protoc_gen_openapiv3.options.ExternalDocs
+	6,  // 12: grpc.gateway.protoc_gen_openapiv3.options.Operation.servers:type_name -> grpc.gateway.protoc_gen_openapiv3.options.Server
+	18, // 13: grpc.gateway.protoc_gen_openapiv3.options.Operation.security:type_name -> grpc.gateway.protoc_gen_openapiv3.options.SecurityRequirement
+	23, // 14: grpc.gateway.protoc_gen_openapiv3.options.Operation.responses:type_name -> grpc.gateway.protoc_gen_openapiv3.options.Operation.ResponsesEntry
+	24, // 15: grpc.gateway.protoc_gen_openapiv3.options.Operation.extensions:type_name -> grpc.gateway.protoc_gen_openapiv3.options.Operation.ExtensionsEntry
+	10, // 16: grpc.gateway.protoc_gen_openapiv3.options.Operation.request_examples:type_name -> grpc.gateway.protoc_gen_openapiv3.options.Example
+	10, // 17: grpc.gateway.protoc_gen_openapiv3.options.Operation.response_examples:type_name -> grpc.gateway.protoc_gen_openapiv3.options.Example
+	18, // 18: grpc.gateway.protoc_gen_openapiv3.options.Service.security:type_name -> grpc.gateway.protoc_gen_openapiv3.options.SecurityRequirement
+	25, // 19: grpc.gateway.protoc_gen_openapiv3.options.Service.responses:type_name -> grpc.gateway.protoc_gen_openapiv3.options.Service.ResponsesEntry
+	26, // 20: grpc.gateway.protoc_gen_openapiv3.options.Response.headers:type_name -> grpc.gateway.protoc_gen_openapiv3.options.Response.HeadersEntry
+	10, // 21: grpc.gateway.protoc_gen_openapiv3.options.Response.examples:type_name -> grpc.gateway.protoc_gen_openapiv3.options.Example
+	27, // 22: grpc.gateway.protoc_gen_openapiv3.options.Schema.extensions:type_name -> grpc.gateway.protoc_gen_openapiv3.options.Schema.ExtensionsEntry
+	10, // 23: grpc.gateway.protoc_gen_openapiv3.options.Schema.message_examples:type_name -> grpc.gateway.protoc_gen_openapiv3.options.Example
+	13, // 24: grpc.gateway.protoc_gen_openapiv3.options.Tag.external_docs:type_name -> grpc.gateway.protoc_gen_openapiv3.options.ExternalDocs
+	28, // 25: grpc.gateway.protoc_gen_openapiv3.options.Tag.extensions:type_name -> grpc.gateway.protoc_gen_openapiv3.options.Tag.ExtensionsEntry
+	0,  // 26: grpc.gateway.protoc_gen_openapiv3.options.SecurityScheme.type:type_name -> grpc.gateway.protoc_gen_openapiv3.options.SecurityScheme.Type
+	1,  // 27: grpc.gateway.protoc_gen_openapiv3.options.SecurityScheme.in:type_name -> grpc.gateway.protoc_gen_openapiv3.options.SecurityScheme.In
+	16, // 28: grpc.gateway.protoc_gen_openapiv3.options.SecurityScheme.flows:type_name -> grpc.gateway.protoc_gen_openapiv3.options.OAuthFlows
+	17, // 29: grpc.gateway.protoc_gen_openapiv3.options.OAuthFlows.implicit:type_name -> grpc.gateway.protoc_gen_openapiv3.options.OAuthFlow
+	17, // 30: grpc.gateway.protoc_gen_openapiv3.options.OAuthFlows.password:type_name -> grpc.gateway.protoc_gen_openapiv3.options.OAuthFlow
+	17, // 31: grpc.gateway.protoc_gen_openapiv3.options.OAuthFlows.client_credentials:type_name -> grpc.gateway.protoc_gen_openapiv3.options.OAuthFlow
+	17, // 32: grpc.gateway.protoc_gen_openapiv3.options.OAuthFlows.authorization_code:type_name -> grpc.gateway.protoc_gen_openapiv3.options.OAuthFlow
+	29, // 33: grpc.gateway.protoc_gen_openapiv3.options.OAuthFlow.scopes:type_name -> grpc.gateway.protoc_gen_openapiv3.options.OAuthFlow.ScopesEntry
+	31, // 34: grpc.gateway.protoc_gen_openapiv3.options.SecurityRequirement.security_requirement:type_name -> grpc.gateway.protoc_gen_openapiv3.options.SecurityRequirement.SecurityRequirementEntry
+	15, // 35: grpc.gateway.protoc_gen_openapiv3.options.Document.SecuritySchemesEntry.value:type_name -> grpc.gateway.protoc_gen_openapiv3.options.SecurityScheme
+	32, // 36: grpc.gateway.protoc_gen_openapiv3.options.Document.ExtensionsEntry.value:type_name -> google.protobuf.Value
+	32, // 37: grpc.gateway.protoc_gen_openapiv3.options.Info.ExtensionsEntry.value:type_name -> google.protobuf.Value
+	32, // 38: grpc.gateway.protoc_gen_openapiv3.options.Server.ExtensionsEntry.value:type_name -> google.protobuf.Value
+	9,  // 39: grpc.gateway.protoc_gen_openapiv3.options.Operation.ResponsesEntry.value:type_name -> grpc.gateway.protoc_gen_openapiv3.options.Response
+	32, // 40: grpc.gateway.protoc_gen_openapiv3.options.Operation.ExtensionsEntry.value:type_name -> google.protobuf.Value
+	9,  // 41: grpc.gateway.protoc_gen_openapiv3.options.Service.ResponsesEntry.value:type_name -> grpc.gateway.protoc_gen_openapiv3.options.Response
+	11, // 42: grpc.gateway.protoc_gen_openapiv3.options.Response.HeadersEntry.value:type_name -> grpc.gateway.protoc_gen_openapiv3.options.Header
+	32, // 43: grpc.gateway.protoc_gen_openapiv3.options.Schema.ExtensionsEntry.value:type_name -> google.protobuf.Value
+	32, // 44: grpc.gateway.protoc_gen_openapiv3.options.Tag.ExtensionsEntry.value:type_name -> google.protobuf.Value
+	30, // 45: grpc.gateway.protoc_gen_openapiv3.options.SecurityRequirement.SecurityRequirementEntry.value:type_name -> grpc.gateway.protoc_gen_openapiv3.options.SecurityRequirement.SecurityRequirementValue
+	46, // [46:46] is the sub-list for method output_type
+	46, // [46:46] is the sub-list for method input_type
+	46, // [46:46] is the sub-list for extension type_name
+	46, // [46:46] is the sub-list for extension extendee
+	0,  // [0:46] is the sub-list for field type_name
 }
 
 func init() { file_protoc_gen_openapiv3_options_openapiv3_proto_init() }
@@ -1387,18 +3815,25 @@ func file_protoc_gen_openapiv3_options_openapiv3_proto_init() {
 		(*License_Identifier)(nil),
 		(*License_Url)(nil),
 	}
+	file_protoc_gen_openapiv3_options_openapiv3_proto_msgTypes[8].OneofWrappers = []any{
+		(*Example_Json)(nil),
+		(*Example_Textproto)(nil),
+		(*Example_File)(nil),
+	}
+	file_protoc_gen_openapiv3_options_openapiv3_proto_msgTypes[10].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_protoc_gen_openapiv3_options_openapiv3_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   9,
+			NumEnums:      2,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_protoc_gen_openapiv3_options_openapiv3_proto_goTypes,
 		DependencyIndexes: file_protoc_gen_openapiv3_options_openapiv3_proto_depIdxs,
+		EnumInfos:         file_protoc_gen_openapiv3_options_openapiv3_proto_enumTypes,
 		MessageInfos:      file_protoc_gen_openapiv3_options_openapiv3_proto_msgTypes,
 	}.Build()
 	File_protoc_gen_openapiv3_options_openapiv3_proto = out.File
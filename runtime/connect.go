@@ -0,0 +1,539 @@
+package runtime
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// WithConnectProtocol returns a ServeMuxOption serving the unary and server
+// streaming calls of the Connect protocol (https://connectrpc.com/docs/protocol)
+// at the POST /{package}.{Service}/{Method} routes of the methods, so that
+// Connect clients can call them through the gateway. The handlers of these
+// routes are registered by the code generated with the connect_protocol option
+// of protoc-gen-grpc-gateway, and take precedence over the HTTP bindings of the
+// same routes.
+func WithConnectProtocol() ServeMuxOption {
+	return func(mux *ServeMux) {
+		mux.connectProtocol = true
+	}
+}
+
+const (
+	connectProtocolVersion = "1"
+	// connectFlagCompressed and connectFlagEndStream are the flags of the
+	// envelopes of streaming calls.
+	connectFlagCompressed = 0x01
+	connectFlagEndStream  = 0x02
+)
+
+// connectCodec is a codec of Connect messages, named "json" or "proto" in the
+// content types and in the query parameters of the requests.
+type connectCodec struct {
+	name string
+	Marshaler
+}
+
+func (mux *ServeMux) connectCodec(name string) (connectCodec, bool) {
+	switch name {
+	case "json":
+		return connectCodec{name: name, Marshaler: mux.marshalers.mimeMap[MIMEWildcard]}, true
+	case "proto":
+		return connectCodec{name: name, Marshaler: &ProtoMarshaller{}}, true
+	default:
+		return connectCodec{}, false
+	}
+}
+
+// connectPattern returns the pattern of the route of the RPC method fullMethod,
+// e.g. "/example.v1.EchoService/Echo".
+func connectPattern(fullMethod string) Pattern {
+	segments := strings.Split(strings.TrimPrefix(fullMethod, "/"), "/")
+	ops := make([]int, 0, 2*len(segments))
+	for i := range segments {
+		ops = append(ops, int(utilities.OpLitPush), i)
+	}
+	return MustPattern(NewPattern(1, ops, segments, ""))
+}
+
+// HandleConnectUnary registers the handlers of the Connect unary calls of the
+// RPC method m to mux, if mux serves the Connect protocol. The handlers mutate
+// the requests with the request mutators of options, call the method with call,
+// and apply the response rewriters of options to its responses. Their errors are
+// written by the error handler of the method if one was given, and as Connect
+// errors otherwise. If sideEffectFree is set, i.e. the idempotency level of the
+// method is NO_SIDE_EFFECTS, the method can also be called with GET requests. It
+// is called by the generated code.
+func HandleConnectUnary[Req, Resp proto.Message](mux *ServeMux, m HandlerMethod[Req, Resp], options *HandlerOptions, sideEffectFree bool, call func(context.Context, Req, ...grpc.CallOption) (Resp, error)) {
+	if !mux.connectProtocol {
+		return
+	}
+	methodOptions := options.Method(m.fullMethod)
+	h := func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		var codec connectCodec
+		var data []byte
+		var err error
+		if r.Method == http.MethodGet {
+			codec, data, err = mux.readConnectGetRequest(r)
+		} else {
+			codec, data, err = mux.readConnectUnaryRequest(r)
+		}
+		if errors.Is(err, errConnectUnsupportedMediaType) {
+			w.WriteHeader(http.StatusUnsupportedMediaType)
+			return
+		}
+		if err != nil {
+			methodOptions.connectError(r.Context(), mux, codec, w, r, ServerMetadata{}, err)
+			return
+		}
+
+		ctx, cancel, err := connectContext(mux, r, m.fullMethod)
+		if err != nil {
+			methodOptions.connectError(r.Context(), mux, codec, w, r, ServerMetadata{}, err)
+			return
+		}
+		defer cancel()
+
+		req := newMessage[Req]()
+		if len(data) > 0 {
+			if err := codec.Unmarshal(data, req); err != nil {
+				methodOptions.connectError(ctx, mux, codec, w, r, ServerMetadata{}, status.Errorf(codes.InvalidArgument, "%v", err))
+				return
+			}
+		}
+		if err := methodOptions.MutateRequest(ctx, req); err != nil {
+			methodOptions.connectError(ctx, mux, codec, w, r, ServerMetadata{}, err)
+			return
+		}
+		var md ServerMetadata
+		resp, err := call(ctx, req, grpc.Header(&md.HeaderMD), grpc.Trailer(&md.TrailerMD))
+		if err != nil {
+			methodOptions.connectError(ctx, mux, codec, w, r, md, err)
+			return
+		}
+		rewritten, err := methodOptions.RewriteResponse(ctx, resp)
+		if err != nil {
+			methodOptions.connectError(ctx, mux, codec, w, r, md, err)
+			return
+		}
+		buf, err := codec.Marshal(rewritten)
+		if err != nil {
+			grpclog.Errorf("Marshal error: %v", err)
+			methodOptions.connectError(ctx, mux, codec, w, r, md, status.Errorf(codes.Internal, "%v", err))
+			return
+		}
+		handleForwardResponseServerMetadata(w, mux, md)
+		handleConnectTrailerHeaders(w, mux, md)
+		w.Header().Set("Content-Type", "application/"+codec.name)
+		if _, err := w.Write(buf); err != nil {
+			grpclog.Errorf("Failed to write response: %v", err)
+		}
+	}
+
+	pat := connectPattern(m.fullMethod)
+	mux.Handle(http.MethodPost, pat, h, WithRPCMethod(m.fullMethod))
+	if sideEffectFree {
+		mux.Handle(http.MethodGet, pat, h, WithRPCMethod(m.fullMethod))
+	}
+}
+
+// HandleConnectServerStream registers the handler of the Connect server
+// streaming calls of the RPC method m to mux, if mux serves the Connect
+// protocol. The handler mutates the requests with the request mutators of
+// options, calls the method with call, and applies the response rewriters of
+// options to its responses. The errors occurring before the first response are
+// written by the error handler of the method if one was given, and all the
+// others in the end of the stream. It is called by the generated code.
+func HandleConnectServerStream[Req, Resp proto.Message, Stream interface {
+	Recv() (Resp, error)
+	grpc.ClientStream
+}](mux *ServeMux, m HandlerMethod[Req, Resp], options *HandlerOptions, call func(context.Context, Req, ...grpc.CallOption) (Stream, error)) {
+	if !mux.connectProtocol {
+		return
+	}
+	methodOptions := options.Method(m.fullMethod)
+	h := func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		codec, ok := mux.connectCodec(strings.TrimPrefix(mediaType, "application/connect+"))
+		if !ok || !strings.HasPrefix(mediaType, "application/connect+") {
+			w.WriteHeader(http.StatusUnsupportedMediaType)
+			return
+		}
+		w.Header().Set("Content-Type", mediaType)
+
+		var md ServerMetadata
+		// started is set once the first envelope is written.
+		var started bool
+		err := func() error {
+			ctx, cancel, err := connectContext(mux, r, m.fullMethod)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			data, err := readConnectEnvelope(r.Body, r.Header.Get("Connect-Content-Encoding"))
+			if err != nil {
+				return err
+			}
+			req := newMessage[Req]()
+			if err := codec.Unmarshal(data, req); err != nil {
+				return status.Errorf(codes.InvalidArgument, "%v", err)
+			}
+			if err := methodOptions.MutateRequest(ctx, req); err != nil {
+				return err
+			}
+			stream, err := call(ctx, req)
+			if err != nil {
+				return err
+			}
+			if md.HeaderMD, err = stream.Header(); err != nil {
+				return err
+			}
+			handleForwardResponseServerMetadata(w, mux, md)
+
+			rc := http.NewResponseController(w)
+			for {
+				resp, err := stream.Recv()
+				if errors.Is(err, io.EOF) {
+					md.TrailerMD = stream.Trailer()
+					return nil
+				}
+				if err != nil {
+					md.TrailerMD = stream.Trailer()
+					return err
+				}
+				rewritten, err := methodOptions.RewriteResponse(ctx, resp)
+				if err != nil {
+					return err
+				}
+				buf, err := codec.Marshal(rewritten)
+				if err != nil {
+					grpclog.Errorf("Marshal error: %v", err)
+					return status.Errorf(codes.Internal, "%v", err)
+				}
+				started = true
+				if err := writeConnectEnvelope(w, 0, buf); err != nil {
+					grpclog.Errorf("Failed to send response chunk: %v", err)
+					return nil
+				}
+				if err := rc.Flush(); err != nil {
+					grpclog.Errorf("Failed to flush response to client: %v", err)
+					return nil
+				}
+			}
+		}()
+
+		if err != nil && !started && methodOptions.errorHandler != nil {
+			methodOptions.HTTPError(NewServerMetadataContext(r.Context(), md), mux, codec.Marshaler, w, r, err)
+			return
+		}
+		end := connectEndStream{Metadata: make(map[string][]string)}
+		if err != nil {
+			end.Error = newConnectError(status.Convert(err))
+		}
+		for k, vs := range md.TrailerMD {
+			if _, ok := mux.outgoingTrailerMatcher(k); ok {
+				end.Metadata[k] = append(end.Metadata[k], vs...)
+			}
+		}
+		buf, err := json.Marshal(end)
+		if err != nil {
+			grpclog.Errorf("Failed to marshal end of stream: %v", err)
+			return
+		}
+		if err := writeConnectEnvelope(w, connectFlagEndStream, buf); err != nil {
+			grpclog.Errorf("Failed to send end of stream: %v", err)
+		}
+	}
+	mux.Handle(http.MethodPost, connectPattern(m.fullMethod), h, WithRPCMethod(m.fullMethod))
+}
+
+func newMessage[T proto.Message]() T {
+	var zero T
+	return zero.ProtoReflect().Type().New().Interface().(T)
+}
+
+// errConnectUnsupportedMediaType is returned by the functions reading the
+// requests of Connect calls whose content type is not supported.
+var errConnectUnsupportedMediaType = errors.New("unsupported media type")
+
+// connectContext returns the context of the Connect call r of the RPC method
+// fullMethod, with the deadline given by its Connect-Timeout-Ms header.
+func connectContext(mux *ServeMux, r *http.Request, fullMethod string) (context.Context, context.CancelFunc, error) {
+	if v := r.Header.Get("Connect-Protocol-Version"); v != "" && v != connectProtocolVersion {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "unsupported Connect-Protocol-Version %q, want %q", v, connectProtocolVersion)
+	}
+	timeout := r.Header.Get("Connect-Timeout-Ms")
+	var ms uint64
+	if timeout != "" {
+		var err error
+		ms, err = strconv.ParseUint(timeout, 10, 64)
+		if err != nil || len(timeout) > 10 {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "invalid Connect-Timeout-Ms %q", timeout)
+		}
+	}
+	ctx, cancelAnnotated, err := AnnotateContextWithCancel(r.Context(), mux, r, fullMethod, WithHTTPPathPattern(fullMethod))
+	if err != nil {
+		return nil, nil, err
+	}
+	if timeout == "" {
+		ctx, cancel := context.WithCancel(ctx)
+		return ctx, func() { cancel(); cancelAnnotated() }, nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(ms)*time.Millisecond)
+	return ctx, func() { cancel(); cancelAnnotated() }, nil
+}
+
+// readConnectUnaryRequest returns the codec and the message of the unary
+// Connect call r made with a POST request.
+func (mux *ServeMux) readConnectUnaryRequest(r *http.Request) (connectCodec, []byte, error) {
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	codec, ok := mux.connectCodec(strings.TrimPrefix(mediaType, "application/"))
+	if !ok || !strings.HasPrefix(mediaType, "application/") {
+		return connectCodec{}, nil, errConnectUnsupportedMediaType
+	}
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		return connectCodec{}, nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	data, err = decompressConnectMessage(data, r.Header.Get("Content-Encoding"))
+	return codec, data, err
+}
+
+// readConnectGetRequest returns the codec and the message of the unary Connect
+// call r made with a GET request, which are given by its encoding, message,
+// base64 and compression query parameters.
+func (mux *ServeMux) readConnectGetRequest(r *http.Request) (connectCodec, []byte, error) {
+	query := r.URL.Query()
+	if v := query.Get("connect"); v != "" && v != "v"+connectProtocolVersion {
+		return connectCodec{}, nil, status.Errorf(codes.InvalidArgument, "unsupported connect query parameter %q, want %q", v, "v"+connectProtocolVersion)
+	}
+	codec, ok := mux.connectCodec(query.Get("encoding"))
+	if !ok {
+		return connectCodec{}, nil, errConnectUnsupportedMediaType
+	}
+	data := []byte(query.Get("message"))
+	if query.Get("base64") == "1" {
+		var err error
+		if data, err = base64.RawURLEncoding.DecodeString(strings.TrimRight(string(data), "=")); err != nil {
+			return connectCodec{}, nil, status.Errorf(codes.InvalidArgument, "invalid message query parameter: %v", err)
+		}
+	}
+	data, err := decompressConnectMessage(data, query.Get("compression"))
+	return codec, data, err
+}
+
+// readConnectEnvelope returns the message of the first envelope of the body of
+// a streaming Connect call, whose compressed messages are encoded with
+// encoding.
+func readConnectEnvelope(body io.Reader, encoding string) ([]byte, error) {
+	var prefix [5]byte
+	if _, err := io.ReadFull(body, prefix[:]); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to read envelope: %v", err)
+	}
+	data := make([]byte, binary.BigEndian.Uint32(prefix[1:]))
+	if _, err := io.ReadFull(body, data); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to read envelope: %v", err)
+	}
+	if prefix[0]&connectFlagCompressed == 0 {
+		return data, nil
+	}
+	return decompressConnectMessage(data, encoding)
+}
+
+func writeConnectEnvelope(w io.Writer, flags byte, data []byte) error {
+	var prefix [5]byte
+	prefix[0] = flags
+	binary.BigEndian.PutUint32(prefix[1:], uint32(len(data)))
+	if _, err := w.Write(prefix[:]); err != nil {
+		return err
+	}
+	_, err := w.Write(data)
+	return err
+}
+
+func decompressConnectMessage(data []byte, encoding string) ([]byte, error) {
+	switch encoding {
+	case "", "identity":
+		return data, nil
+	case "gzip":
+		zr, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to decompress message: %v", err)
+		}
+		defer zr.Close()
+		data, err := io.ReadAll(zr)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to decompress message: %v", err)
+		}
+		return data, nil
+	default:
+		return nil, status.Errorf(codes.Unimplemented, "unsupported compression %q", encoding)
+	}
+}
+
+// handleConnectTrailerHeaders writes the trailers of a unary Connect call as
+// headers prefixed with "Trailer-".
+func handleConnectTrailerHeaders(w http.ResponseWriter, mux *ServeMux, md ServerMetadata) {
+	for k, vs := range md.TrailerMD {
+		if _, ok := mux.outgoingTrailerMatcher(k); ok {
+			for _, v := range vs {
+				w.Header().Add("Trailer-"+k, v)
+			}
+		}
+	}
+}
+
+// writeConnectError writes the error of a unary Connect call.
+func writeConnectError(w http.ResponseWriter, mux *ServeMux, md ServerMetadata, err error) {
+	st := status.Convert(err)
+	buf, merr := json.Marshal(newConnectError(st))
+	if merr != nil {
+		grpclog.Errorf("Failed to marshal error message %q: %v", st, merr)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	handleForwardResponseServerMetadata(w, mux, md)
+	handleConnectTrailerHeaders(w, mux, md)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(connectHTTPStatus(st.Code()))
+	if _, err := w.Write(buf); err != nil {
+		grpclog.Errorf("Failed to write response: %v", err)
+	}
+}
+
+// connectError writes the error of a unary Connect call of the method with the
+// error handler of the method, if one was given, or as a Connect error.
+func (o *MethodHandlerOptions) connectError(ctx context.Context, mux *ServeMux, codec connectCodec, w http.ResponseWriter, r *http.Request, md ServerMetadata, err error) {
+	if o.errorHandler == nil {
+		writeConnectError(w, mux, md, err)
+		return
+	}
+	marshaler := codec.Marshaler
+	if marshaler == nil {
+		_, marshaler = MarshalerForRequest(mux, r)
+	}
+	o.HTTPError(NewServerMetadataContext(ctx, md), mux, marshaler, w, r, err)
+}
+
+// connectError is the JSON representation of the errors of Connect calls.
+type connectError struct {
+	Code    string               `json:"code"`
+	Message string               `json:"message,omitempty"`
+	Details []connectErrorDetail `json:"details,omitempty"`
+}
+
+type connectErrorDetail struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// connectEndStream is the JSON message of the last envelope of the responses of
+// streaming Connect calls.
+type connectEndStream struct {
+	Error    *connectError       `json:"error,omitempty"`
+	Metadata map[string][]string `json:"metadata,omitempty"`
+}
+
+func newConnectError(st *status.Status) *connectError {
+	e := &connectError{
+		Code:    connectCode(st.Code()),
+		Message: st.Message(),
+	}
+	for _, d := range st.Proto().GetDetails() {
+		url := d.GetTypeUrl()
+		e.Details = append(e.Details, connectErrorDetail{
+			Type:  url[strings.LastIndex(url, "/")+1:],
+			Value: base64.RawStdEncoding.EncodeToString(d.GetValue()),
+		})
+	}
+	return e
+}
+
+// connectCode returns the name of code in the Connect protocol, e.g.
+// "invalid_argument".
+func connectCode(code codes.Code) string {
+	switch code {
+	case codes.Canceled:
+		return "canceled"
+	case codes.Unknown:
+		return "unknown"
+	case codes.InvalidArgument:
+		return "invalid_argument"
+	case codes.DeadlineExceeded:
+		return "deadline_exceeded"
+	case codes.NotFound:
+		return "not_found"
+	case codes.AlreadyExists:
+		return "already_exists"
+	case codes.PermissionDenied:
+		return "permission_denied"
+	case codes.ResourceExhausted:
+		return "resource_exhausted"
+	case codes.FailedPrecondition:
+		return "failed_precondition"
+	case codes.Aborted:
+		return "aborted"
+	case codes.OutOfRange:
+		return "out_of_range"
+	case codes.Unimplemented:
+		return "unimplemented"
+	case codes.Internal:
+		return "internal"
+	case codes.Unavailable:
+		return "unavailable"
+	case codes.DataLoss:
+		return "data_loss"
+	case codes.Unauthenticated:
+		return "unauthenticated"
+	default:
+		return fmt.Sprintf("code_%d", code)
+	}
+}
+
+// connectHTTPStatus returns the HTTP status of the errors of unary Connect calls
+// with code.
+func connectHTTPStatus(code codes.Code) int {
+	switch code {
+	case codes.Canceled:
+		return 499
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,445 @@
+package runtime_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	pb "github.com/grpc-ecosystem/grpc-gateway/v2/runtime/internal/examplepb"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func echoUnary(ctx context.Context, req *pb.SimpleMessage, opts ...grpc.CallOption) (*pb.SimpleMessage, error) {
+	for _, opt := range opts {
+		if o, ok := opt.(grpc.TrailerCallOption); ok {
+			*o.TrailerAddr = metadata.Pairs("foo", "bar")
+		}
+	}
+	if req.GetId() == "missing" {
+		st, _ := status.New(codes.NotFound, "no such message").WithDetails(&errdetails.ErrorInfo{Reason: "MISSING"})
+		return nil, st.Err()
+	}
+	if _, ok := ctx.Deadline(); ok {
+		return &pb.SimpleMessage{Id: req.GetId() + "-deadline"}, nil
+	}
+	return &pb.SimpleMessage{Id: req.GetId()}, nil
+}
+
+func newConnectMux(t *testing.T, sideEffectFree bool) *runtime.ServeMux {
+	t.Helper()
+	mux := runtime.NewServeMux(runtime.WithConnectProtocol())
+	runtime.HandleConnectUnary(mux, testHandlerMethod, runtime.NewHandlerOptions(), sideEffectFree, echoUnary)
+	return mux
+}
+
+func TestHandleConnectUnary(t *testing.T) {
+	protoBody, err := proto.Marshal(&pb.SimpleMessage{Id: "foo"})
+	if err != nil {
+		t.Fatalf("proto.Marshal(...) failed with %v; want success", err)
+	}
+	for _, spec := range []struct {
+		name        string
+		contentType string
+		body        []byte
+		header      http.Header
+		wantCode    int
+		wantType    string
+		want        string
+	}{
+		{
+			name:        "json",
+			contentType: "application/json",
+			body:        []byte(`{"id":"foo"}`),
+			wantCode:    http.StatusOK,
+			wantType:    "application/json",
+			want:        "foo",
+		},
+		{
+			name:        "proto",
+			contentType: "application/proto",
+			body:        protoBody,
+			wantCode:    http.StatusOK,
+			wantType:    "application/proto",
+			want:        "foo",
+		},
+		{
+			name:        "timeout",
+			contentType: "application/json",
+			body:        []byte(`{"id":"foo"}`),
+			header:      http.Header{"Connect-Timeout-Ms": {"1000"}, "Connect-Protocol-Version": {"1"}},
+			wantCode:    http.StatusOK,
+			wantType:    "application/json",
+			want:        "foo-deadline",
+		},
+		{
+			name:        "empty body",
+			contentType: "application/json",
+			wantCode:    http.StatusOK,
+			wantType:    "application/json",
+		},
+		{
+			name:        "unsupported content type",
+			contentType: "text/plain",
+			body:        []byte(`foo`),
+			wantCode:    http.StatusUnsupportedMediaType,
+		},
+		{
+			name:        "unsupported protocol version",
+			contentType: "application/json",
+			body:        []byte(`{"id":"foo"}`),
+			header:      http.Header{"Connect-Protocol-Version": {"2"}},
+			wantCode:    http.StatusBadRequest,
+			wantType:    "application/json",
+		},
+		{
+			name:        "invalid timeout",
+			contentType: "application/json",
+			body:        []byte(`{"id":"foo"}`),
+			header:      http.Header{"Connect-Timeout-Ms": {"soon"}},
+			wantCode:    http.StatusBadRequest,
+			wantType:    "application/json",
+		},
+	} {
+		t.Run(spec.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/example.Service/Method", bytes.NewReader(spec.body))
+			r.Header.Set("Content-Type", spec.contentType)
+			for k, vs := range spec.header {
+				r.Header[k] = vs
+			}
+			w := httptest.NewRecorder()
+			newConnectMux(t, false).ServeHTTP(w, r)
+
+			if got, want := w.Code, spec.wantCode; got != want {
+				t.Fatalf("w.Code = %d; want %d; body: %s", got, want, w.Body)
+			}
+			if got, want := w.Header().Get("Content-Type"), spec.wantType; got != want {
+				t.Errorf("Content-Type = %q; want %q", got, want)
+			}
+			if spec.wantCode != http.StatusOK {
+				return
+			}
+			if got, want := w.Header().Get("Trailer-Foo"), "bar"; got != want {
+				t.Errorf("Trailer-Foo = %q; want %q", got, want)
+			}
+			var msg pb.SimpleMessage
+			if spec.wantType == "application/proto" {
+				err = proto.Unmarshal(w.Body.Bytes(), &msg)
+			} else {
+				err = (&runtime.JSONPb{}).Unmarshal(w.Body.Bytes(), &msg)
+			}
+			if err != nil {
+				t.Fatalf("Unmarshal(%q) failed with %v; want success", w.Body, err)
+			}
+			if got, want := msg.GetId(), spec.want; got != want {
+				t.Errorf("msg.Id = %q; want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestHandleConnectUnaryError(t *testing.T) {
+	r := httptest.NewRequest(http.MethodPost, "/example.Service/Method", strings.NewReader(`{"id":"missing"}`))
+	r.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	newConnectMux(t, false).ServeHTTP(w, r)
+
+	if got, want := w.Code, http.StatusNotFound; got != want {
+		t.Fatalf("w.Code = %d; want %d", got, want)
+	}
+	var got struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+		Details []struct {
+			Type  string `json:"type"`
+			Value string `json:"value"`
+		} `json:"details"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+		t.Fatalf("json.Unmarshal(%q) failed with %v; want success", w.Body, err)
+	}
+	if got.Code != "not_found" || got.Message != "no such message" {
+		t.Errorf("error = %q, %q; want %q, %q", got.Code, got.Message, "not_found", "no such message")
+	}
+	if len(got.Details) != 1 {
+		t.Fatalf("len(details) = %d; want 1", len(got.Details))
+	}
+	if got, want := got.Details[0].Type, "google.rpc.ErrorInfo"; got != want {
+		t.Errorf("details[0].type = %q; want %q", got, want)
+	}
+	value, err := base64.RawStdEncoding.DecodeString(got.Details[0].Value)
+	if err != nil {
+		t.Fatalf("base64.RawStdEncoding.DecodeString(%q) failed with %v; want success", got.Details[0].Value, err)
+	}
+	var info errdetails.ErrorInfo
+	if err := proto.Unmarshal(value, &info); err != nil {
+		t.Fatalf("proto.Unmarshal(...) failed with %v; want success", err)
+	}
+	if got, want := info.GetReason(), "MISSING"; got != want {
+		t.Errorf("info.Reason = %q; want %q", got, want)
+	}
+}
+
+func TestHandleConnectUnaryOptions(t *testing.T) {
+	options := runtime.NewHandlerOptions(
+		runtime.WithRequestMutator(testHandlerMethod, func(ctx context.Context, req *pb.SimpleMessage) error {
+			if req.GetId() == "forbidden" {
+				return status.Error(codes.PermissionDenied, "forbidden")
+			}
+			req.Id += "-mutated"
+			return nil
+		}),
+		runtime.WithMethodErrorHandler(testHandlerMethod, func(ctx context.Context, mux *runtime.ServeMux, m runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+			w.WriteHeader(http.StatusTeapot)
+			_, _ = io.WriteString(w, status.Convert(err).Message())
+		}),
+	)
+	mux := runtime.NewServeMux(runtime.WithConnectProtocol())
+	runtime.HandleConnectUnary(mux, testHandlerMethod, options, false, echoUnary)
+
+	for _, spec := range []struct {
+		id       string
+		wantCode int
+		want     string
+	}{
+		{id: "foo", wantCode: http.StatusOK, want: `{"id":"foo-mutated"}`},
+		{id: "forbidden", wantCode: http.StatusTeapot, want: "forbidden"},
+	} {
+		r := httptest.NewRequest(http.MethodPost, "/example.Service/Method", strings.NewReader(`{"id":"`+spec.id+`"}`))
+		r.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if got, want := w.Code, spec.wantCode; got != want {
+			t.Errorf("id %q: w.Code = %d; want %d", spec.id, got, want)
+		}
+		if got, want := w.Body.String(), spec.want; got != want {
+			t.Errorf("id %q: w.Body = %q; want %q", spec.id, got, want)
+		}
+	}
+}
+
+func TestHandleConnectUnaryGet(t *testing.T) {
+	query := url.Values{
+		"encoding": {"json"},
+		"message":  {base64.RawURLEncoding.EncodeToString([]byte(`{"id":"foo"}`))},
+		"base64":   {"1"},
+		"connect":  {"v1"},
+	}
+	for _, spec := range []struct {
+		sideEffectFree bool
+		wantCode       int
+	}{
+		{sideEffectFree: true, wantCode: http.StatusOK},
+		{sideEffectFree: false, wantCode: http.StatusNotImplemented},
+	} {
+		r := httptest.NewRequest(http.MethodGet, "/example.Service/Method?"+query.Encode(), nil)
+		w := httptest.NewRecorder()
+		newConnectMux(t, spec.sideEffectFree).ServeHTTP(w, r)
+		if got, want := w.Code, spec.wantCode; got != want {
+			t.Errorf("GET with sideEffectFree=%t: w.Code = %d; want %d", spec.sideEffectFree, got, want)
+		}
+	}
+}
+
+func TestHandleConnectUnaryDisabled(t *testing.T) {
+	mux := runtime.NewServeMux()
+	runtime.HandleConnectUnary(mux, testHandlerMethod, runtime.NewHandlerOptions(), false, echoUnary)
+
+	r := httptest.NewRequest(http.MethodPost, "/example.Service/Method", strings.NewReader(`{"id":"foo"}`))
+	r.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+	if got, want := w.Code, http.StatusNotFound; got != want {
+		t.Errorf("w.Code = %d; want %d", got, want)
+	}
+}
+
+type fakeServerStream struct {
+	grpc.ClientStream
+	msgs []*pb.SimpleMessage
+	err  error
+}
+
+func (s *fakeServerStream) Header() (metadata.MD, error) {
+	return metadata.Pairs("foo", "bar"), nil
+}
+
+func (s *fakeServerStream) Trailer() metadata.MD {
+	return metadata.Pairs("baz", "qux")
+}
+
+func (s *fakeServerStream) Recv() (*pb.SimpleMessage, error) {
+	if len(s.msgs) == 0 {
+		if s.err != nil {
+			return nil, s.err
+		}
+		return nil, io.EOF
+	}
+	msg := s.msgs[0]
+	s.msgs = s.msgs[1:]
+	return msg, nil
+}
+
+func connectEnvelope(flags byte, data []byte) []byte {
+	buf := make([]byte, 5, 5+len(data))
+	buf[0] = flags
+	binary.BigEndian.PutUint32(buf[1:], uint32(len(data)))
+	return append(buf, data...)
+}
+
+func readConnectEnvelopes(t *testing.T, body []byte) (flags []byte, msgs [][]byte) {
+	t.Helper()
+	for len(body) > 0 {
+		if len(body) < 5 {
+			t.Fatalf("truncated envelope prefix %q", body)
+		}
+		n := binary.BigEndian.Uint32(body[1:5])
+		if uint32(len(body)-5) < n {
+			t.Fatalf("truncated envelope %q", body)
+		}
+		flags = append(flags, body[0])
+		msgs = append(msgs, body[5:5+n])
+		body = body[5+n:]
+	}
+	return flags, msgs
+}
+
+func TestHandleConnectServerStream(t *testing.T) {
+	for _, spec := range []struct {
+		name      string
+		err       error
+		wantError string
+	}{
+		{name: "success"},
+		{name: "error", err: status.Error(codes.Unavailable, "try again"), wantError: "unavailable"},
+	} {
+		t.Run(spec.name, func(t *testing.T) {
+			mux := runtime.NewServeMux(runtime.WithConnectProtocol())
+			runtime.HandleConnectServerStream(mux, testHandlerMethod, runtime.NewHandlerOptions(), func(ctx context.Context, req *pb.SimpleMessage, opts ...grpc.CallOption) (*fakeServerStream, error) {
+				return &fakeServerStream{
+					msgs: []*pb.SimpleMessage{{Id: req.GetId() + "-1"}, {Id: req.GetId() + "-2"}},
+					err:  spec.err,
+				}, nil
+			})
+
+			r := httptest.NewRequest(http.MethodPost, "/example.Service/Method", bytes.NewReader(connectEnvelope(0, []byte(`{"id":"foo"}`))))
+			r.Header.Set("Content-Type", "application/connect+json")
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, r)
+
+			if got, want := w.Code, http.StatusOK; got != want {
+				t.Fatalf("w.Code = %d; want %d", got, want)
+			}
+			if got, want := w.Header().Get("Content-Type"), "application/connect+json"; got != want {
+				t.Errorf("Content-Type = %q; want %q", got, want)
+			}
+			flags, msgs := readConnectEnvelopes(t, w.Body.Bytes())
+			if got, want := string(flags), "\x00\x00\x02"; got != want {
+				t.Fatalf("flags = %q; want %q", got, want)
+			}
+			for i, want := range []string{"foo-1", "foo-2"} {
+				var msg pb.SimpleMessage
+				if err := (&runtime.JSONPb{}).Unmarshal(msgs[i], &msg); err != nil {
+					t.Fatalf("Unmarshal(%q) failed with %v; want success", msgs[i], err)
+				}
+				if got := msg.GetId(); got != want {
+					t.Errorf("msgs[%d].Id = %q; want %q", i, got, want)
+				}
+			}
+
+			var end struct {
+				Error *struct {
+					Code string `json:"code"`
+				} `json:"error"`
+				Metadata map[string][]string `json:"metadata"`
+			}
+			if err := json.Unmarshal(msgs[2], &end); err != nil {
+				t.Fatalf("json.Unmarshal(%q) failed with %v; want success", msgs[2], err)
+			}
+			var gotError string
+			if end.Error != nil {
+				gotError = end.Error.Code
+			}
+			if gotError != spec.wantError {
+				t.Errorf("end.error.code = %q; want %q", gotError, spec.wantError)
+			}
+			if got, want := end.Metadata["baz"], []string{"qux"}; len(got) != 1 || got[0] != want[0] {
+				t.Errorf("end.metadata[baz] = %q; want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestHandleConnectServerStreamOptions(t *testing.T) {
+	options := runtime.NewHandlerOptions(
+		runtime.WithRequestMutator(testHandlerMethod, func(ctx context.Context, req *pb.SimpleMessage) error {
+			if req.GetId() == "forbidden" {
+				return status.Error(codes.PermissionDenied, "forbidden")
+			}
+			req.Id += "-mutated"
+			return nil
+		}),
+		runtime.WithMethodErrorHandler(testHandlerMethod, func(ctx context.Context, mux *runtime.ServeMux, m runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+			w.WriteHeader(http.StatusTeapot)
+			_, _ = io.WriteString(w, status.Convert(err).Message())
+		}),
+	)
+	mux := runtime.NewServeMux(runtime.WithConnectProtocol())
+	runtime.HandleConnectServerStream(mux, testHandlerMethod, options, func(ctx context.Context, req *pb.SimpleMessage, opts ...grpc.CallOption) (*fakeServerStream, error) {
+		return &fakeServerStream{msgs: []*pb.SimpleMessage{{Id: req.GetId()}}}, nil
+	})
+	call := func(id string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodPost, "/example.Service/Method", bytes.NewReader(connectEnvelope(0, []byte(`{"id":"`+id+`"}`))))
+		r.Header.Set("Content-Type", "application/connect+json")
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		return w
+	}
+
+	w := call("foo")
+	_, msgs := readConnectEnvelopes(t, w.Body.Bytes())
+	var msg pb.SimpleMessage
+	if err := (&runtime.JSONPb{}).Unmarshal(msgs[0], &msg); err != nil {
+		t.Fatalf("Unmarshal(%q) failed with %v; want success", msgs[0], err)
+	}
+	if got, want := msg.GetId(), "foo-mutated"; got != want {
+		t.Errorf("msgs[0].Id = %q; want %q", got, want)
+	}
+
+	w = call("forbidden")
+	if got, want := w.Code, http.StatusTeapot; got != want {
+		t.Errorf("w.Code = %d; want %d", got, want)
+	}
+	if got, want := w.Body.String(), "forbidden"; got != want {
+		t.Errorf("w.Body = %q; want %q", got, want)
+	}
+}
+
+func TestHandleConnectServerStreamUnsupportedMediaType(t *testing.T) {
+	mux := runtime.NewServeMux(runtime.WithConnectProtocol())
+	runtime.HandleConnectServerStream(mux, testHandlerMethod, runtime.NewHandlerOptions(), func(ctx context.Context, req *pb.SimpleMessage, opts ...grpc.CallOption) (*fakeServerStream, error) {
+		t.Error("call(...) was called; want no call")
+		return &fakeServerStream{}, nil
+	})
+
+	r := httptest.NewRequest(http.MethodPost, "/example.Service/Method", strings.NewReader(`{"id":"foo"}`))
+	r.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+	if got, want := w.Code, http.StatusUnsupportedMediaType; got != want {
+		t.Errorf("w.Code = %d; want %d", got, want)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"math"
 	"net"
 	"net/http"
 	"net/textproto"
@@ -31,13 +32,16 @@ const MetadataPrefix = "grpcgateway-"
 const MetadataTrailerPrefix = "Grpc-Trailer-"
 
 const metadataGrpcTimeout = "Grpc-Timeout"
+const requestTimeoutHeader = "Request-Timeout"
+const preferHeader = "Prefer"
 const metadataHeaderBinarySuffix = "-Bin"
 
 const xForwardedFor = "X-Forwarded-For"
 const xForwardedHost = "X-Forwarded-Host"
 
-// DefaultContextTimeout is used for gRPC call context.WithTimeout whenever a Grpc-Timeout inbound
-// header isn't present. If the value is 0 the sent `context` will not have a timeout.
+// DefaultContextTimeout is used for gRPC call context.WithTimeout whenever the inbound request
+// carries no timeout and no per-method default is configured. If the value is 0 the sent `context`
+// will not have a timeout.
 var DefaultContextTimeout = 0 * time.Second
 
 // malformedHTTPHeaders lists the headers that the gRPC server may reject outright as malformed.
@@ -50,6 +54,8 @@ type (
 	rpcMethodKey       struct{}
 	httpPathPatternKey struct{}
 	httpPatternKey     struct{}
+	defaultTimeoutKey  struct{}
+	systemParamsKey    struct{}
 
 	AnnotateContextOption func(ctx context.Context) context.Context
 )
@@ -60,6 +66,37 @@ func WithHTTPPathPattern(pattern string) AnnotateContextOption {
 	}
 }
 
+// WithDefaultTimeout returns an AnnotateContextOption that sets the timeout applied to the call
+// when the request does not carry one. It is used by the generated code to apply the deadlines
+// of backend rules in a gRPC API Configuration, and is overridden by the Default of a
+// DeadlinePolicy configured with WithDeadlinePolicies.
+func WithDefaultTimeout(timeout time.Duration) AnnotateContextOption {
+	return func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, defaultTimeoutKey{}, timeout)
+	}
+}
+
+// SystemParameter is a parameter of a call which is not part of the request message,
+// e.g. an API key, as configured by the system parameter rules of a gRPC API Configuration.
+type SystemParameter struct {
+	// Name is the name of the parameter, used as the gRPC metadata key it is forwarded as.
+	Name string
+	// HTTPHeader is the name of the HTTP header the parameter is read from, if any.
+	HTTPHeader string
+	// URLQueryParameter is the name of the URL query parameter the parameter is read from, if any.
+	URLQueryParameter string
+}
+
+// WithSystemParameters returns an AnnotateContextOption that forwards the given system
+// parameters of the request as gRPC metadata. A parameter is read from its HTTP header
+// first, then from its URL query parameter. It is used by the generated code to apply the
+// system parameter rules of a gRPC API Configuration.
+func WithSystemParameters(params ...SystemParameter) AnnotateContextOption {
+	return func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, systemParamsKey{}, params)
+	}
+}
+
 func decodeBinHeader(v string) ([]byte, error) {
 	if len(v)%4 == 0 {
 		// Input was padded, or padding was not necessary.
@@ -74,31 +111,54 @@ AnnotateContext adds context information such as metadata from the request.
 At a minimum, the RemoteAddr is included in the fashion of "X-Forwarded-For",
 except that the forwarded destination is not another HTTP service but rather
 a gRPC service.
+
+The timer of the deadline of the returned context is only released when ctx
+is done. Use AnnotateContextWithCancel to release it earlier.
 */
 func AnnotateContext(ctx context.Context, mux *ServeMux, req *http.Request, rpcMethodName string, options ...AnnotateContextOption) (context.Context, error) {
-	ctx, md, err := annotateContext(ctx, mux, req, rpcMethodName, options...)
+	ctx, _, err := AnnotateContextWithCancel(ctx, mux, req, rpcMethodName, options...)
+	return ctx, err
+}
+
+// AnnotateContextWithCancel is like AnnotateContext, and also returns the
+// function releasing the resources of the deadline of the returned context.
+// It must be called once the RPC is done, unless an error is returned.
+func AnnotateContextWithCancel(ctx context.Context, mux *ServeMux, req *http.Request, rpcMethodName string, options ...AnnotateContextOption) (context.Context, context.CancelFunc, error) {
+	ctx, md, cancel, err := annotateContext(ctx, mux, req, rpcMethodName, options...)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if md == nil {
-		return ctx, nil
+		return ctx, cancel, nil
 	}
 
-	return metadata.NewOutgoingContext(ctx, md), nil
+	return metadata.NewOutgoingContext(ctx, md), cancel, nil
 }
 
 // AnnotateIncomingContext adds context information such as metadata from the request.
 // Attach metadata as incoming context.
+//
+// The timer of the deadline of the returned context is only released when ctx
+// is done. Use AnnotateIncomingContextWithCancel to release it earlier.
 func AnnotateIncomingContext(ctx context.Context, mux *ServeMux, req *http.Request, rpcMethodName string, options ...AnnotateContextOption) (context.Context, error) {
-	ctx, md, err := annotateContext(ctx, mux, req, rpcMethodName, options...)
+	ctx, _, err := AnnotateIncomingContextWithCancel(ctx, mux, req, rpcMethodName, options...)
+	return ctx, err
+}
+
+// AnnotateIncomingContextWithCancel is like AnnotateIncomingContext, and also
+// returns the function releasing the resources of the deadline of the
+// returned context. It must be called once the RPC is done, unless an error
+// is returned.
+func AnnotateIncomingContextWithCancel(ctx context.Context, mux *ServeMux, req *http.Request, rpcMethodName string, options ...AnnotateContextOption) (context.Context, context.CancelFunc, error) {
+	ctx, md, cancel, err := annotateContext(ctx, mux, req, rpcMethodName, options...)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if md == nil {
-		return ctx, nil
+		return ctx, cancel, nil
 	}
 
-	return metadata.NewIncomingContext(ctx, md), nil
+	return metadata.NewIncomingContext(ctx, md), cancel, nil
 }
 
 func isValidGRPCMetadataKey(key string) bool {
@@ -133,18 +193,14 @@ func isValidGRPCMetadataTextValue(textValue string) bool {
 	return true
 }
 
-func annotateContext(ctx context.Context, mux *ServeMux, req *http.Request, rpcMethodName string, options ...AnnotateContextOption) (context.Context, metadata.MD, error) {
+func annotateContext(ctx context.Context, mux *ServeMux, req *http.Request, rpcMethodName string, options ...AnnotateContextOption) (context.Context, metadata.MD, context.CancelFunc, error) {
 	ctx = withRPCMethod(ctx, rpcMethodName)
 	for _, o := range options {
 		ctx = o(ctx)
 	}
-	timeout := DefaultContextTimeout
-	if tm := req.Header.Get(metadataGrpcTimeout); tm != "" {
-		var err error
-		timeout, err = timeoutDecode(tm)
-		if err != nil {
-			return nil, nil, status.Errorf(codes.InvalidArgument, "invalid grpc-timeout: %s", tm)
-		}
+	timeout, err := requestTimeout(ctx, mux, req, rpcMethodName)
+	if err != nil {
+		return nil, nil, nil, err
 	}
 	var pairs []string
 	for key, vals := range req.Header {
@@ -170,7 +226,7 @@ func annotateContext(ctx context.Context, mux *ServeMux, req *http.Request, rpcM
 				if strings.HasSuffix(key, metadataHeaderBinarySuffix) {
 					b, err := decodeBinHeader(val)
 					if err != nil {
-						return nil, nil, status.Errorf(codes.InvalidArgument, "invalid binary header %s: %s", key, err)
+						return nil, nil, nil, status.Errorf(codes.InvalidArgument, "invalid binary header %s: %s", key, err)
 					}
 
 					val = string(b)
@@ -182,6 +238,9 @@ func annotateContext(ctx context.Context, mux *ServeMux, req *http.Request, rpcM
 			}
 		}
 	}
+	if params, ok := ctx.Value(systemParamsKey{}).([]SystemParameter); ok {
+		pairs = append(pairs, systemParameterPairs(req, params)...)
+	}
 	if host := req.Header.Get(xForwardedHost); host != "" {
 		pairs = append(pairs, strings.ToLower(xForwardedHost), host)
 	} else if req.Host != "" {
@@ -198,17 +257,134 @@ func annotateContext(ctx context.Context, mux *ServeMux, req *http.Request, rpcM
 		pairs = append(pairs, strings.ToLower(xForwardedFor), strings.Join(xff, ", "))
 	}
 
+	cancel := context.CancelFunc(func() {})
 	if timeout != 0 {
-		ctx, _ = context.WithTimeout(ctx, timeout)
+		ctx, cancel = context.WithTimeout(ctx, timeout)
 	}
 	md := metadata.Pairs(pairs...)
 	for _, mda := range mux.metadataAnnotators {
 		md = metadata.Join(md, mda(ctx, req))
 	}
 	if len(md) == 0 {
-		return ctx, nil, nil
+		return ctx, nil, cancel, nil
+	}
+	return ctx, md, cancel, nil
+}
+
+// systemParameterPairs returns the metadata pairs of the system parameters found in req.
+func systemParameterPairs(req *http.Request, params []SystemParameter) []string {
+	var pairs []string
+	for _, p := range params {
+		key := strings.ToLower(p.Name)
+		if key == "" || !isValidGRPCMetadataKey(key) {
+			grpclog.Errorf("System parameter name %q is not valid as gRPC metadata key; skipping", p.Name)
+			continue
+		}
+		var val string
+		if p.HTTPHeader != "" {
+			val = req.Header.Get(p.HTTPHeader)
+		}
+		if val == "" && p.URLQueryParameter != "" && req.URL != nil {
+			val = req.URL.Query().Get(p.URLQueryParameter)
+		}
+		if val == "" {
+			continue
+		}
+		if !isValidGRPCMetadataTextValue(val) {
+			grpclog.Errorf("Value of system parameter %q contains non-ASCII value (not valid as gRPC metadata): skipping", p.Name)
+			continue
+		}
+		pairs = append(pairs, key, val)
+	}
+	return pairs
+}
+
+// requestTimeout determines the timeout of the call to rpcMethodName.
+//
+// A timeout supplied by the client takes precedence over the per-method default,
+// which in turn takes precedence over the default set by the generated code and
+// DefaultContextTimeout. The result is clamped to the maximum of the method's
+// DeadlinePolicy, if any.
+func requestTimeout(ctx context.Context, mux *ServeMux, req *http.Request, rpcMethodName string) (time.Duration, error) {
+	timeout := DefaultContextTimeout
+	if d, ok := ctx.Value(defaultTimeoutKey{}).(time.Duration); ok {
+		timeout = d
+	}
+	policy := mux.deadlinePolicies[rpcMethodName]
+	if policy.Default > 0 {
+		timeout = policy.Default
+	}
+
+	clientTimeout, ok, err := timeoutFromHeader(req.Header)
+	if err != nil {
+		return 0, err
+	}
+	if ok {
+		timeout = clientTimeout
+	}
+
+	if policy.Max > 0 && (timeout == 0 || timeout > policy.Max) {
+		timeout = policy.Max
+	}
+	return timeout, nil
+}
+
+// timeoutFromHeader returns the timeout requested by the client, if any. The
+// Grpc-Timeout header is preferred over the Request-Timeout header, which is
+// preferred over the wait preference of the Prefer header (RFC 7240).
+func timeoutFromHeader(header http.Header) (time.Duration, bool, error) {
+	if tm := header.Get(metadataGrpcTimeout); tm != "" {
+		timeout, err := timeoutDecode(tm)
+		if err != nil {
+			return 0, false, status.Errorf(codes.InvalidArgument, "invalid grpc-timeout: %s", tm)
+		}
+		return timeout, true, nil
+	}
+	if tm := header.Get(requestTimeoutHeader); tm != "" {
+		timeout, err := secondsDecode(tm)
+		if err != nil {
+			return 0, false, status.Errorf(codes.InvalidArgument, "invalid request-timeout: %s", tm)
+		}
+		return timeout, true, nil
+	}
+	if timeout, ok := preferWait(header.Values(preferHeader)); ok {
+		return timeout, true, nil
+	}
+	return 0, false, nil
+}
+
+// secondsDecode decodes a non-negative, possibly fractional, number of seconds.
+func secondsDecode(s string) (time.Duration, error) {
+	secs, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	if err != nil {
+		return 0, err
+	}
+	if secs < 0 || math.IsNaN(secs) || math.IsInf(secs, 0) || secs > math.MaxInt64/float64(time.Second) {
+		return 0, fmt.Errorf("timeout out of range: %q", s)
+	}
+	return time.Duration(secs * float64(time.Second)), nil
+}
+
+// preferWait returns the duration of the "wait" preference in the given Prefer
+// header values. As required by RFC 7240, preferences that cannot be
+// understood are ignored rather than rejected.
+func preferWait(values []string) (time.Duration, bool) {
+	for _, value := range values {
+		for _, pref := range strings.Split(value, ",") {
+			// Preference parameters following a ';' do not apply to "wait".
+			pref, _, _ = strings.Cut(pref, ";")
+			name, val, ok := strings.Cut(pref, "=")
+			if !ok || !strings.EqualFold(strings.TrimSpace(name), "wait") {
+				continue
+			}
+			secs, err := strconv.ParseUint(strings.Trim(strings.TrimSpace(val), `"`), 10, 32)
+			if err != nil {
+				continue
+			}
+			return time.Duration(secs) * time.Second, true
+		}
 	}
-	return ctx, md, nil
+	return 0, false
 }
 
 // ServerMetadata consists of metadata sent from gRPC server.
@@ -9,7 +9,9 @@ import (
 	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -292,6 +294,174 @@ func TestAnnotateContext_SupportsTimeouts(t *testing.T) {
 		}
 	}
 }
+
+func TestAnnotateContext_SupportsDeadlinePolicies(t *testing.T) {
+	runtime.DefaultContextTimeout = 0 * time.Second
+	ctx := context.Background()
+	expectedRPCName := "/example.Example/Example"
+	mux := runtime.NewServeMux(runtime.WithDeadlinePolicies(map[string]runtime.DeadlinePolicy{
+		expectedRPCName: {Default: 5 * time.Second, Max: 30 * time.Second},
+	}))
+
+	const acceptableError = 50 * time.Millisecond
+	for _, spec := range []struct {
+		name    string
+		header  http.Header
+		options []runtime.AnnotateContextOption
+		mux     *runtime.ServeMux
+		want    time.Duration
+	}{
+		{
+			name: "generated default",
+			mux:  runtime.NewServeMux(),
+			options: []runtime.AnnotateContextOption{
+				runtime.WithDefaultTimeout(7 * time.Second),
+			},
+			want: 7 * time.Second,
+		},
+		{
+			name: "policy default overrides generated default",
+			mux:  mux,
+			options: []runtime.AnnotateContextOption{
+				runtime.WithDefaultTimeout(7 * time.Second),
+			},
+			want: 5 * time.Second,
+		},
+		{
+			name:   "request-timeout header",
+			mux:    mux,
+			header: http.Header{"Request-Timeout": []string{"12.5"}},
+			want:   12500 * time.Millisecond,
+		},
+		{
+			name:   "prefer wait",
+			mux:    mux,
+			header: http.Header{"Prefer": []string{"respond-async, wait=9"}},
+			want:   9 * time.Second,
+		},
+		{
+			name: "grpc-timeout takes precedence",
+			mux:  mux,
+			header: http.Header{
+				"Grpc-Timeout":    []string{"3S"},
+				"Request-Timeout": []string{"12"},
+			},
+			want: 3 * time.Second,
+		},
+		{
+			name:   "clamped to max",
+			mux:    mux,
+			header: http.Header{"Grpc-Timeout": []string{"1H"}},
+			want:   30 * time.Second,
+		},
+	} {
+		t.Run(spec.name, func(t *testing.T) {
+			request, err := http.NewRequestWithContext(ctx, "GET", "http://example.com", nil)
+			if err != nil {
+				t.Fatalf(`http.NewRequestWithContext(ctx, "GET", "http://example.com", nil) failed with %v; want success`, err)
+			}
+			for k, v := range spec.header {
+				request.Header[k] = v
+			}
+			annotated, err := runtime.AnnotateContext(ctx, spec.mux, request, expectedRPCName, spec.options...)
+			if err != nil {
+				t.Fatalf("runtime.AnnotateContext(ctx, %#v) failed with %v; want success", request, err)
+			}
+			deadline, ok := annotated.Deadline()
+			if !ok {
+				t.Fatalf("annotated.Deadline() = _, false; want _, true")
+			}
+			if got, want := time.Until(deadline), spec.want; got-want > acceptableError || got-want < -acceptableError {
+				t.Errorf("time.Until(deadline) = %v; want %v; with error %v", got, want, acceptableError)
+			}
+		})
+	}
+}
+
+func TestAnnotateContext_InvalidRequestTimeout(t *testing.T) {
+	ctx := context.Background()
+	request, err := http.NewRequestWithContext(ctx, "GET", "http://example.com", nil)
+	if err != nil {
+		t.Fatalf(`http.NewRequestWithContext(ctx, "GET", "http://example.com", nil) failed with %v; want success`, err)
+	}
+	request.Header.Set("Request-Timeout", "soon")
+	_, err = runtime.AnnotateContext(ctx, runtime.NewServeMux(), request, "/example.Example/Example")
+	if got, want := status.Code(err), codes.InvalidArgument; got != want {
+		t.Errorf("status.Code(err) = %v; want %v; err = %v", got, want, err)
+	}
+}
+
+func TestAnnotateContextWithCancel_ReleasesDeadline(t *testing.T) {
+	ctx := context.Background()
+	request, err := http.NewRequestWithContext(ctx, "GET", "http://example.com", nil)
+	if err != nil {
+		t.Fatalf(`http.NewRequestWithContext(ctx, "GET", "http://example.com", nil) failed with %v; want success`, err)
+	}
+	annotated, cancel, err := runtime.AnnotateContextWithCancel(ctx, runtime.NewServeMux(), request, "/example.Example/Example", runtime.WithDefaultTimeout(time.Hour))
+	if err != nil {
+		t.Fatalf("runtime.AnnotateContextWithCancel(ctx, mux, request) failed with %v; want success", err)
+	}
+	if _, ok := annotated.Deadline(); !ok {
+		t.Fatal("annotated.Deadline() is unset; want the default timeout")
+	}
+	cancel()
+	if got, want := annotated.Err(), context.Canceled; got != want {
+		t.Errorf("annotated.Err() = %v; want %v", got, want)
+	}
+}
+
+func TestAnnotateContext_SupportsSystemParameters(t *testing.T) {
+	params := runtime.WithSystemParameters(
+		runtime.SystemParameter{Name: "api_key", HTTPHeader: "X-API-Key", URLQueryParameter: "key"},
+		runtime.SystemParameter{Name: "user_project", HTTPHeader: "X-Goog-User-Project"},
+		runtime.SystemParameter{Name: "invalid key", URLQueryParameter: "invalid"},
+	)
+	for _, spec := range []struct {
+		name   string
+		url    string
+		header http.Header
+		want   metadata.MD
+	}{
+		{
+			name:   "header",
+			url:    "http://example.com/v1?key=fromquery",
+			header: http.Header{"X-Api-Key": {"fromheader"}, "X-Goog-User-Project": {"project"}},
+			want:   metadata.MD{"api_key": {"fromheader"}, "user_project": {"project"}},
+		},
+		{
+			name: "query parameter",
+			url:  "http://example.com/v1?key=fromquery&invalid=value",
+			want: metadata.MD{"api_key": {"fromquery"}},
+		},
+		{
+			name: "missing",
+			url:  "http://example.com/v1",
+			want: metadata.MD{},
+		},
+	} {
+		t.Run(spec.name, func(t *testing.T) {
+			ctx := context.Background()
+			request, err := http.NewRequestWithContext(ctx, "GET", spec.url, nil)
+			if err != nil {
+				t.Fatalf("http.NewRequestWithContext(ctx, %q, %q, nil) failed with %v; want success", "GET", spec.url, err)
+			}
+			for key, vals := range spec.header {
+				request.Header[key] = vals
+			}
+			annotated, err := runtime.AnnotateContext(ctx, runtime.NewServeMux(), request, "/example.Example/Example", params)
+			if err != nil {
+				t.Fatalf("runtime.AnnotateContext(ctx, %#v) failed with %v; want success", request, err)
+			}
+			md, _ := metadata.FromOutgoingContext(annotated)
+			for _, key := range []string{"api_key", "user_project", "invalid key"} {
+				if got, want := md.Get(key), spec.want.Get(key); !reflect.DeepEqual(got, want) {
+					t.Errorf("md[%q] = %q; want %q", key, got, want)
+				}
+			}
+		})
+	}
+}
+
 func TestAnnotateContext_SupportsCustomAnnotators(t *testing.T) {
 	ctx := context.Background()
 	md1 := func(context.Context, *http.Request) metadata.MD { return metadata.New(map[string]string{"foo": "bar"}) }
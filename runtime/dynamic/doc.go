@@ -0,0 +1,14 @@
+/*
+Package dynamic registers handlers on a runtime.ServeMux that transcode HTTP
+requests to gRPC calls without generated code.
+
+The services are described by proto descriptors, loaded either from a
+FileDescriptorSet file, e.g. produced with
+"protoc --include_imports --descriptor_set_out", or from the gRPC server
+reflection service of the backend. Routes are derived from the
+google.api.http annotations of the methods and, optionally, from a gRPC API
+Configuration file, like with protoc-gen-grpc-gateway. Requests and responses
+are represented by dynamicpb messages and (un)marshaled by the marshalers
+registered on the ServeMux.
+*/
+package dynamic
@@ -0,0 +1,91 @@
+package dynamic
+
+import (
+	"fmt"
+	"os"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// LoadFileDescriptorSet reads a serialized google.protobuf.FileDescriptorSet
+// from the file at path and returns the files it contains.
+func LoadFileDescriptorSet(path string) (*protoregistry.Files, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read FileDescriptorSet from %q: %w", path, err)
+	}
+	var set descriptorpb.FileDescriptorSet
+	if err := proto.Unmarshal(b, &set); err != nil {
+		return nil, fmt.Errorf("failed to parse FileDescriptorSet from %q: %w", path, err)
+	}
+	return NewFiles(set.GetFile())
+}
+
+// NewFiles builds a registry of the given files.
+//
+// Dependencies missing from fds are looked up in protoregistry.GlobalFiles,
+// so that well-known types and the google.api annotations, which are linked
+// into the binary, need not be included.
+func NewFiles(fds []*descriptorpb.FileDescriptorProto) (*protoregistry.Files, error) {
+	byName := make(map[string]*descriptorpb.FileDescriptorProto, len(fds))
+	for _, fd := range fds {
+		byName[fd.GetName()] = fd
+	}
+
+	files := new(protoregistry.Files)
+	visiting := make(map[string]bool)
+	var register func(name string) error
+	register = func(name string) error {
+		if _, err := files.FindFileByPath(name); err == nil {
+			return nil
+		}
+		if visiting[name] {
+			return fmt.Errorf("import cycle involving %q", name)
+		}
+		visiting[name] = true
+
+		fdp, ok := byName[name]
+		if !ok {
+			fd, err := protoregistry.GlobalFiles.FindFileByPath(name)
+			if err != nil {
+				return fmt.Errorf("missing dependency %q: %w", name, err)
+			}
+			return registerGlobalFile(files, fd)
+		}
+		for _, dep := range fdp.GetDependency() {
+			if err := register(dep); err != nil {
+				return err
+			}
+		}
+		fd, err := protodesc.NewFile(fdp, files)
+		if err != nil {
+			return fmt.Errorf("invalid file %q: %w", name, err)
+		}
+		return files.RegisterFile(fd)
+	}
+	for _, fd := range fds {
+		if err := register(fd.GetName()); err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+// registerGlobalFile registers fd, which was linked into the binary, and its
+// transitive dependencies in files.
+func registerGlobalFile(files *protoregistry.Files, fd protoreflect.FileDescriptor) error {
+	if _, err := files.FindFileByPath(fd.Path()); err == nil {
+		return nil
+	}
+	imports := fd.Imports()
+	for i := 0; i < imports.Len(); i++ {
+		if err := registerGlobalFile(files, imports.Get(i).FileDescriptor); err != nil {
+			return err
+		}
+	}
+	return files.RegisterFile(fd)
+}
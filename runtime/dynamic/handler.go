@@ -0,0 +1,648 @@
+package dynamic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/internal/descriptor"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/internal/httprule"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	options "google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// Option configures the handlers registered by RegisterHandlers.
+type Option func(*config)
+
+type config struct {
+	services               map[string]bool
+	grpcAPIConfiguration   string
+	generateUnboundMethods bool
+}
+
+// WithServices restricts the registered handlers to the given services, identified by
+// their fully qualified names, e.g. "example.v1.EchoService".
+func WithServices(names ...string) Option {
+	return func(c *config) {
+		if c.services == nil {
+			c.services = make(map[string]bool, len(names))
+		}
+		for _, name := range names {
+			c.services[strings.TrimPrefix(name, ".")] = true
+		}
+	}
+}
+
+// WithGrpcAPIConfiguration loads HTTP rules, and the deadlines of backend rules, from the
+// gRPC API Configuration YAML file at path, like the grpc_api_configuration option of
+// protoc-gen-grpc-gateway.
+func WithGrpcAPIConfiguration(path string) Option {
+	return func(c *config) {
+		c.grpcAPIConfiguration = path
+	}
+}
+
+// WithGenerateUnboundMethods registers methods without HTTP rules at the path
+// "/<service>/<method>" for POST requests carrying the request message in the body,
+// like the generate_unbound_methods option of protoc-gen-grpc-gateway.
+func WithGenerateUnboundMethods() Option {
+	return func(c *config) {
+		c.generateUnboundMethods = true
+	}
+}
+
+// RegisterHandlers registers handlers on mux for the methods of the services described by
+// files that have HTTP rules. The calls are forwarded to conn.
+//
+// Handlers are registered in the order of the fully qualified service names, and in the
+// declaration order of methods within a service.
+func RegisterHandlers(mux *runtime.ServeMux, files *protoregistry.Files, conn grpc.ClientConnInterface, opts ...Option) error {
+	var c config
+	for _, opt := range opts {
+		opt(&c)
+	}
+	reg := descriptor.NewRegistry()
+	if c.grpcAPIConfiguration != "" {
+		if err := reg.LoadGrpcAPIServiceFromYAML(c.grpcAPIConfiguration); err != nil {
+			return err
+		}
+	}
+
+	var services []protoreflect.ServiceDescriptor
+	files.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		for i := 0; i < fd.Services().Len(); i++ {
+			sd := fd.Services().Get(i)
+			if c.services != nil && !c.services[string(sd.FullName())] {
+				continue
+			}
+			services = append(services, sd)
+		}
+		return true
+	})
+	sort.Slice(services, func(i, j int) bool {
+		return services[i].FullName() < services[j].FullName()
+	})
+
+	var bindings []*binding
+	for _, sd := range services {
+		for i := 0; i < sd.Methods().Len(); i++ {
+			md := sd.Methods().Get(i)
+			bs, err := newBindings(reg, md, c.generateUnboundMethods)
+			if err != nil {
+				return fmt.Errorf("%s: %w", md.FullName(), err)
+			}
+			bindings = append(bindings, bs...)
+		}
+	}
+	for _, b := range bindings {
+		b.register(mux, conn)
+	}
+	return nil
+}
+
+// RegisterReflectionHandlers is like RegisterHandlers, except that the services are
+// discovered through the gRPC server reflection service of the server at the other end
+// of conn, see FilesFromReflection.
+func RegisterReflectionHandlers(ctx context.Context, mux *runtime.ServeMux, conn grpc.ClientConnInterface, opts ...Option) error {
+	files, services, err := FilesFromReflection(ctx, conn)
+	if err != nil {
+		return err
+	}
+	return RegisterHandlers(mux, files, conn, append([]Option{WithServices(services...)}, opts...)...)
+}
+
+// binding is an HTTP binding of a method, derived from a google.api.HttpRule.
+type binding struct {
+	method     protoreflect.MethodDescriptor
+	rpcName    string
+	httpMethod string
+	pattern    runtime.Pattern
+	template   string
+	pathParams []string
+	// body is empty if the request has no body, "*" if the body is the request
+	// message and the field path of the body otherwise.
+	body         string
+	bodyField    []protoreflect.FieldDescriptor
+	responseBody []protoreflect.FieldDescriptor
+	fieldMask    protoreflect.FieldDescriptor
+	filter       *utilities.DoubleArray
+	deadline     time.Duration
+}
+
+func newBindings(reg *descriptor.Registry, md protoreflect.MethodDescriptor, generateUnboundMethods bool) ([]*binding, error) {
+	fqmn := "." + string(md.FullName())
+	rules := reg.LookupExternalHTTPRules(fqmn)
+	if rule, ok := proto.GetExtension(md.Options(), options.E_Http).(*options.HttpRule); ok && rule != nil {
+		rules = append(rules, rule)
+	}
+	if len(rules) == 0 {
+		if !generateUnboundMethods || strings.HasPrefix(string(md.FullName()), "grpc.reflection.") {
+			return nil, nil
+		}
+		rules = append(rules, &options.HttpRule{
+			Pattern: &options.HttpRule_Post{
+				Post: fmt.Sprintf("/%s/%s", md.Parent().FullName(), md.Name()),
+			},
+			Body: "*",
+		})
+	}
+	deadline, _ := reg.LookupExternalBackendDeadline(fqmn)
+
+	var bindings []*binding
+	for _, rule := range rules {
+		b, err := newBinding(md, rule, deadline)
+		if err != nil {
+			return nil, err
+		}
+		if b != nil {
+			bindings = append(bindings, b)
+		}
+		for _, additional := range rule.GetAdditionalBindings() {
+			if len(additional.GetAdditionalBindings()) > 0 {
+				return nil, errors.New("additional_binding in additional_binding not allowed")
+			}
+			b, err := newBinding(md, additional, deadline)
+			if err != nil {
+				return nil, err
+			}
+			if b != nil {
+				bindings = append(bindings, b)
+			}
+		}
+	}
+	return bindings, nil
+}
+
+func newBinding(md protoreflect.MethodDescriptor, rule *options.HttpRule, deadline time.Duration) (*binding, error) {
+	var httpMethod, pathTemplate string
+	switch {
+	case rule.GetGet() != "":
+		httpMethod, pathTemplate = http.MethodGet, rule.GetGet()
+		if rule.GetBody() != "" {
+			return nil, errors.New("must not set request body when http method is GET")
+		}
+	case rule.GetPut() != "":
+		httpMethod, pathTemplate = http.MethodPut, rule.GetPut()
+	case rule.GetPost() != "":
+		httpMethod, pathTemplate = http.MethodPost, rule.GetPost()
+	case rule.GetDelete() != "":
+		httpMethod, pathTemplate = http.MethodDelete, rule.GetDelete()
+	case rule.GetPatch() != "":
+		httpMethod, pathTemplate = http.MethodPatch, rule.GetPatch()
+	case rule.GetCustom() != nil:
+		httpMethod, pathTemplate = rule.GetCustom().GetKind(), rule.GetCustom().GetPath()
+	default:
+		if grpclog.V(1) {
+			grpclog.Infof("No pattern specified in google.api.HttpRule: %s", md.FullName())
+		}
+		return nil, nil
+	}
+
+	parsed, err := httprule.Parse(pathTemplate)
+	if err != nil {
+		return nil, err
+	}
+	tmpl := parsed.Compile()
+	pattern, err := runtime.NewPattern(tmpl.Version, tmpl.OpCodes, tmpl.Pool, tmpl.Verb)
+	if err != nil {
+		return nil, err
+	}
+	if md.IsStreamingClient() && len(tmpl.Fields) > 0 {
+		return nil, errors.New("cannot use path parameter in client streaming")
+	}
+
+	b := &binding{
+		method:     md,
+		rpcName:    fmt.Sprintf("/%s/%s", md.Parent().FullName(), md.Name()),
+		httpMethod: httpMethod,
+		pattern:    pattern,
+		template:   tmpl.Template,
+		pathParams: tmpl.Fields,
+		body:       rule.GetBody(),
+		deadline:   deadline,
+	}
+
+	var filter [][]string
+	for _, param := range tmpl.Fields {
+		if _, err := resolveFieldPath(md.Input(), param); err != nil {
+			return nil, err
+		}
+		filter = append(filter, strings.Split(param, "."))
+	}
+	if b.body != "" && b.body != "*" {
+		if b.bodyField, err = resolveFieldPath(md.Input(), b.body); err != nil {
+			return nil, err
+		}
+		filter = append(filter, strings.Split(b.body, "."))
+	}
+	b.filter = utilities.NewDoubleArray(filter)
+
+	if rb := rule.GetResponseBody(); rb != "" && rb != "*" {
+		if b.responseBody, err = resolveFieldPath(md.Output(), rb); err != nil {
+			return nil, err
+		}
+	}
+
+	fields := md.Input().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.Message() == nil || fd.Message().FullName() != "google.protobuf.FieldMask" || fd.IsList() {
+			continue
+		}
+		// If there is more than one FieldMask in the request, use none.
+		if b.fieldMask != nil {
+			b.fieldMask = nil
+			break
+		}
+		b.fieldMask = fd
+	}
+	return b, nil
+}
+
+// resolveFieldPath resolves the dot-separated field path "path", starting from md.
+func resolveFieldPath(md protoreflect.MessageDescriptor, path string) ([]protoreflect.FieldDescriptor, error) {
+	var result []protoreflect.FieldDescriptor
+	for i, name := range strings.Split(path, ".") {
+		if i > 0 {
+			prev := result[i-1]
+			if prev.Message() == nil || prev.IsList() || prev.IsMap() {
+				return nil, fmt.Errorf("not an aggregate type: %s in %s", prev.Name(), path)
+			}
+			md = prev.Message()
+		}
+		fd := md.Fields().ByName(protoreflect.Name(name))
+		if fd == nil {
+			return nil, fmt.Errorf("no field %q found in %s", path, md.FullName())
+		}
+		result = append(result, fd)
+	}
+	return result, nil
+}
+
+func (b *binding) register(mux *runtime.ServeMux, conn grpc.ClientConnInterface) {
+	annotateOpts := []runtime.AnnotateContextOption{runtime.WithHTTPPathPattern(b.template)}
+	if b.deadline != 0 {
+		annotateOpts = append(annotateOpts, runtime.WithDefaultTimeout(b.deadline))
+	}
+	mux.Handle(b.httpMethod, b.pattern, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, cancelAnnotated, err := runtime.AnnotateContextWithCancel(ctx, mux, req, b.rpcName, annotateOpts...)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		defer cancelAnnotated()
+		var (
+			resp any
+			md   runtime.ServerMetadata
+		)
+		if b.method.IsStreamingClient() {
+			resp, md, err = b.requestStreaming(annotatedContext, inboundMarshaler, conn, req)
+		} else {
+			resp, md, err = b.request(annotatedContext, inboundMarshaler, conn, req, pathParams)
+		}
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		if b.method.IsStreamingServer() {
+			stream := resp.(grpc.ClientStream)
+			runtime.ForwardResponseStream(annotatedContext, mux, outboundMarshaler, w, req, func() (proto.Message, error) {
+				msg := dynamicpb.NewMessage(b.method.Output())
+				if err := stream.RecvMsg(msg); err != nil {
+					return nil, err
+				}
+				return b.wrapResponse(msg), nil
+			}, mux.GetForwardResponseOptions()...)
+			return
+		}
+		runtime.ForwardResponseMessage(annotatedContext, mux, outboundMarshaler, w, req, b.wrapResponse(resp.(proto.Message)), mux.GetForwardResponseOptions()...)
+	}, runtime.WithRPCMethod(b.rpcName))
+}
+
+// request builds the request message of a method without client streaming and calls it.
+// For server streaming methods, the returned value is the grpc.ClientStream of the call.
+func (b *binding) request(ctx context.Context, marshaler runtime.Marshaler, conn grpc.ClientConnInterface, req *http.Request, pathParams map[string]string) (any, runtime.ServerMetadata, error) {
+	var metadata runtime.ServerMetadata
+	protoReq := dynamicpb.NewMessage(b.method.Input())
+
+	if b.body != "" {
+		body := io.Reader(req.Body)
+		var newReader func() io.Reader
+		isFieldMask := b.httpMethod == http.MethodPatch && b.fieldMask != nil && b.body != "*"
+		if isFieldMask {
+			var berr error
+			newReader, berr = utilities.IOReaderFactory(req.Body)
+			if berr != nil {
+				return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", berr)
+			}
+			body = newReader()
+		}
+		bodyMsg, err := b.decodeBody(marshaler, body, protoReq)
+		if err != nil {
+			return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		if isFieldMask && bodyMsg != nil {
+			mask := protoReq.Get(b.fieldMask).Message()
+			if !mask.IsValid() || mask.Get(mask.Descriptor().Fields().ByName("paths")).List().Len() == 0 {
+				fieldMask, err := runtime.FieldMaskFromRequestBody(newReader(), bodyMsg)
+				if err != nil {
+					return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+				}
+				buf, err := proto.Marshal(fieldMask)
+				if err != nil {
+					return nil, metadata, err
+				}
+				if err := proto.Unmarshal(buf, protoReq.Mutable(b.fieldMask).Message().Interface()); err != nil {
+					return nil, metadata, err
+				}
+			}
+		}
+	}
+
+	for _, param := range b.pathParams {
+		val, ok := pathParams[param]
+		if !ok {
+			return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", param)
+		}
+		var err error
+		if fields, _ := resolveFieldPath(b.method.Input(), param); fields[len(fields)-1].IsList() {
+			err = runtime.PopulateQueryParameters(protoReq, url.Values{param: strings.Split(val, ",")}, utilities.NewDoubleArray(nil))
+		} else {
+			err = runtime.PopulateFieldFromPath(protoReq, param, val)
+		}
+		if err != nil {
+			return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", param, err)
+		}
+	}
+
+	if b.body != "*" {
+		if err := req.ParseForm(); err != nil {
+			return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		if err := runtime.PopulateQueryParameters(protoReq, req.Form, b.filter); err != nil {
+			return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+	}
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+
+	if b.method.IsStreamingServer() {
+		stream, err := conn.NewStream(ctx, b.streamDesc(), b.rpcName)
+		if err != nil {
+			return nil, metadata, err
+		}
+		if err := stream.SendMsg(protoReq); err != nil {
+			return nil, metadata, err
+		}
+		if err := stream.CloseSend(); err != nil {
+			return nil, metadata, err
+		}
+		header, err := stream.Header()
+		if err != nil {
+			return nil, metadata, err
+		}
+		metadata.HeaderMD = header
+		return stream, metadata, nil
+	}
+	msg := dynamicpb.NewMessage(b.method.Output())
+	err := conn.Invoke(ctx, b.rpcName, protoReq, msg, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+// decodeBody decodes the request body into the field of protoReq selected by the body of
+// the HTTP rule. It returns the message the body was decoded into, or nil if the body
+// field is not a message.
+func (b *binding) decodeBody(marshaler runtime.Marshaler, body io.Reader, protoReq *dynamicpb.Message) (proto.Message, error) {
+	if b.body == "*" {
+		if err := marshaler.NewDecoder(body).Decode(protoReq); err != nil && !errors.Is(err, io.EOF) {
+			return nil, err
+		}
+		return protoReq, nil
+	}
+
+	msg := protoReq.ProtoReflect()
+	for _, fd := range b.bodyField[:len(b.bodyField)-1] {
+		msg = msg.Mutable(fd).Message()
+	}
+	last := b.bodyField[len(b.bodyField)-1]
+	if last.Message() != nil && !last.IsList() && !last.IsMap() {
+		bodyMsg := msg.Mutable(last).Message().Interface()
+		if err := marshaler.NewDecoder(body).Decode(bodyMsg); err != nil && !errors.Is(err, io.EOF) {
+			return nil, err
+		}
+		return bodyMsg, nil
+	}
+
+	// Lists, maps and scalars have no message of their own to decode into, so
+	// the body is decoded as the JSON value of the field within the request.
+	raw, err := io.ReadAll(body)
+	if err != nil {
+		return nil, err
+	}
+	if len(strings.TrimSpace(string(raw))) == 0 {
+		return nil, nil
+	}
+	wrapped := string(raw)
+	for i := len(b.bodyField) - 1; i >= 0; i-- {
+		wrapped = fmt.Sprintf("{%q:%s}", b.bodyField[i].JSONName(), wrapped)
+	}
+	if err := marshaler.Unmarshal([]byte(wrapped), protoReq); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// requestStreaming starts a call to a client streaming method, sending the messages
+// decoded from the request body. For bidirectional streaming methods, the messages are
+// sent concurrently with receiving the responses, and the returned value is the
+// grpc.ClientStream of the call.
+func (b *binding) requestStreaming(ctx context.Context, marshaler runtime.Marshaler, conn grpc.ClientConnInterface, req *http.Request) (any, runtime.ServerMetadata, error) {
+	var metadata runtime.ServerMetadata
+	stream, err := conn.NewStream(ctx, b.streamDesc(), b.rpcName)
+	if err != nil {
+		grpclog.Errorf("Failed to start streaming: %v", err)
+		return nil, metadata, err
+	}
+	dec := marshaler.NewDecoder(req.Body)
+	handleSend := func() error {
+		protoReq := dynamicpb.NewMessage(b.method.Input())
+		err := dec.Decode(protoReq)
+		if errors.Is(err, io.EOF) {
+			return err
+		}
+		if err != nil {
+			grpclog.Errorf("Failed to decode request: %v", err)
+			return status.Errorf(codes.InvalidArgument, "Failed to decode request: %v", err)
+		}
+		if err := stream.SendMsg(protoReq); err != nil {
+			if !errors.Is(err, io.EOF) {
+				grpclog.Errorf("Failed to send request: %v", err)
+			}
+			return err
+		}
+		return nil
+	}
+
+	if b.method.IsStreamingServer() {
+		go func() {
+			for {
+				if err := handleSend(); err != nil {
+					break
+				}
+			}
+			if err := stream.CloseSend(); err != nil {
+				grpclog.Errorf("Failed to terminate client stream: %v", err)
+			}
+		}()
+	} else {
+		for {
+			err := handleSend()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return nil, metadata, err
+			}
+		}
+		if err := stream.CloseSend(); err != nil {
+			grpclog.Errorf("Failed to terminate client stream: %v", err)
+			return nil, metadata, err
+		}
+	}
+
+	header, err := stream.Header()
+	if err != nil {
+		grpclog.Errorf("Failed to get header from client: %v", err)
+		return nil, metadata, err
+	}
+	metadata.HeaderMD = header
+	if b.method.IsStreamingServer() {
+		return stream, metadata, nil
+	}
+	msg := dynamicpb.NewMessage(b.method.Output())
+	err = stream.RecvMsg(msg)
+	metadata.TrailerMD = stream.Trailer()
+	return msg, metadata, err
+}
+
+func (b *binding) streamDesc() *grpc.StreamDesc {
+	return &grpc.StreamDesc{
+		StreamName:    string(b.method.Name()),
+		ServerStreams: b.method.IsStreamingServer(),
+		ClientStreams: b.method.IsStreamingClient(),
+	}
+}
+
+// wrapResponse wraps msg so that only the field selected by the response_body of the
+// HTTP rule is forwarded, if any.
+func (b *binding) wrapResponse(msg proto.Message) proto.Message {
+	if len(b.responseBody) == 0 {
+		return msg
+	}
+	return responseBody{Message: msg, fields: b.responseBody}
+}
+
+// responseBody implements the XXX_ResponseBody method that the code generated for
+// response_body provides, which runtime.ForwardResponseMessage looks for.
+type responseBody struct {
+	proto.Message
+	fields []protoreflect.FieldDescriptor
+}
+
+func (r responseBody) XXX_ResponseBody() interface{} {
+	msg := r.ProtoReflect()
+	for _, fd := range r.fields[:len(r.fields)-1] {
+		msg = msg.Get(fd).Message()
+	}
+	fd := r.fields[len(r.fields)-1]
+	return fieldValue(fd, msg.Get(fd))
+}
+
+// fieldValue converts the value v of field fd into a value the marshalers can marshal
+// on its own, i.e. a proto.Message, a slice or map of these, or a scalar.
+func fieldValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) interface{} {
+	switch {
+	case fd.IsList():
+		list := v.List()
+		if fd.Message() != nil {
+			msgs := make([]proto.Message, list.Len())
+			for i := range msgs {
+				msgs[i] = list.Get(i).Message().Interface()
+			}
+			return msgs
+		}
+		if fd.Enum() != nil {
+			enums := make([]enumValue, list.Len())
+			for i := range enums {
+				enums[i] = enumValue{desc: fd.Enum(), num: list.Get(i).Enum()}
+			}
+			return enums
+		}
+		values := make([]interface{}, list.Len())
+		for i := range values {
+			values[i] = list.Get(i).Interface()
+		}
+		return values
+	case fd.IsMap():
+		values := make(map[string]interface{})
+		v.Map().Range(func(k protoreflect.MapKey, mv protoreflect.Value) bool {
+			values[k.String()] = fieldValue(fd.MapValue(), mv)
+			return true
+		})
+		return values
+	case fd.Message() != nil:
+		return v.Message().Interface()
+	case fd.Enum() != nil:
+		return enumValue{desc: fd.Enum(), num: v.Enum()}
+	default:
+		return v.Interface()
+	}
+}
+
+// enumValue is a value of a dynamic enum type. Like the enum types of generated code,
+// it is written by name by the JSONPb marshaler unless UseEnumNumbers is set, and as
+// a number by other marshalers.
+type enumValue struct {
+	desc protoreflect.EnumDescriptor
+	num  protoreflect.EnumNumber
+}
+
+// String returns the name of the value, or its number if it is not a known value.
+func (e enumValue) String() string {
+	if v := e.desc.Values().ByNumber(e.num); v != nil {
+		return string(v.Name())
+	}
+	return strconv.Itoa(int(e.num))
+}
+
+// EnumDescriptor only marks enumValue as an enum for the JSONPb marshaler, which
+// does not use the descriptor it returns.
+func (e enumValue) EnumDescriptor() ([]byte, []int) {
+	return nil, nil
+}
+
+// MarshalJSON writes the number of the value.
+func (e enumValue) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Itoa(int(e.num))), nil
+}
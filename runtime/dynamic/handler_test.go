@@ -0,0 +1,482 @@
+package dynamic_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime/dynamic"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+const echoProto = `
+name: "dynamic/echo.proto"
+package: "dynamic.test"
+dependency: "google/api/annotations.proto"
+dependency: "google/protobuf/field_mask.proto"
+message_type <
+	name: "Inner"
+	field < name: "text" number: 1 label: LABEL_OPTIONAL type: TYPE_STRING json_name: "text" >
+>
+enum_type <
+	name: "State"
+	value < name: "STATE_UNSPECIFIED" number: 0 >
+	value < name: "ACTIVE" number: 1 >
+	value < name: "INACTIVE" number: 2 >
+>
+message_type <
+	name: "StateMessage"
+	field < name: "state" number: 1 label: LABEL_OPTIONAL type: TYPE_ENUM type_name: ".dynamic.test.State" json_name: "state" >
+	field < name: "states" number: 2 label: LABEL_REPEATED type: TYPE_ENUM type_name: ".dynamic.test.State" json_name: "states" >
+>
+message_type <
+	name: "EchoMessage"
+	field < name: "id" number: 1 label: LABEL_OPTIONAL type: TYPE_STRING json_name: "id" >
+	field < name: "num" number: 2 label: LABEL_OPTIONAL type: TYPE_INT32 json_name: "num" >
+	field < name: "tags" number: 3 label: LABEL_REPEATED type: TYPE_STRING json_name: "tags" >
+	field < name: "inner" number: 4 label: LABEL_OPTIONAL type: TYPE_MESSAGE type_name: ".dynamic.test.Inner" json_name: "inner" >
+	field < name: "update_mask" number: 5 label: LABEL_OPTIONAL type: TYPE_MESSAGE type_name: ".google.protobuf.FieldMask" json_name: "updateMask" >
+>
+service <
+	name: "EchoService"
+	method <
+		name: "Get"
+		input_type: ".dynamic.test.EchoMessage"
+		output_type: ".dynamic.test.EchoMessage"
+		options < [google.api.http] < get: "/v1/echo/{id}" > >
+	>
+	method <
+		name: "Post"
+		input_type: ".dynamic.test.EchoMessage"
+		output_type: ".dynamic.test.EchoMessage"
+		options < [google.api.http] <
+			post: "/v1/echo"
+			body: "*"
+			additional_bindings < put: "/v1/echo/{id}" body: "*" >
+		> >
+	>
+	method <
+		name: "Update"
+		input_type: ".dynamic.test.EchoMessage"
+		output_type: ".dynamic.test.EchoMessage"
+		options < [google.api.http] < patch: "/v1/echo/{id}/inner" body: "inner" response_body: "inner" > >
+	>
+	method <
+		name: "Stream"
+		input_type: ".dynamic.test.EchoMessage"
+		output_type: ".dynamic.test.EchoMessage"
+		options < [google.api.http] < get: "/v1/stream/{id}" > >
+		server_streaming: true
+	>
+	method <
+		name: "Collect"
+		input_type: ".dynamic.test.EchoMessage"
+		output_type: ".dynamic.test.EchoMessage"
+		options < [google.api.http] < post: "/v1/collect" body: "*" > >
+		client_streaming: true
+	>
+	method <
+		name: "Unbound"
+		input_type: ".dynamic.test.EchoMessage"
+		output_type: ".dynamic.test.EchoMessage"
+	>
+>
+service <
+	name: "StateService"
+	method <
+		name: "SetState"
+		input_type: ".dynamic.test.StateMessage"
+		output_type: ".dynamic.test.StateMessage"
+		options < [google.api.http] < post: "/v1/state" body: "*" response_body: "state" > >
+	>
+	method <
+		name: "SetStates"
+		input_type: ".dynamic.test.StateMessage"
+		output_type: ".dynamic.test.StateMessage"
+		options < [google.api.http] < post: "/v1/states" body: "*" response_body: "states" > >
+	>
+>
+syntax: "proto3"
+`
+
+func newEchoFiles(t *testing.T) *protoregistry.Files {
+	t.Helper()
+	var fd descriptorpb.FileDescriptorProto
+	if err := prototext.Unmarshal([]byte(echoProto), &fd); err != nil {
+		t.Fatalf("prototext.Unmarshal(%s) failed with %v; want success", echoProto, err)
+	}
+	files, err := dynamic.NewFiles([]*descriptorpb.FileDescriptorProto{&fd})
+	if err != nil {
+		t.Fatalf("dynamic.NewFiles() failed with %v; want success", err)
+	}
+	return files
+}
+
+// echoHandler implements the methods of EchoService by echoing the request
+// messages. Server streaming methods send the request twice, and client
+// streaming methods reply with the last request, its num set to the number
+// of requests.
+func echoHandler(files *protoregistry.Files) grpc.StreamHandler {
+	return func(_ any, stream grpc.ServerStream) error {
+		name, _ := grpc.MethodFromServerStream(stream)
+		d, err := files.FindDescriptorByName(protoreflect.FullName(strings.ReplaceAll(strings.TrimPrefix(name, "/"), "/", ".")))
+		if err != nil {
+			return status.Errorf(codes.Unimplemented, "unknown method %s", name)
+		}
+		md := d.(protoreflect.MethodDescriptor)
+		if md.Name() == "Get" {
+			id := ""
+			msg := dynamicpb.NewMessage(md.Input())
+			if err := stream.RecvMsg(msg); err != nil {
+				return err
+			}
+			id = msg.Get(md.Input().Fields().ByName("id")).String()
+			if id == "missing" {
+				return status.Error(codes.NotFound, "not found")
+			}
+			return stream.SendMsg(msg)
+		}
+		var (
+			last  *dynamicpb.Message
+			count int32
+		)
+		for {
+			msg := dynamicpb.NewMessage(md.Input())
+			err := stream.RecvMsg(msg)
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			last, count = msg, count+1
+			if !md.IsStreamingClient() {
+				break
+			}
+		}
+		if md.IsStreamingClient() {
+			last.Set(md.Input().Fields().ByName("num"), protoreflect.ValueOfInt32(count))
+		}
+		if err := stream.SendMsg(last); err != nil {
+			return err
+		}
+		if md.IsStreamingServer() {
+			return stream.SendMsg(last)
+		}
+		return nil
+	}
+}
+
+func newEchoConn(t *testing.T, files *protoregistry.Files, register func(*grpc.Server)) *grpc.ClientConn {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer(grpc.UnknownServiceHandler(echoHandler(files)))
+	if register != nil {
+		register(s)
+	}
+	go func() {
+		_ = s.Serve(lis)
+	}()
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("grpc.NewClient() failed with %v; want success", err)
+	}
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+	return conn
+}
+
+func serve(mux *runtime.ServeMux, method, path, body string) *httptest.ResponseRecorder {
+	var r io.Reader
+	if body != "" {
+		r = strings.NewReader(body)
+	}
+	req := httptest.NewRequest(method, path, r)
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+	return w
+}
+
+func TestRegisterHandlers(t *testing.T) {
+	files := newEchoFiles(t)
+	conn := newEchoConn(t, files, nil)
+	mux := runtime.NewServeMux()
+	if err := dynamic.RegisterHandlers(mux, files, conn); err != nil {
+		t.Fatalf("dynamic.RegisterHandlers() failed with %v; want success", err)
+	}
+
+	for _, spec := range []struct {
+		name       string
+		method     string
+		path       string
+		body       string
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "path and query parameters",
+			method:     "GET",
+			path:       "/v1/echo/foo?num=3&tags=a&tags=b&inner.text=bar",
+			wantStatus: http.StatusOK,
+			wantBody:   `{"id":"foo","num":3,"tags":["a","b"],"inner":{"text":"bar"},"updateMask":null}`,
+		},
+		{
+			name:       "error",
+			method:     "GET",
+			path:       "/v1/echo/missing",
+			wantStatus: http.StatusNotFound,
+			wantBody:   `{"code":5,"message":"not found","details":[]}`,
+		},
+		{
+			name:       "body",
+			method:     "POST",
+			path:       "/v1/echo",
+			body:       `{"id":"foo","num":3}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"id":"foo","num":3,"tags":[],"inner":null,"updateMask":null}`,
+		},
+		{
+			name:       "additional binding",
+			method:     "PUT",
+			path:       "/v1/echo/foo",
+			body:       `{"num":3}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"id":"foo","num":3,"tags":[],"inner":null,"updateMask":null}`,
+		},
+		{
+			name:       "body and response body field",
+			method:     "PATCH",
+			path:       "/v1/echo/foo/inner",
+			body:       `{"text":"bar"}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"text":"bar"}`,
+		},
+		{
+			name:       "server streaming",
+			method:     "GET",
+			path:       "/v1/stream/foo",
+			wantStatus: http.StatusOK,
+			wantBody:   `{"result":{"id":"foo","num":0,"tags":[],"inner":null,"updateMask":null}}` + "\n" + `{"result":{"id":"foo","num":0,"tags":[],"inner":null,"updateMask":null}}` + "\n",
+		},
+		{
+			name:       "client streaming",
+			method:     "POST",
+			path:       "/v1/collect",
+			body:       `{"id":"a"}{"id":"b"}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"id":"b","num":2,"tags":[],"inner":null,"updateMask":null}`,
+		},
+		{
+			name:       "unbound method",
+			method:     "POST",
+			path:       "/dynamic.test.EchoService/Unbound",
+			body:       `{"id":"foo"}`,
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "invalid path parameter",
+			method:     "GET",
+			path:       "/v1/echo/foo?num=x",
+			wantStatus: http.StatusBadRequest,
+		},
+	} {
+		t.Run(spec.name, func(t *testing.T) {
+			w := serve(mux, spec.method, spec.path, spec.body)
+			if got, want := w.Code, spec.wantStatus; got != want {
+				t.Fatalf("w.Code = %d; want %d; body: %s", got, want, w.Body.String())
+			}
+			if spec.wantBody == "" {
+				return
+			}
+			if got, want := w.Body.String(), spec.wantBody; !jsonLinesEqual(t, got, want) {
+				t.Errorf("w.Body = %s; want %s", got, want)
+			}
+		})
+	}
+}
+
+// jsonLinesEqual reports whether got and want hold the same sequence of
+// newline delimited JSON values.
+func jsonLinesEqual(t *testing.T, got, want string) bool {
+	t.Helper()
+	gotLines := strings.Split(strings.TrimSpace(got), "\n")
+	wantLines := strings.Split(strings.TrimSpace(want), "\n")
+	if len(gotLines) != len(wantLines) {
+		return false
+	}
+	for i := range gotLines {
+		var g, w any
+		if err := json.Unmarshal([]byte(gotLines[i]), &g); err != nil {
+			t.Fatalf("json.Unmarshal(%q) failed with %v; want success", gotLines[i], err)
+		}
+		if err := json.Unmarshal([]byte(wantLines[i]), &w); err != nil {
+			t.Fatalf("json.Unmarshal(%q) failed with %v; want success", wantLines[i], err)
+		}
+		if !reflect.DeepEqual(g, w) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestRegisterHandlersEnumResponseBody(t *testing.T) {
+	files := newEchoFiles(t)
+	conn := newEchoConn(t, files, nil)
+
+	for _, spec := range []struct {
+		name           string
+		useEnumNumbers bool
+		path           string
+		body           string
+		wantBody       string
+	}{
+		{
+			name:     "enum",
+			path:     "/v1/state",
+			body:     `{"state":"ACTIVE"}`,
+			wantBody: `"ACTIVE"`,
+		},
+		{
+			name:     "repeated enum",
+			path:     "/v1/states",
+			body:     `{"states":["ACTIVE","INACTIVE"]}`,
+			wantBody: `["ACTIVE","INACTIVE"]`,
+		},
+		{
+			name:           "enum number",
+			useEnumNumbers: true,
+			path:           "/v1/state",
+			body:           `{"state":"ACTIVE"}`,
+			wantBody:       `1`,
+		},
+		{
+			name:           "repeated enum numbers",
+			useEnumNumbers: true,
+			path:           "/v1/states",
+			body:           `{"states":["ACTIVE","INACTIVE"]}`,
+			wantBody:       `[1,2]`,
+		},
+	} {
+		t.Run(spec.name, func(t *testing.T) {
+			mux := runtime.NewServeMux(runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+				MarshalOptions: protojson.MarshalOptions{UseEnumNumbers: spec.useEnumNumbers},
+			}))
+			if err := dynamic.RegisterHandlers(mux, files, conn); err != nil {
+				t.Fatalf("dynamic.RegisterHandlers() failed with %v; want success", err)
+			}
+			w := serve(mux, "POST", spec.path, spec.body)
+			if got, want := w.Code, http.StatusOK; got != want {
+				t.Fatalf("w.Code = %d; want %d; body: %s", got, want, w.Body.String())
+			}
+			if got, want := w.Body.String(), spec.wantBody; got != want {
+				t.Errorf("w.Body = %s; want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestRegisterHandlersWithOptions(t *testing.T) {
+	files := newEchoFiles(t)
+	conn := newEchoConn(t, files, nil)
+
+	config := filepath.Join(t.TempDir(), "echo.yaml")
+	if err := os.WriteFile(config, []byte(`
+type: google.api.Service
+config_version: 3
+
+http:
+  rules:
+  - selector: dynamic.test.EchoService.Get
+    get: /v2/echo/{id}
+`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	mux := runtime.NewServeMux()
+	err := dynamic.RegisterHandlers(mux, files, conn,
+		dynamic.WithGrpcAPIConfiguration(config),
+		dynamic.WithGenerateUnboundMethods(),
+	)
+	if err != nil {
+		t.Fatalf("dynamic.RegisterHandlers() failed with %v; want success", err)
+	}
+	for _, spec := range []struct {
+		method string
+		path   string
+		body   string
+	}{
+		{method: "GET", path: "/v1/echo/foo"},
+		{method: "GET", path: "/v2/echo/foo"},
+		{method: "POST", path: "/dynamic.test.EchoService/Unbound", body: `{"id":"foo"}`},
+	} {
+		if w := serve(mux, spec.method, spec.path, spec.body); w.Code != http.StatusOK {
+			t.Errorf("%s %s: w.Code = %d; want %d; body: %s", spec.method, spec.path, w.Code, http.StatusOK, w.Body.String())
+		}
+	}
+
+	mux = runtime.NewServeMux()
+	if err := dynamic.RegisterHandlers(mux, files, conn, dynamic.WithServices("dynamic.test.OtherService")); err != nil {
+		t.Fatalf("dynamic.RegisterHandlers() failed with %v; want success", err)
+	}
+	if w := serve(mux, "GET", "/v1/echo/foo", ""); w.Code != http.StatusNotFound {
+		t.Errorf("w.Code = %d; want %d", w.Code, http.StatusNotFound)
+	}
+}
+
+func TestRegisterHandlersRejectsInvalidRules(t *testing.T) {
+	var fd descriptorpb.FileDescriptorProto
+	if err := prototext.Unmarshal([]byte(strings.Replace(echoProto, `body: "inner"`, `body: "outer"`, 1)), &fd); err != nil {
+		t.Fatal(err)
+	}
+	files, err := dynamic.NewFiles([]*descriptorpb.FileDescriptorProto{&fd})
+	if err != nil {
+		t.Fatalf("dynamic.NewFiles() failed with %v; want success", err)
+	}
+	err = dynamic.RegisterHandlers(runtime.NewServeMux(), files, nil)
+	if err == nil || !strings.Contains(err.Error(), "dynamic.test.EchoService.Update") {
+		t.Errorf("dynamic.RegisterHandlers() = %v; want an error about dynamic.test.EchoService.Update", err)
+	}
+}
+
+func TestRegisterHandlersRemoveService(t *testing.T) {
+	files := newEchoFiles(t)
+	conn := newEchoConn(t, files, nil)
+	mux := runtime.NewServeMux()
+	if err := dynamic.RegisterHandlers(mux, files, conn); err != nil {
+		t.Fatalf("dynamic.RegisterHandlers() failed with %v; want success", err)
+	}
+	if got, want := mux.RemoveService("dynamic.test.EchoService"), 6; got != want {
+		t.Errorf("mux.RemoveService() = %d; want %d", got, want)
+	}
+	if w := serve(mux, "GET", "/v1/echo/foo", ""); w.Code != http.StatusNotFound {
+		t.Errorf("w.Code = %d; want %d", w.Code, http.StatusNotFound)
+	}
+}
@@ -0,0 +1,135 @@
+package dynamic
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	rpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// FilesFromReflection queries the gRPC server reflection service (grpc.reflection.v1) of the
+// server at the other end of conn. It returns the files describing the services of the server,
+// and the fully qualified names of these services, excluding the reflection service itself.
+//
+// Dependencies the server does not know of are looked up in protoregistry.GlobalFiles,
+// see NewFiles.
+func FilesFromReflection(ctx context.Context, conn grpc.ClientConnInterface) (*protoregistry.Files, []string, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := rpb.NewServerReflectionClient(conn).ServerReflectionInfo(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to start server reflection: %w", err)
+	}
+	c := &reflectionClient{stream: stream, files: make(map[string]*descriptorpb.FileDescriptorProto)}
+
+	resp, err := c.do(&rpb.ServerReflectionRequest{
+		MessageRequest: &rpb.ServerReflectionRequest_ListServices{ListServices: "*"},
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list services: %w", err)
+	}
+	var services []string
+	for _, svc := range resp.GetListServicesResponse().GetService() {
+		name := svc.GetName()
+		if strings.HasPrefix(name, "grpc.reflection.") {
+			continue
+		}
+		services = append(services, name)
+		resp, err := c.do(&rpb.ServerReflectionRequest{
+			MessageRequest: &rpb.ServerReflectionRequest_FileContainingSymbol{FileContainingSymbol: name},
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get the file of service %q: %w", name, err)
+		}
+		if err := c.addFiles(resp); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// Servers usually send the transitive dependencies along with the file
+	// containing a symbol, but they are not required to.
+	missing := make(map[string]bool)
+	for {
+		var next string
+		for _, fd := range c.files {
+			for _, dep := range fd.GetDependency() {
+				if _, ok := c.files[dep]; !ok && !missing[dep] {
+					next = dep
+					break
+				}
+			}
+			if next != "" {
+				break
+			}
+		}
+		if next == "" {
+			break
+		}
+		resp, err := c.do(&rpb.ServerReflectionRequest{
+			MessageRequest: &rpb.ServerReflectionRequest_FileByFilename{FileByFilename: next},
+		})
+		if status.Code(err) == codes.NotFound {
+			missing[next] = true
+			continue
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get file %q: %w", next, err)
+		}
+		if err := c.addFiles(resp); err != nil {
+			return nil, nil, err
+		}
+		if _, ok := c.files[next]; !ok {
+			missing[next] = true
+		}
+	}
+	if err := stream.CloseSend(); err != nil {
+		return nil, nil, err
+	}
+
+	fds := make([]*descriptorpb.FileDescriptorProto, 0, len(c.files))
+	for _, fd := range c.files {
+		fds = append(fds, fd)
+	}
+	files, err := NewFiles(fds)
+	if err != nil {
+		return nil, nil, err
+	}
+	return files, services, nil
+}
+
+type reflectionClient struct {
+	stream rpb.ServerReflection_ServerReflectionInfoClient
+	files  map[string]*descriptorpb.FileDescriptorProto
+}
+
+func (c *reflectionClient) do(req *rpb.ServerReflectionRequest) (*rpb.ServerReflectionResponse, error) {
+	if err := c.stream.Send(req); err != nil {
+		return nil, err
+	}
+	resp, err := c.stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+	if e := resp.GetErrorResponse(); e != nil {
+		return nil, status.Error(codes.Code(e.GetErrorCode()), e.GetErrorMessage())
+	}
+	return resp, nil
+}
+
+func (c *reflectionClient) addFiles(resp *rpb.ServerReflectionResponse) error {
+	for _, b := range resp.GetFileDescriptorResponse().GetFileDescriptorProto() {
+		fd := new(descriptorpb.FileDescriptorProto)
+		if err := proto.Unmarshal(b, fd); err != nil {
+			return fmt.Errorf("failed to parse file descriptor: %w", err)
+		}
+		c.files[fd.GetName()] = fd
+	}
+	return nil
+}
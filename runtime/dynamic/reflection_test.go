@@ -0,0 +1,76 @@
+package dynamic_test
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime/dynamic"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+	rpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// echoServices lists EchoService and the reflection service as the services
+// of the server, as the echo backend registers no generated services.
+type echoServices struct{}
+
+func (echoServices) GetServiceInfo() map[string]grpc.ServiceInfo {
+	return map[string]grpc.ServiceInfo{
+		"dynamic.test.EchoService":            {},
+		"grpc.reflection.v1.ServerReflection": {},
+	}
+}
+
+func newReflectionConn(t *testing.T, files *protoregistry.Files) grpc.ClientConnInterface {
+	t.Helper()
+	return newEchoConn(t, files, func(s *grpc.Server) {
+		rpb.RegisterServerReflectionServer(s, reflection.NewServerV1(reflection.ServerOptions{
+			Services:           echoServices{},
+			DescriptorResolver: files,
+		}))
+	})
+}
+
+func TestFilesFromReflection(t *testing.T) {
+	conn := newReflectionConn(t, newEchoFiles(t))
+
+	files, services, err := dynamic.FilesFromReflection(context.Background(), conn)
+	if err != nil {
+		t.Fatalf("dynamic.FilesFromReflection() failed with %v; want success", err)
+	}
+	if got, want := services, []string{"dynamic.test.EchoService"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("services = %v; want %v", got, want)
+	}
+	for _, name := range []string{
+		"dynamic/echo.proto",
+		"google/api/annotations.proto",
+		"google/api/http.proto",
+		"google/protobuf/descriptor.proto",
+		"google/protobuf/field_mask.proto",
+	} {
+		if _, err := files.FindFileByPath(name); err != nil {
+			t.Errorf("files.FindFileByPath(%q) failed with %v; want success", name, err)
+		}
+	}
+}
+
+func TestRegisterReflectionHandlers(t *testing.T) {
+	conn := newReflectionConn(t, newEchoFiles(t))
+
+	mux := runtime.NewServeMux()
+	if err := dynamic.RegisterReflectionHandlers(context.Background(), mux, conn); err != nil {
+		t.Fatalf("dynamic.RegisterReflectionHandlers() failed with %v; want success", err)
+	}
+	w := serve(mux, "GET", "/v1/echo/foo?num=3", "")
+	if got, want := w.Code, http.StatusOK; got != want {
+		t.Fatalf("w.Code = %d; want %d; body: %s", got, want, w.Body.String())
+	}
+	want := `{"id":"foo","num":3,"tags":[],"inner":null,"updateMask":null}`
+	if got := w.Body.String(); !jsonLinesEqual(t, got, want) {
+		t.Errorf("w.Body = %s; want %s", got, want)
+	}
+}
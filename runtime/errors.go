@@ -5,7 +5,10 @@ import (
 	"errors"
 	"io"
 	"net/http"
+	"strconv"
+	"time"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/grpclog"
 	"google.golang.org/grpc/status"
@@ -76,6 +79,50 @@ func HTTPStatusFromCode(code codes.Code) int {
 	}
 }
 
+// HTTPStatusFromStatus converts a gRPC status into the HTTP response status, using the
+// mapping configured with WithHTTPStatusMapping. The reason of a google.rpc.ErrorInfo
+// detail of "s" takes precedence over its code. Codes that are not configured are
+// converted by HTTPStatusFromCode.
+func HTTPStatusFromStatus(mux *ServeMux, s *status.Status) int {
+	mapping := mux.httpStatusMapping
+	if len(mapping.Reasons) > 0 {
+		for _, detail := range s.Details() {
+			info, ok := detail.(*errdetails.ErrorInfo)
+			if !ok {
+				continue
+			}
+			if st, ok := mapping.Reasons[info.GetReason()]; ok {
+				return st
+			}
+		}
+	}
+	if st, ok := mapping.Codes[s.Code()]; ok {
+		return st
+	}
+	return HTTPStatusFromCode(s.Code())
+}
+
+// setRetryAfter sets the Retry-After header of a 503 or 429 response to the delay of
+// the google.rpc.RetryInfo detail of "s", if any, rounded up to whole seconds.
+func setRetryAfter(w http.ResponseWriter, httpStatus int, s *status.Status) {
+	if httpStatus != http.StatusServiceUnavailable && httpStatus != http.StatusTooManyRequests {
+		return
+	}
+	for _, detail := range s.Details() {
+		info, ok := detail.(*errdetails.RetryInfo)
+		if !ok || info.GetRetryDelay() == nil {
+			continue
+		}
+		delay := info.GetRetryDelay().AsDuration()
+		if delay < 0 {
+			delay = 0
+		}
+		seconds := int64((delay + time.Second - 1) / time.Second)
+		w.Header().Set("Retry-After", strconv.FormatInt(seconds, 10))
+		return
+	}
+}
+
 // HTTPError uses the mux-configured error handler.
 func HTTPError(ctx context.Context, mux *ServeMux, marshaler Marshaler, w http.ResponseWriter, r *http.Request, err error) {
 	mux.errorHandler(ctx, mux, marshaler, w, r, err)
@@ -97,12 +144,18 @@ func HTTPStreamError(ctx context.Context, mux *ServeMux, marshaler Marshaler, w
 }
 
 // DefaultHTTPErrorHandler is the default error handler.
-// If "err" is a gRPC Status, the function replies with the status code mapped by HTTPStatusFromCode.
+// If "err" is a gRPC Status, the function replies with the status code mapped by HTTPStatusFromStatus,
+// i.e. by HTTPStatusFromCode unless the mapping was overridden with WithHTTPStatusMapping.
 // If "err" is a HTTPStatusError, the function replies with the status code provide by that struct. This is
 // intended to allow passing through of specific statuses via the function set via WithRoutingErrorHandler
 // for the ServeMux constructor to handle edge cases which the standard mappings in HTTPStatusFromCode
 // are insufficient for.
+// If "err" is context.DeadlineExceeded, e.g. because the deadline of the call expired in the gateway,
+// the function replies with http.StatusGatewayTimeout.
 // If otherwise, it replies with http.StatusInternalServerError.
+// If the reply is http.StatusServiceUnavailable or http.StatusTooManyRequests, e.g. for the Unavailable
+// and ResourceExhausted codes, and the status has a google.rpc.RetryInfo detail, the Retry-After header
+// is set to its retry delay.
 //
 // The response body written by this function is a Status message marshaled by the Marshaler.
 func DefaultHTTPErrorHandler(ctx context.Context, mux *ServeMux, marshaler Marshaler, w http.ResponseWriter, r *http.Request, err error) {
@@ -115,7 +168,7 @@ func DefaultHTTPErrorHandler(ctx context.Context, mux *ServeMux, marshaler Marsh
 		err = customStatus.Err
 	}
 
-	s := status.Convert(err)
+	s := convertError(err)
 
 	w.Header().Del("Trailer")
 	w.Header().Del("Transfer-Encoding")
@@ -164,10 +217,11 @@ func DefaultHTTPErrorHandler(ctx context.Context, mux *ServeMux, marshaler Marsh
 		}
 	}
 
-	st := HTTPStatusFromCode(s.Code())
+	st := HTTPStatusFromStatus(mux, s)
 	if customStatus != nil {
 		st = customStatus.HTTPStatus
 	}
+	setRetryAfter(w, st, s)
 
 	w.WriteHeader(st)
 	if _, err := w.Write(buf); err != nil {
@@ -180,6 +234,19 @@ func DefaultHTTPErrorHandler(ctx context.Context, mux *ServeMux, marshaler Marsh
 }
 
 func DefaultStreamErrorHandler(_ context.Context, err error) *status.Status {
+	return convertError(err)
+}
+
+// convertError is like status.Convert, except that an expired context deadline,
+// e.g. when the handler registered with Register*HandlerServer returns ctx.Err(),
+// is reported as DeadlineExceeded rather than Unknown.
+func convertError(err error) *status.Status {
+	if s, ok := status.FromError(err); ok {
+		return s
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err)
+	}
 	return status.Convert(err)
 }
 
@@ -190,6 +257,9 @@ func DefaultStreamErrorHandler(_ context.Context, err error) *status.Status {
 //	StatusBadRequest -> grpc.InvalidArgument
 //	MethodNotAllowed -> grpc.Unimplemented
 //	Other -> grpc.Internal, method is not expecting to be called for anything else
+//
+// The HTTP status of the response is then derived from the gRPC code by the mux-configured
+// error handler, so mappings configured with WithHTTPStatusMapping apply to routing errors too.
 func DefaultRoutingErrorHandler(ctx context.Context, mux *ServeMux, marshaler Marshaler, w http.ResponseWriter, r *http.Request, httpStatus int) {
 	sterr := status.Error(codes.Internal, "Unexpected routing error")
 	switch httpStatus {
@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
@@ -16,6 +17,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 func TestDefaultHTTPError(t *testing.T) {
@@ -42,6 +44,13 @@ func TestDefaultHTTPError(t *testing.T) {
 			contentType: "application/json",
 			msg:         "example error",
 		},
+		{
+			err:         context.DeadlineExceeded,
+			status:      http.StatusGatewayTimeout,
+			marshaler:   &runtime.JSONPb{},
+			contentType: "application/json",
+			msg:         context.DeadlineExceeded.Error(),
+		},
 		{
 			err:         status.Error(codes.NotFound, "no such resource"),
 			status:      http.StatusNotFound,
@@ -182,3 +191,138 @@ func TestHTTPStreamError(t *testing.T) {
 		})
 	}
 }
+
+func TestHTTPStatusMapping(t *testing.T) {
+	ctx := context.Background()
+
+	withReason, _ := status.New(codes.FailedPrecondition, "resource deleted").WithDetails(
+		&errdetails.ErrorInfo{Reason: "RESOURCE_DELETED", Domain: "example.com"},
+	)
+	mux := runtime.NewServeMux(runtime.WithHTTPStatusMapping(runtime.HTTPStatusMapping{
+		Codes: map[codes.Code]int{
+			codes.FailedPrecondition: http.StatusConflict,
+			codes.Unimplemented:      http.StatusMethodNotAllowed,
+		},
+		Reasons: map[string]int{
+			"RESOURCE_DELETED": http.StatusGone,
+		},
+	}))
+
+	for _, spec := range []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{
+			name:   "code override",
+			err:    status.Error(codes.FailedPrecondition, "conflict"),
+			status: http.StatusConflict,
+		},
+		{
+			name:   "reason override",
+			err:    withReason.Err(),
+			status: http.StatusGone,
+		},
+		{
+			name:   "default mapping",
+			err:    status.Error(codes.NotFound, "no such resource"),
+			status: http.StatusNotFound,
+		},
+		{
+			name: "HTTPStatusError",
+			err: &runtime.HTTPStatusError{
+				HTTPStatus: http.StatusTeapot,
+				Err:        status.Error(codes.FailedPrecondition, "conflict"),
+			},
+			status: http.StatusTeapot,
+		},
+	} {
+		t.Run(spec.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("GET", "/", nil)
+			runtime.HTTPError(ctx, mux, &runtime.JSONPb{}, w, req, spec.err)
+			if got, want := w.Code, spec.status; got != want {
+				t.Errorf("w.Code = %d; want %d", got, want)
+			}
+		})
+	}
+
+	t.Run("routing error", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", "/", nil)
+		runtime.DefaultRoutingErrorHandler(ctx, mux, &runtime.JSONPb{}, w, req, http.StatusMethodNotAllowed)
+		if got, want := w.Code, http.StatusMethodNotAllowed; got != want {
+			t.Errorf("w.Code = %d; want %d", got, want)
+		}
+	})
+
+	t.Run("stream error", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", "/", nil)
+		recv := func() (proto.Message, error) {
+			return nil, withReason.Err()
+		}
+		ctx := runtime.NewServerMetadataContext(ctx, runtime.ServerMetadata{})
+		runtime.ForwardResponseStream(ctx, mux, &runtime.JSONPb{}, w, req, recv)
+		if got, want := w.Code, http.StatusGone; got != want {
+			t.Errorf("w.Code = %d; want %d", got, want)
+		}
+	})
+}
+
+func TestDefaultHTTPErrorRetryAfter(t *testing.T) {
+	ctx := context.Background()
+	mux := runtime.NewServeMux()
+
+	withRetryInfo := func(code codes.Code, delay time.Duration) error {
+		s, err := status.New(code, "try again later").WithDetails(
+			&errdetails.RetryInfo{RetryDelay: durationpb.New(delay)},
+		)
+		if err != nil {
+			t.Fatalf("status.WithDetails() failed with %v; want success", err)
+		}
+		return s.Err()
+	}
+
+	for _, spec := range []struct {
+		name       string
+		err        error
+		status     int
+		retryAfter string
+	}{
+		{
+			name:       "unavailable",
+			err:        withRetryInfo(codes.Unavailable, 30*time.Second),
+			status:     http.StatusServiceUnavailable,
+			retryAfter: "30",
+		},
+		{
+			name:       "resource exhausted",
+			err:        withRetryInfo(codes.ResourceExhausted, 1500*time.Millisecond),
+			status:     http.StatusTooManyRequests,
+			retryAfter: "2",
+		},
+		{
+			name:   "no retry info",
+			err:    status.Error(codes.Unavailable, "try again later"),
+			status: http.StatusServiceUnavailable,
+		},
+		{
+			name:   "other status",
+			err:    withRetryInfo(codes.Aborted, 30*time.Second),
+			status: http.StatusConflict,
+		},
+	} {
+		t.Run(spec.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("GET", "/", nil)
+			runtime.HTTPError(ctx, mux, &runtime.JSONPb{}, w, req, spec.err)
+			if got, want := w.Code, spec.status; got != want {
+				t.Errorf("w.Code = %d; want %d", got, want)
+			}
+			if got, want := w.Header().Get("Retry-After"), spec.retryAfter; got != want {
+				t.Errorf("w.Header().Get(%q) = %q; want %q", "Retry-After", got, want)
+			}
+		})
+	}
+}
@@ -231,7 +231,9 @@ func handleForwardResponseStreamError(ctx context.Context, wroteHeader bool, mar
 	msg := errorChunk(st)
 	if !wroteHeader {
 		w.Header().Set("Content-Type", marshaler.ContentType(msg))
-		w.WriteHeader(HTTPStatusFromCode(st.Code()))
+		httpStatus := HTTPStatusFromStatus(mux, st)
+		setRetryAfter(w, httpStatus, st)
+		w.WriteHeader(httpStatus)
 	}
 	buf, err := marshaler.Marshal(msg)
 	if err != nil {
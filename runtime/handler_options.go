@@ -0,0 +1,200 @@
+package runtime
+
+import (
+	"context"
+	"net/http"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// HandlerMethod identifies an RPC method in the HandlerOptions given to the
+// generated Register{Service}HandlerClientWithOptions functions. Req and Resp are
+// the request and the response messages of the method, which type the hooks of
+// the method. The generated code declares a {Service}_{Method}_HandlerMethod
+// variable for each method with HTTP bindings.
+type HandlerMethod[Req, Resp proto.Message] struct {
+	fullMethod string
+}
+
+// NewHandlerMethod returns the HandlerMethod of the RPC method fullMethod, e.g.
+// "/example.v1.EchoService/Echo". It is called by the generated code.
+func NewHandlerMethod[Req, Resp proto.Message](fullMethod string) HandlerMethod[Req, Resp] {
+	return HandlerMethod[Req, Resp]{fullMethod: fullMethod}
+}
+
+// FullMethod returns the full name of the RPC method, e.g.
+// "/example.v1.EchoService/Echo".
+func (m HandlerMethod[Req, Resp]) FullMethod() string {
+	return m.fullMethod
+}
+
+// ForwardResponseMessageFunc is the signature of ForwardResponseMessage, which
+// writes the response of unary calls.
+type ForwardResponseMessageFunc func(ctx context.Context, mux *ServeMux, marshaler Marshaler, w http.ResponseWriter, req *http.Request, resp proto.Message, opts ...func(context.Context, http.ResponseWriter, proto.Message) error)
+
+// ForwardResponseStreamFunc is the signature of ForwardResponseStream, which
+// writes the responses of server streaming calls.
+type ForwardResponseStreamFunc func(ctx context.Context, mux *ServeMux, marshaler Marshaler, w http.ResponseWriter, req *http.Request, recv func() (proto.Message, error), opts ...func(context.Context, http.ResponseWriter, proto.Message) error)
+
+// HandlerOption is an option that can be given to the generated
+// Register{Service}HandlerClientWithOptions functions to customize the HTTP
+// handlers of a method.
+type HandlerOption func(*HandlerOptions)
+
+// HandlerOptions holds the options of the HTTP handlers registered by a
+// generated Register{Service}HandlerClientWithOptions function.
+type HandlerOptions struct {
+	methods map[string]*MethodHandlerOptions
+}
+
+// NewHandlerOptions returns the HandlerOptions set by opts. It is called by the
+// generated code.
+func NewHandlerOptions(opts ...HandlerOption) *HandlerOptions {
+	o := &HandlerOptions{methods: make(map[string]*MethodHandlerOptions)}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// Method returns the options of the RPC method fullMethod. It returns options
+// keeping the default behavior if none were given for the method.
+func (o *HandlerOptions) Method(fullMethod string) *MethodHandlerOptions {
+	if m, ok := o.methods[fullMethod]; ok {
+		return m
+	}
+	return &defaultMethodHandlerOptions
+}
+
+var defaultMethodHandlerOptions MethodHandlerOptions
+
+func (o *HandlerOptions) method(fullMethod string) *MethodHandlerOptions {
+	m, ok := o.methods[fullMethod]
+	if !ok {
+		m = &MethodHandlerOptions{}
+		o.methods[fullMethod] = m
+	}
+	return m
+}
+
+// MethodHandlerOptions holds the options of the HTTP handlers of an RPC method.
+// Its methods are called by the generated code.
+type MethodHandlerOptions struct {
+	requestMutators   []func(context.Context, proto.Message) error
+	responseRewriters []func(context.Context, proto.Message) (proto.Message, error)
+	messageForwarder  ForwardResponseMessageFunc
+	streamForwarder   ForwardResponseStreamFunc
+	errorHandler      ErrorHandlerFunc
+}
+
+// MutateRequest calls the request mutators of the method with the request
+// message req, before it is sent to the gRPC server.
+func (o *MethodHandlerOptions) MutateRequest(ctx context.Context, req proto.Message) error {
+	for _, mutate := range o.requestMutators {
+		if err := mutate(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RewriteResponse returns the response message resp received from the gRPC
+// server, rewritten by the response rewriters of the method.
+func (o *MethodHandlerOptions) RewriteResponse(ctx context.Context, resp proto.Message) (proto.Message, error) {
+	for _, rewrite := range o.responseRewriters {
+		var err error
+		if resp, err = rewrite(ctx, resp); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+// ForwardResponseMessage returns the function writing the response of unary
+// calls of the method, which is forward unless another forwarder was given.
+func (o *MethodHandlerOptions) ForwardResponseMessage(forward ForwardResponseMessageFunc) ForwardResponseMessageFunc {
+	if o.messageForwarder != nil {
+		return o.messageForwarder
+	}
+	return forward
+}
+
+// ForwardResponseStream returns the function writing the responses of server
+// streaming calls of the method, which is forward unless another forwarder was
+// given.
+func (o *MethodHandlerOptions) ForwardResponseStream(forward ForwardResponseStreamFunc) ForwardResponseStreamFunc {
+	if o.streamForwarder != nil {
+		return o.streamForwarder
+	}
+	return forward
+}
+
+// HTTPError writes the error of a call of the method with the error handler of
+// the method, or with HTTPError if none was given.
+func (o *MethodHandlerOptions) HTTPError(ctx context.Context, mux *ServeMux, marshaler Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	if o.errorHandler != nil {
+		o.errorHandler(ctx, mux, marshaler, w, r, err)
+		return
+	}
+	HTTPError(ctx, mux, marshaler, w, r, err)
+}
+
+// WithRequestMutator returns a HandlerOption calling mutate with the request
+// messages of the method m before they are sent to the gRPC server. If mutate
+// returns an error, the call fails with this error. Mutators are called in the
+// order they are given.
+//
+// The requests of client streaming and bidirectional streaming methods are not
+// mutated.
+func WithRequestMutator[Req, Resp proto.Message](m HandlerMethod[Req, Resp], mutate func(ctx context.Context, req Req) error) HandlerOption {
+	return func(o *HandlerOptions) {
+		mo := o.method(m.fullMethod)
+		mo.requestMutators = append(mo.requestMutators, func(ctx context.Context, req proto.Message) error {
+			return mutate(ctx, req.(Req))
+		})
+	}
+}
+
+// WithResponseRewriter returns a HandlerOption replacing the response messages
+// of the method m received from the gRPC server with the messages returned by
+// rewrite, before they are forwarded. If rewrite returns an error, the call fails
+// with this error. Rewriters are called in the order they are given.
+//
+// Unlike the ForwardResponseRewriter of the ServeMux, the rewritten response has
+// the type of the response of the method, so the response_body of the HTTP
+// bindings of the method still applies to it.
+func WithResponseRewriter[Req, Resp proto.Message](m HandlerMethod[Req, Resp], rewrite func(ctx context.Context, resp Resp) (Resp, error)) HandlerOption {
+	return func(o *HandlerOptions) {
+		mo := o.method(m.fullMethod)
+		mo.responseRewriters = append(mo.responseRewriters, func(ctx context.Context, resp proto.Message) (proto.Message, error) {
+			return rewrite(ctx, resp.(Resp))
+		})
+	}
+}
+
+// WithResponseForwarder returns a HandlerOption writing the responses of unary
+// calls of the method m with forward instead of ForwardResponseMessage.
+func WithResponseForwarder[Req, Resp proto.Message](m HandlerMethod[Req, Resp], forward ForwardResponseMessageFunc) HandlerOption {
+	return func(o *HandlerOptions) {
+		o.method(m.fullMethod).messageForwarder = forward
+	}
+}
+
+// WithResponseStreamForwarder returns a HandlerOption writing the responses of
+// server streaming calls of the method m with forward instead of
+// ForwardResponseStream.
+func WithResponseStreamForwarder[Req, Resp proto.Message](m HandlerMethod[Req, Resp], forward ForwardResponseStreamFunc) HandlerOption {
+	return func(o *HandlerOptions) {
+		o.method(m.fullMethod).streamForwarder = forward
+	}
+}
+
+// WithMethodErrorHandler returns a HandlerOption writing the errors of the calls
+// of the method m with handler instead of the error handler of the ServeMux.
+// Errors ending a stream after its first response are still written by the
+// stream error handler of the ServeMux.
+func WithMethodErrorHandler[Req, Resp proto.Message](m HandlerMethod[Req, Resp], handler ErrorHandlerFunc) HandlerOption {
+	return func(o *HandlerOptions) {
+		o.method(m.fullMethod).errorHandler = handler
+	}
+}
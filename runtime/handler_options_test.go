@@ -0,0 +1,150 @@
+package runtime_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	pb "github.com/grpc-ecosystem/grpc-gateway/v2/runtime/internal/examplepb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var (
+	testHandlerMethod  = runtime.NewHandlerMethod[*pb.SimpleMessage, *pb.SimpleMessage]("/example.Service/Method")
+	otherHandlerMethod = runtime.NewHandlerMethod[*pb.SimpleMessage, *emptypb.Empty]("/example.Service/Other")
+)
+
+func TestHandlerOptionsDefaults(t *testing.T) {
+	o := runtime.NewHandlerOptions().Method(testHandlerMethod.FullMethod())
+
+	req := &pb.SimpleMessage{Id: "foo"}
+	if err := o.MutateRequest(context.Background(), req); err != nil {
+		t.Fatalf("o.MutateRequest(ctx, req) failed with %v; want success", err)
+	}
+	if req.GetId() != "foo" {
+		t.Errorf("req.Id = %q; want %q", req.GetId(), "foo")
+	}
+	resp, err := o.RewriteResponse(context.Background(), req)
+	if err != nil {
+		t.Fatalf("o.RewriteResponse(ctx, req) failed with %v; want success", err)
+	}
+	if resp != req {
+		t.Errorf("o.RewriteResponse(ctx, req) = %v; want %v", resp, req)
+	}
+	if o.ForwardResponseMessage(nil) != nil {
+		t.Error("o.ForwardResponseMessage(nil) != nil; want the given forwarder")
+	}
+	if o.ForwardResponseStream(nil) != nil {
+		t.Error("o.ForwardResponseStream(nil) != nil; want the given forwarder")
+	}
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	o.HTTPError(context.Background(), runtime.NewServeMux(), &runtime.JSONPb{}, w, r, status.Error(codes.NotFound, "not found"))
+	if got, want := w.Code, http.StatusNotFound; got != want {
+		t.Errorf("w.Code = %d; want %d", got, want)
+	}
+}
+
+func TestHandlerOptionsMethod(t *testing.T) {
+	var calls []string
+	opts := runtime.NewHandlerOptions(
+		runtime.WithRequestMutator(testHandlerMethod, func(ctx context.Context, req *pb.SimpleMessage) error {
+			calls = append(calls, "mutate1")
+			req.Id += "-1"
+			return nil
+		}),
+		runtime.WithRequestMutator(testHandlerMethod, func(ctx context.Context, req *pb.SimpleMessage) error {
+			calls = append(calls, "mutate2")
+			req.Id += "-2"
+			return nil
+		}),
+		runtime.WithResponseRewriter(testHandlerMethod, func(ctx context.Context, resp *pb.SimpleMessage) (*pb.SimpleMessage, error) {
+			calls = append(calls, "rewrite")
+			return &pb.SimpleMessage{Id: resp.GetId() + "-rewritten"}, nil
+		}),
+		runtime.WithResponseForwarder(testHandlerMethod, func(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, req *http.Request, resp proto.Message, opts ...func(context.Context, http.ResponseWriter, proto.Message) error) {
+			calls = append(calls, "forward")
+		}),
+		runtime.WithMethodErrorHandler(testHandlerMethod, func(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+			calls = append(calls, "error")
+			w.WriteHeader(http.StatusTeapot)
+		}),
+		runtime.WithRequestMutator(otherHandlerMethod, func(ctx context.Context, req *pb.SimpleMessage) error {
+			calls = append(calls, "other")
+			return nil
+		}),
+	)
+	o := opts.Method(testHandlerMethod.FullMethod())
+
+	req := &pb.SimpleMessage{Id: "foo"}
+	if err := o.MutateRequest(context.Background(), req); err != nil {
+		t.Fatalf("o.MutateRequest(ctx, req) failed with %v; want success", err)
+	}
+	if got, want := req.GetId(), "foo-1-2"; got != want {
+		t.Errorf("req.Id = %q; want %q", got, want)
+	}
+	resp, err := o.RewriteResponse(context.Background(), req)
+	if err != nil {
+		t.Fatalf("o.RewriteResponse(ctx, req) failed with %v; want success", err)
+	}
+	if got, want := resp.(*pb.SimpleMessage).GetId(), "foo-1-2-rewritten"; got != want {
+		t.Errorf("resp.Id = %q; want %q", got, want)
+	}
+	o.ForwardResponseMessage(runtime.ForwardResponseMessage)(context.Background(), nil, nil, nil, nil, resp)
+
+	w := httptest.NewRecorder()
+	o.HTTPError(context.Background(), nil, nil, w, nil, errors.New("failed"))
+	if got, want := w.Code, http.StatusTeapot; got != want {
+		t.Errorf("w.Code = %d; want %d", got, want)
+	}
+
+	want := []string{"mutate1", "mutate2", "rewrite", "forward", "error"}
+	if len(calls) != len(want) {
+		t.Fatalf("calls = %q; want %q", calls, want)
+	}
+	for i := range want {
+		if calls[i] != want[i] {
+			t.Errorf("calls = %q; want %q", calls, want)
+			break
+		}
+	}
+}
+
+func TestHandlerOptionsErrors(t *testing.T) {
+	errMutate := status.Error(codes.InvalidArgument, "invalid")
+	errRewrite := status.Error(codes.Internal, "internal")
+	var rewritten bool
+	o := runtime.NewHandlerOptions(
+		runtime.WithRequestMutator(testHandlerMethod, func(ctx context.Context, req *pb.SimpleMessage) error {
+			return errMutate
+		}),
+		runtime.WithRequestMutator(testHandlerMethod, func(ctx context.Context, req *pb.SimpleMessage) error {
+			t.Error("mutator called after a failed mutator")
+			return nil
+		}),
+		runtime.WithResponseRewriter(testHandlerMethod, func(ctx context.Context, resp *pb.SimpleMessage) (*pb.SimpleMessage, error) {
+			return nil, errRewrite
+		}),
+		runtime.WithResponseRewriter(testHandlerMethod, func(ctx context.Context, resp *pb.SimpleMessage) (*pb.SimpleMessage, error) {
+			rewritten = true
+			return resp, nil
+		}),
+	).Method(testHandlerMethod.FullMethod())
+
+	if err := o.MutateRequest(context.Background(), &pb.SimpleMessage{}); !errors.Is(err, errMutate) {
+		t.Errorf("o.MutateRequest(ctx, req) failed with %v; want %v", err, errMutate)
+	}
+	if _, err := o.RewriteResponse(context.Background(), &pb.SimpleMessage{}); !errors.Is(err, errRewrite) {
+		t.Errorf("o.RewriteResponse(ctx, resp) failed with %v; want %v", err, errRewrite)
+	}
+	if rewritten {
+		t.Error("rewriter called after a failed rewriter")
+	}
+}
@@ -0,0 +1,332 @@
+package httpclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime/internal/httprequest"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Client sends the calls of generated HTTP clients to a gateway.
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+	marshaler  runtime.Marshaler
+}
+
+// Option is an option that can be given to New.
+type Option func(*Client)
+
+// WithHTTPClient sets the http.Client used to send requests. It defaults to
+// http.DefaultClient.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithMarshaler sets the marshaler of request and response bodies. It must
+// marshal messages to JSON, like runtime.JSONPb, since responses and stream
+// chunks are decoded from JSON. It defaults to a runtime.JSONPb discarding
+// unknown fields.
+func WithMarshaler(marshaler runtime.Marshaler) Option {
+	return func(c *Client) {
+		c.marshaler = marshaler
+	}
+}
+
+// New returns a Client sending requests to the gateway at baseURL, e.g.
+// "https://api.example.com" or "http://localhost:8080/prefix".
+func New(baseURL string, opts ...Option) (*Client, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base URL %q: %w", baseURL, err)
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("invalid base URL %q: scheme and host are required", baseURL)
+	}
+	u.RawQuery, u.Fragment = "", ""
+	c := &Client{
+		baseURL:    strings.TrimSuffix(u.String(), "/"),
+		httpClient: http.DefaultClient,
+		marshaler: &runtime.JSONPb{
+			UnmarshalOptions: protojson.UnmarshalOptions{DiscardUnknown: true},
+		},
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c, nil
+}
+
+// Binding is an HTTP binding of a method, as described by a google.api.HttpRule.
+type Binding struct {
+	// HTTPMethod is the HTTP method of the binding, e.g. "GET".
+	HTTPMethod string
+	// PathTemplate is the path template of the binding, e.g. "/v1/{name=shelves/*}".
+	PathTemplate string
+	// Body is the field path of the request field sent as the request body, "*" if
+	// the request message is the body or empty if the request has no body.
+	Body string
+	// ResponseBody is the field path of the response field sent as the response
+	// body, or empty if the response message is the body.
+	ResponseBody string
+}
+
+// Invoke sends the unary call of method, e.g. "/example.v1.EchoService/Echo", with
+// the request in and decodes the response into out. The request is sent with the
+// first of bindings whose path parameters are all set in in, or with the first
+// binding if there is none.
+//
+// The grpc.Header and grpc.Trailer call options are supported, other options are
+// ignored.
+func (c *Client) Invoke(ctx context.Context, method string, bindings []Binding, in, out proto.Message, opts ...grpc.CallOption) error {
+	resp, b, err := c.send(ctx, method, bindings, in, opts)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	setHeader(opts, resp)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		err := c.statusError(resp)
+		setTrailer(opts, resp)
+		return err
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return transportError(ctx, err)
+	}
+	setTrailer(opts, resp)
+	if hb, ok := out.(*httpbody.HttpBody); ok && b.ResponseBody == "" {
+		// The gateway sends the data of HttpBody responses as the raw body.
+		hb.ContentType = resp.Header.Get("Content-Type")
+		hb.Data = body
+		return nil
+	}
+	if err := c.unmarshalResponse(body, b.ResponseBody, out); err != nil {
+		return status.Errorf(codes.Internal, "failed to decode the response of %s: %v", method, err)
+	}
+	return nil
+}
+
+// send sends the request of a call.
+func (c *Client) send(ctx context.Context, method string, bindings []Binding, in proto.Message, opts []grpc.CallOption) (*http.Response, Binding, error) {
+	if len(bindings) == 0 {
+		return nil, Binding{}, status.Errorf(codes.Unimplemented, "method %s has no HTTP binding", method)
+	}
+	b := bindings[0]
+	for _, candidate := range bindings {
+		if httprequest.PathParamsSet(candidate.PathTemplate, in) {
+			b = candidate
+			break
+		}
+	}
+
+	path, params, err := ExpandPath(b.PathTemplate, in)
+	if err != nil {
+		return nil, b, status.Errorf(codes.InvalidArgument, "%s: %v", method, err)
+	}
+	var body io.Reader
+	var query url.Values
+	switch b.Body {
+	case "*":
+	case "":
+		if query, err = QueryValues(in, params...); err != nil {
+			return nil, b, status.Errorf(codes.InvalidArgument, "%s: %v", method, err)
+		}
+	default:
+		if query, err = QueryValues(in, append(params, b.Body)...); err != nil {
+			return nil, b, status.Errorf(codes.InvalidArgument, "%s: %v", method, err)
+		}
+	}
+	if b.Body != "" {
+		buf, err := c.marshalBody(in, b.Body, params)
+		if err != nil {
+			return nil, b, status.Errorf(codes.InvalidArgument, "failed to encode the request of %s: %v", method, err)
+		}
+		body = bytes.NewReader(buf)
+	}
+
+	u := c.baseURL + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, b.HTTPMethod, u, body)
+	if err != nil {
+		return nil, b, status.Errorf(codes.Internal, "failed to create the request of %s: %v", method, err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", c.marshaler.ContentType(in))
+	}
+	req.Header.Set("Accept", "application/json")
+	setRequestMetadata(ctx, req, opts)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, b, transportError(ctx, err)
+	}
+	return resp, b, nil
+}
+
+// setRequestMetadata sends the outgoing metadata and the deadline of ctx as
+// headers, as the default incoming header matcher of the gateway expects them.
+func setRequestMetadata(ctx context.Context, req *http.Request, opts []grpc.CallOption) {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	for k, vs := range md {
+		for _, v := range vs {
+			if strings.HasSuffix(k, "-bin") {
+				v = base64.StdEncoding.EncodeToString([]byte(v))
+			}
+			req.Header.Add(runtime.MetadataHeaderPrefix+k, v)
+		}
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		req.Header.Set("Grpc-Timeout", encodeTimeout(time.Until(deadline)))
+	}
+	for _, opt := range opts {
+		if _, ok := opt.(grpc.TrailerCallOption); ok {
+			req.Header.Set("TE", "trailers")
+		}
+	}
+}
+
+// encodeTimeout encodes t in the format of the Grpc-Timeout header, rounding it
+// up to the smallest unit that fits in 8 digits.
+func encodeTimeout(t time.Duration) string {
+	if t <= 0 {
+		return "0n"
+	}
+	for _, u := range []struct {
+		d    time.Duration
+		unit string
+	}{
+		{time.Nanosecond, "n"},
+		{time.Microsecond, "u"},
+		{time.Millisecond, "m"},
+		{time.Second, "S"},
+		{time.Minute, "M"},
+	} {
+		if n := (t + u.d - 1) / u.d; n <= 99999999 {
+			return fmt.Sprintf("%d%s", n, u.unit)
+		}
+	}
+	return fmt.Sprintf("%dH", (t+time.Hour-1)/time.Hour)
+}
+
+func setHeader(opts []grpc.CallOption, resp *http.Response) {
+	for _, opt := range opts {
+		if o, ok := opt.(grpc.HeaderCallOption); ok {
+			*o.HeaderAddr = responseMetadata(resp.Header, runtime.MetadataHeaderPrefix)
+		}
+	}
+}
+
+func setTrailer(opts []grpc.CallOption, resp *http.Response) {
+	for _, opt := range opts {
+		if o, ok := opt.(grpc.TrailerCallOption); ok {
+			*o.TrailerAddr = responseMetadata(resp.Trailer, runtime.MetadataTrailerPrefix)
+		}
+	}
+}
+
+// responseMetadata returns the metadata sent by the gateway as the headers of h
+// starting with prefix.
+func responseMetadata(h http.Header, prefix string) metadata.MD {
+	md := metadata.MD{}
+	for k, vs := range h {
+		if key, ok := strings.CutPrefix(k, prefix); ok {
+			md.Append(key, vs...)
+		}
+	}
+	return md
+}
+
+// statusError returns the error of a failed call, sent as a google.rpc.Status by
+// the gateway, or as an error chunk if the call is server streaming. If the body
+// is neither, the error is derived from the HTTP status code of the response.
+func (c *Client) statusError(resp *http.Response) error {
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return transportError(resp.Request.Context(), err)
+	}
+	var chunk struct {
+		Error json.RawMessage `json:"error"`
+	}
+	if json.Unmarshal(body, &chunk) == nil && len(chunk.Error) > 0 {
+		body = chunk.Error
+	}
+	s := new(spb.Status)
+	if err := c.marshaler.Unmarshal(body, s); err == nil && s.GetCode() != int32(codes.OK) {
+		return status.ErrorProto(s)
+	}
+	msg := strings.TrimSpace(string(body))
+	if msg == "" {
+		msg = http.StatusText(resp.StatusCode)
+	}
+	return status.Error(codeFromHTTPStatus(resp.StatusCode), msg)
+}
+
+// codeFromHTTPStatus maps HTTP status codes to gRPC codes as specified in
+// https://github.com/grpc/grpc/blob/master/doc/http-grpc-status-mapping.md.
+func codeFromHTTPStatus(code int) codes.Code {
+	switch code {
+	case http.StatusBadRequest:
+		return codes.Internal
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.Unimplemented
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return codes.Unavailable
+	default:
+		return codes.Unknown
+	}
+}
+
+// transportError converts an error sending a request or reading a response to a
+// status error.
+func transportError(ctx context.Context, err error) error {
+	if ctx.Err() != nil {
+		return status.FromContextError(ctx.Err()).Err()
+	}
+	return status.Error(codes.Unavailable, err.Error())
+}
+
+// unmarshalResponse decodes data, the response body, into out. If responseBody is
+// set, data is the value of this field of the response.
+func (c *Client) unmarshalResponse(data []byte, responseBody string, out proto.Message) error {
+	if responseBody != "" {
+		path := strings.Split(responseBody, ".")
+		for i := len(path) - 1; i >= 0; i-- {
+			wrapped, err := json.Marshal(map[string]json.RawMessage{path[i]: data})
+			if err != nil {
+				return err
+			}
+			data = wrapped
+		}
+	}
+	if err := c.marshaler.Unmarshal(data, out); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,333 @@
+package httpclient_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime/httpclient"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime/internal/examplepb"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+var (
+	getBindings = []httpclient.Binding{
+		{HTTPMethod: "GET", PathTemplate: "/v1/messages/{string_value}/{int32_value}"},
+		{HTTPMethod: "GET", PathTemplate: "/v1/messages/{string_value}"},
+	}
+	postBindings = []httpclient.Binding{
+		{HTTPMethod: "POST", PathTemplate: "/v1/messages/{string_value}", Body: "nested"},
+	}
+	postAllBindings = []httpclient.Binding{
+		{HTTPMethod: "POST", PathTemplate: "/v1/messages", Body: "*"},
+	}
+	responseBodyBindings = []httpclient.Binding{
+		{HTTPMethod: "GET", PathTemplate: "/v1/response", ResponseBody: "response"},
+	}
+	errorBindings = []httpclient.Binding{
+		{HTTPMethod: "GET", PathTemplate: "/v1/error"},
+	}
+	streamBindings = []httpclient.Binding{
+		{HTTPMethod: "GET", PathTemplate: "/v1/stream/{string_value}"},
+	}
+)
+
+// newTestServer starts a gateway serving the bindings above like generated
+// handlers would, and returns a client of it.
+func newTestServer(t *testing.T) *httpclient.Client {
+	t.Helper()
+	mux := runtime.NewServeMux()
+	marshaler := &runtime.JSONPb{}
+
+	// echo returns the request parsed from the path, query and body, with the
+	// incoming metadata in the header metadata.
+	echo := func(w http.ResponseWriter, r *http.Request, params map[string]string, body string) {
+		ctx, err := runtime.AnnotateIncomingContext(r.Context(), mux, r, "/test.Service/Echo")
+		if err != nil {
+			runtime.HTTPError(ctx, mux, marshaler, w, r, err)
+			return
+		}
+		msg := new(examplepb.Proto3Message)
+		switch body {
+		case "*":
+			err = marshaler.NewDecoder(r.Body).Decode(msg)
+		case "nested":
+			msg.Nested = new(examplepb.Proto3Message)
+			err = marshaler.NewDecoder(r.Body).Decode(msg.Nested)
+		}
+		if err != nil {
+			runtime.HTTPError(ctx, mux, marshaler, w, r, status.Error(codes.InvalidArgument, err.Error()))
+			return
+		}
+		for k, v := range params {
+			if err := runtime.PopulateFieldFromPath(msg, k, v); err != nil {
+				runtime.HTTPError(ctx, mux, marshaler, w, r, status.Error(codes.InvalidArgument, err.Error()))
+				return
+			}
+		}
+		if err := r.ParseForm(); err != nil {
+			runtime.HTTPError(ctx, mux, marshaler, w, r, status.Error(codes.InvalidArgument, err.Error()))
+			return
+		}
+		if err := runtime.PopulateQueryParameters(msg, r.Form, utilities.NewDoubleArray(nil)); err != nil {
+			runtime.HTTPError(ctx, mux, marshaler, w, r, status.Error(codes.InvalidArgument, err.Error()))
+			return
+		}
+		md, _ := metadata.FromIncomingContext(ctx)
+		if v := md.Get("baz-bin"); len(v) > 0 {
+			msg.BytesValue = []byte(v[0])
+		}
+		ctx = runtime.NewServerMetadataContext(ctx, runtime.ServerMetadata{
+			HeaderMD:  metadata.Pairs("foo", strings.Join(md.Get("foo"), ",")),
+			TrailerMD: metadata.Pairs("trailer", "value"),
+		})
+		runtime.ForwardResponseMessage(ctx, mux, marshaler, w, r, msg)
+	}
+	for _, spec := range []struct {
+		meth, pattern, body string
+	}{
+		{"GET", "/v1/messages/{string_value}/{int32_value}", ""},
+		{"GET", "/v1/messages/{string_value}", ""},
+		{"POST", "/v1/messages/{string_value}", "nested"},
+		{"POST", "/v1/messages", "*"},
+	} {
+		body := spec.body
+		if err := mux.HandlePath(spec.meth, spec.pattern, func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+			echo(w, r, params, body)
+		}); err != nil {
+			t.Fatalf("mux.HandlePath(%q, %q) failed with %v; want success", spec.meth, spec.pattern, err)
+		}
+	}
+	if err := mux.HandlePath("GET", "/v1/response", func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, `{"data":"foo"}`)
+	}); err != nil {
+		t.Fatalf("mux.HandlePath() failed with %v; want success", err)
+	}
+	if err := mux.HandlePath("GET", "/v1/error", func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		st, _ := status.New(codes.NotFound, "not found").WithDetails(&errdetails.ResourceInfo{ResourceName: "foo"})
+		runtime.HTTPError(r.Context(), mux, marshaler, w, r, st.Err())
+	}); err != nil {
+		t.Fatalf("mux.HandlePath() failed with %v; want success", err)
+	}
+	if err := mux.HandlePath("GET", "/v1/stream/{string_value}", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		if params["string_value"] == "fail" {
+			runtime.HTTPError(r.Context(), mux, marshaler, w, r, status.Error(codes.PermissionDenied, "denied"))
+			return
+		}
+		ctx := runtime.NewServerMetadataContext(r.Context(), runtime.ServerMetadata{
+			HeaderMD: metadata.Pairs("stream", "header"),
+		})
+		var n int32
+		runtime.ForwardResponseStream(ctx, mux, marshaler, w, r, func() (proto.Message, error) {
+			n++
+			if n > 3 {
+				return nil, status.Error(codes.ResourceExhausted, "no more")
+			}
+			return &examplepb.Proto3Message{StringValue: params["string_value"], Int32Value: n}, nil
+		})
+	}); err != nil {
+		t.Fatalf("mux.HandlePath() failed with %v; want success", err)
+	}
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	c, err := httpclient.New(server.URL)
+	if err != nil {
+		t.Fatalf("httpclient.New(%q) failed with %v; want success", server.URL, err)
+	}
+	return c
+}
+
+func TestNew(t *testing.T) {
+	for _, baseURL := range []string{"", "localhost:8080", "/v1", "http://%zz"} {
+		if _, err := httpclient.New(baseURL); err == nil {
+			t.Errorf("httpclient.New(%q) succeeded; want an error", baseURL)
+		}
+	}
+}
+
+func TestInvoke(t *testing.T) {
+	c := newTestServer(t)
+	for _, spec := range []struct {
+		name     string
+		bindings []httpclient.Binding
+		in       *examplepb.Proto3Message
+	}{
+		{
+			name:     "first binding",
+			bindings: getBindings,
+			in:       &examplepb.Proto3Message{StringValue: "a b", Int32Value: 3, RepeatedValue: []string{"x", "y"}},
+		},
+		{
+			name:     "fallback binding",
+			bindings: getBindings,
+			in:       &examplepb.Proto3Message{StringValue: "a", BoolValue: true},
+		},
+		{
+			name:     "body field",
+			bindings: postBindings,
+			in: &examplepb.Proto3Message{
+				StringValue: "a",
+				Int64Value:  64,
+				Nested:      &examplepb.Proto3Message{StringValue: "nested"},
+			},
+		},
+		{
+			name:     "whole body",
+			bindings: postAllBindings,
+			in: &examplepb.Proto3Message{
+				StringValue: "a",
+				Nested:      &examplepb.Proto3Message{StringValue: "nested"},
+				MapValue:    map[string]string{"k": "v"},
+			},
+		},
+	} {
+		t.Run(spec.name, func(t *testing.T) {
+			out := new(examplepb.Proto3Message)
+			if err := c.Invoke(context.Background(), "/test.Service/Echo", spec.bindings, spec.in, out); err != nil {
+				t.Fatalf("c.Invoke() failed with %v; want success", err)
+			}
+			if diff := cmp.Diff(out, spec.in, protocmp.Transform()); diff != "" {
+				t.Errorf("c.Invoke() response differs (-got +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestInvokeMetadata(t *testing.T) {
+	c := newTestServer(t)
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "foo", "bar", "baz-bin", "\x00\x01")
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+
+	var header, trailer metadata.MD
+	in := &examplepb.Proto3Message{StringValue: "a"}
+	out := new(examplepb.Proto3Message)
+	if err := c.Invoke(ctx, "/test.Service/Echo", getBindings, in, out, grpc.Header(&header), grpc.Trailer(&trailer)); err != nil {
+		t.Fatalf("c.Invoke() failed with %v; want success", err)
+	}
+	if got, want := header.Get("foo"), []string{"bar"}; !cmp.Equal(got, want) {
+		t.Errorf("header.Get(%q) = %q; want %q", "foo", got, want)
+	}
+	if got, want := out.GetBytesValue(), []byte{0, 1}; !cmp.Equal(got, want) {
+		t.Errorf("incoming metadata %q = %q; want %q", "baz-bin", got, want)
+	}
+	if got, want := trailer.Get("trailer"), []string{"value"}; !cmp.Equal(got, want) {
+		t.Errorf("trailer.Get(%q) = %q; want %q", "trailer", got, want)
+	}
+}
+
+func TestInvokeResponseBody(t *testing.T) {
+	c := newTestServer(t)
+	out := new(examplepb.ResponseBodyOut)
+	if err := c.Invoke(context.Background(), "/test.Service/ResponseBody", responseBodyBindings, new(examplepb.SimpleMessage), out); err != nil {
+		t.Fatalf("c.Invoke() failed with %v; want success", err)
+	}
+	if got, want := out.GetResponse().GetData(), "foo"; got != want {
+		t.Errorf("out.Response.Data = %q; want %q", got, want)
+	}
+}
+
+func TestInvokeErrors(t *testing.T) {
+	c := newTestServer(t)
+
+	err := c.Invoke(context.Background(), "/test.Service/Error", errorBindings, new(examplepb.SimpleMessage), new(examplepb.SimpleMessage))
+	st := status.Convert(err)
+	if got, want := st.Code(), codes.NotFound; got != want {
+		t.Errorf("status.Code(c.Invoke()) = %v; want %v", got, want)
+	}
+	if got, want := st.Message(), "not found"; got != want {
+		t.Errorf("status.Message(c.Invoke()) = %q; want %q", got, want)
+	}
+	if got := st.Details(); len(got) != 1 {
+		t.Errorf("status.Details(c.Invoke()) = %v; want a ResourceInfo", got)
+	} else if info, ok := got[0].(*errdetails.ResourceInfo); !ok || info.GetResourceName() != "foo" {
+		t.Errorf("status.Details(c.Invoke())[0] = %v; want a ResourceInfo of foo", got[0])
+	}
+
+	// Not a binding of the gateway.
+	notFound := []httpclient.Binding{{HTTPMethod: "GET", PathTemplate: "/v1/unknown"}}
+	err = c.Invoke(context.Background(), "/test.Service/Unknown", notFound, new(examplepb.SimpleMessage), new(examplepb.SimpleMessage))
+	if got, want := status.Code(err), codes.NotFound; got != want {
+		t.Errorf("status.Code(c.Invoke()) = %v; want %v", got, want)
+	}
+
+	// Path parameter not set.
+	err = c.Invoke(context.Background(), "/test.Service/Stream", streamBindings, new(examplepb.Proto3Message), new(examplepb.Proto3Message))
+	if got, want := status.Code(err), codes.InvalidArgument; got != want {
+		t.Errorf("status.Code(c.Invoke()) = %v; want %v", got, want)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = c.Invoke(ctx, "/test.Service/Echo", getBindings, &examplepb.Proto3Message{StringValue: "a"}, new(examplepb.Proto3Message))
+	if got, want := status.Code(err), codes.Canceled; got != want {
+		t.Errorf("status.Code(c.Invoke()) = %v; want %v", got, want)
+	}
+
+	unreachable, err := httpclient.New("http://127.0.0.1:1")
+	if err != nil {
+		t.Fatalf("httpclient.New() failed with %v; want success", err)
+	}
+	err = unreachable.Invoke(context.Background(), "/test.Service/Error", errorBindings, new(examplepb.SimpleMessage), new(examplepb.SimpleMessage))
+	if got, want := status.Code(err), codes.Unavailable; got != want {
+		t.Errorf("status.Code(c.Invoke()) = %v; want %v", got, want)
+	}
+}
+
+func TestServerStream(t *testing.T) {
+	c := newTestServer(t)
+	in := &examplepb.Proto3Message{StringValue: "foo"}
+	stream, err := httpclient.NewServerStream[examplepb.Proto3Message](context.Background(), c, "/test.Service/Stream", streamBindings, in)
+	if err != nil {
+		t.Fatalf("httpclient.NewServerStream() failed with %v; want success", err)
+	}
+	header, err := stream.Header()
+	if err != nil {
+		t.Fatalf("stream.Header() failed with %v; want success", err)
+	}
+	if got, want := header.Get("stream"), []string{"header"}; !cmp.Equal(got, want) {
+		t.Errorf("header.Get(%q) = %q; want %q", "stream", got, want)
+	}
+	for i := int32(1); i <= 3; i++ {
+		msg, err := stream.Recv()
+		if err != nil {
+			t.Fatalf("stream.Recv() failed with %v; want success", err)
+		}
+		want := &examplepb.Proto3Message{StringValue: "foo", Int32Value: i}
+		if diff := cmp.Diff(msg, want, protocmp.Transform()); diff != "" {
+			t.Errorf("stream.Recv() differs (-got +want):\n%s", diff)
+		}
+	}
+	_, err = stream.Recv()
+	if got, want := status.Code(err), codes.ResourceExhausted; got != want {
+		t.Errorf("status.Code(stream.Recv()) = %v; want %v", got, want)
+	}
+	if _, err2 := stream.Recv(); !errors.Is(err2, err) {
+		t.Errorf("stream.Recv() after an error = %v; want %v", err2, err)
+	}
+}
+
+func TestServerStreamError(t *testing.T) {
+	c := newTestServer(t)
+	in := &examplepb.Proto3Message{StringValue: "fail"}
+	_, err := httpclient.NewServerStream[examplepb.Proto3Message](context.Background(), c, "/test.Service/Stream", streamBindings, in)
+	if got, want := status.Code(err), codes.PermissionDenied; got != want {
+		t.Errorf("status.Code(httpclient.NewServerStream()) = %v; want %v", got, want)
+	}
+}
@@ -0,0 +1,9 @@
+/*
+Package httpclient contains runtime helper functions used by the HTTP clients
+which protoc-gen-grpc-gateway-client generates.
+
+The generated clients implement the gRPC client interfaces of the services,
+but send the calls as HTTP/JSON requests to a gateway, following the
+google.api.http bindings of the methods like the gateway does.
+*/
+package httpclient
@@ -0,0 +1,30 @@
+package httpclient
+
+import (
+	"net/url"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime/internal/httprequest"
+	"google.golang.org/protobuf/proto"
+)
+
+// ExpandPath substitutes the variables of a path template with the values of the
+// fields of msg they are bound to, e.g. "/v1/{name=shelves/*}" becomes
+// "/v1/shelves/1" if the name field is "shelves/1". It also returns the field paths
+// of the variables, which must not be sent as query parameters.
+func ExpandPath(template string, msg proto.Message) (string, []string, error) {
+	return httprequest.ExpandPath(template, msg)
+}
+
+// QueryValues encodes the populated fields of msg as query parameters, in the
+// format runtime.DefaultQueryParser parses. Fields whose path is in exclude, e.g.
+// the fields bound to the path or the body, and their subfields are left out.
+func QueryValues(msg proto.Message, exclude ...string) (url.Values, error) {
+	return httprequest.QueryValues(msg, exclude...)
+}
+
+// marshalBody marshals the body of a request, the whole message but the fields
+// bound to the path, params, if body is "*" or the field at the path body
+// otherwise.
+func (c *Client) marshalBody(msg proto.Message, body string, params []string) ([]byte, error) {
+	return httprequest.MarshalBody(c.marshaler.Marshal, msg, body, params)
+}
@@ -0,0 +1,196 @@
+package httpclient_test
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime/httpclient"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime/internal/examplepb"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestExpandPath(t *testing.T) {
+	for _, spec := range []struct {
+		name       string
+		template   string
+		msg        proto.Message
+		wantPath   string
+		wantParams []string
+		wantErr    bool
+	}{
+		{
+			name:     "literal",
+			template: "/v1/example",
+			msg:      &examplepb.SimpleMessage{},
+			wantPath: "/v1/example",
+		},
+		{
+			name:       "single segment",
+			template:   "/v1/example/{id}",
+			msg:        &examplepb.SimpleMessage{Id: "a/b c"},
+			wantPath:   "/v1/example/a%2Fb%20c",
+			wantParams: []string{"id"},
+		},
+		{
+			name:       "multiple segments",
+			template:   "/v1/{id=shelves/*/books/*}:publish",
+			msg:        &examplepb.SimpleMessage{Id: "shelves/1/books/a b"},
+			wantPath:   "/v1/shelves/1/books/a%20b:publish",
+			wantParams: []string{"id"},
+		},
+		{
+			name:     "nested field",
+			template: "/v1/{nested.string_value}/{enum_value}/{int32_value}",
+			msg: &examplepb.Proto3Message{
+				Nested:     &examplepb.Proto3Message{StringValue: "foo"},
+				EnumValue:  examplepb.EnumValue_Y,
+				Int32Value: 3,
+			},
+			wantPath:   "/v1/foo/Y/3",
+			wantParams: []string{"nested.string_value", "enum_value", "int32_value"},
+		},
+		{
+			name:       "repeated field",
+			template:   "/v1/{repeated_value}",
+			msg:        &examplepb.Proto3Message{RepeatedValue: []string{"a", "b"}},
+			wantPath:   "/v1/a%2Cb",
+			wantParams: []string{"repeated_value"},
+		},
+		{
+			name:     "unset field",
+			template: "/v1/example/{id}",
+			msg:      &examplepb.SimpleMessage{},
+			wantErr:  true,
+		},
+		{
+			name:     "unset parent",
+			template: "/v1/{nested.string_value}",
+			msg:      &examplepb.Proto3Message{},
+			wantErr:  true,
+		},
+		{
+			name:     "unknown field",
+			template: "/v1/example/{name}",
+			msg:      &examplepb.SimpleMessage{Id: "foo"},
+			wantErr:  true,
+		},
+	} {
+		t.Run(spec.name, func(t *testing.T) {
+			path, params, err := httpclient.ExpandPath(spec.template, spec.msg)
+			if spec.wantErr {
+				if err == nil {
+					t.Errorf("httpclient.ExpandPath(%q) = %q; want an error", spec.template, path)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("httpclient.ExpandPath(%q) failed with %v; want success", spec.template, err)
+			}
+			if path != spec.wantPath {
+				t.Errorf("path = %q; want %q", path, spec.wantPath)
+			}
+			if !reflect.DeepEqual(params, spec.wantParams) {
+				t.Errorf("params = %q; want %q", params, spec.wantParams)
+			}
+		})
+	}
+}
+
+func TestQueryValues(t *testing.T) {
+	msg := &examplepb.Proto3Message{
+		Nested: &examplepb.Proto3Message{
+			StringValue: "nested",
+			Nested:      &examplepb.Proto3Message{BoolValue: true},
+		},
+		FloatValue:         1.5,
+		DoubleValue:        2.25,
+		Int64Value:         -64,
+		Int32Value:         -32,
+		Uint64Value:        64,
+		Uint32Value:        32,
+		BoolValue:          true,
+		StringValue:        "a b&c=d",
+		BytesValue:         []byte{0xfb, 0xff},
+		RepeatedValue:      []string{"a", "b"},
+		OptionalValue:      proto.String(""),
+		RepeatedMessage:    []*wrapperspb.UInt64Value{wrapperspb.UInt64(1), wrapperspb.UInt64(2)},
+		EnumValue:          examplepb.EnumValue_Y,
+		RepeatedEnum:       []examplepb.EnumValue{examplepb.EnumValue_X, examplepb.EnumValue_Z},
+		TimestampValue:     timestamppb.New(time.Date(2016, 5, 10, 10, 19, 13, 123456789, time.UTC)),
+		DurationValue:      durationpb.New(90 * time.Second),
+		FieldmaskValue:     &fieldmaskpb.FieldMask{Paths: []string{"a", "b.c"}},
+		OneofValue:         &examplepb.Proto3Message_OneofStringValue{OneofStringValue: "oneof"},
+		WrapperDoubleValue: wrapperspb.Double(0.5),
+		WrapperBytesValue:  wrapperspb.Bytes([]byte("bytes")),
+		WrapperStringValue: wrapperspb.String(""),
+		MapValue:           map[string]string{"a": "1", "b c": "2"},
+		MapValue3:          map[int32]string{-1: "minus"},
+		MapValue16:         map[string]*wrapperspb.UInt64Value{"u": wrapperspb.UInt64(16)},
+		StructValueValue:   structpb.NewStringValue("value"),
+		StructValue: &structpb.Struct{Fields: map[string]*structpb.Value{
+			"k": structpb.NewNumberValue(1),
+		}},
+	}
+	values, err := httpclient.QueryValues(msg)
+	if err != nil {
+		t.Fatalf("httpclient.QueryValues() failed with %v; want success", err)
+	}
+	if got, want := values.Get("timestamp_value"), "2016-05-10T10:19:13.123456789Z"; got != want {
+		t.Errorf("values.Get(%q) = %q; want %q", "timestamp_value", got, want)
+	}
+	if got, want := values.Get("map_value[b c]"), "2"; got != want {
+		t.Errorf("values.Get(%q) = %q; want %q", "map_value[b c]", got, want)
+	}
+
+	// The values must be parsed back into the same message by the gateway.
+	query, err := url.ParseQuery(values.Encode())
+	if err != nil {
+		t.Fatalf("url.ParseQuery(%q) failed with %v; want success", values.Encode(), err)
+	}
+	got := new(examplepb.Proto3Message)
+	if err := runtime.PopulateQueryParameters(got, query, utilities.NewDoubleArray(nil)); err != nil {
+		t.Fatalf("runtime.PopulateQueryParameters(%q) failed with %v; want success", values.Encode(), err)
+	}
+	if diff := cmp.Diff(got, msg, protocmp.Transform()); diff != "" {
+		t.Errorf("round trip through %q differs (-got +want):\n%s", values.Encode(), diff)
+	}
+}
+
+func TestQueryValuesExclude(t *testing.T) {
+	msg := &examplepb.Proto3Message{
+		Nested:      &examplepb.Proto3Message{StringValue: "nested", Int32Value: 1},
+		StringValue: "path",
+		Int32Value:  2,
+	}
+	values, err := httpclient.QueryValues(msg, "string_value", "nested.string_value")
+	if err != nil {
+		t.Fatalf("httpclient.QueryValues() failed with %v; want success", err)
+	}
+	want := url.Values{
+		"int32_value":        {"2"},
+		"nested.int32_value": {"1"},
+	}
+	if !reflect.DeepEqual(values, want) {
+		t.Errorf("httpclient.QueryValues() = %v; want %v", values, want)
+	}
+}
+
+func TestQueryValuesRepeatedMessage(t *testing.T) {
+	msg := &examplepb.RepeatedResponseBodyOut{
+		Response: []*examplepb.RepeatedResponseBodyOut_Response{{Data: "foo"}},
+	}
+	if values, err := httpclient.QueryValues(msg); err == nil {
+		t.Errorf("httpclient.QueryValues() = %v; want an error", values)
+	}
+}
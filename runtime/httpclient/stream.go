@@ -0,0 +1,153 @@
+package httpclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// ServerStream is the client side of a server streaming call sent to a gateway. It
+// implements grpc.ServerStreamingClient.
+type ServerStream[Res any, PRes interface {
+	*Res
+	proto.Message
+}] struct {
+	ctx          context.Context
+	client       *Client
+	method       string
+	resp         *http.Response
+	decoder      *json.Decoder
+	responseBody string
+	err          error
+}
+
+var _ grpc.ServerStreamingClient[emptypb.Empty] = (*ServerStream[emptypb.Empty, *emptypb.Empty])(nil)
+
+// NewServerStream sends the server streaming call of method with the request in,
+// picking the binding like Invoke does. The response messages are read from the
+// chunks of the response body with Recv.
+//
+// The grpc.Header call option is supported, other options are ignored.
+func NewServerStream[Res any, PRes interface {
+	*Res
+	proto.Message
+}](ctx context.Context, c *Client, method string, bindings []Binding, in proto.Message, opts ...grpc.CallOption) (*ServerStream[Res, PRes], error) {
+	resp, b, err := c.send(ctx, method, bindings, in, opts)
+	if err != nil {
+		return nil, err
+	}
+	setHeader(opts, resp)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		defer resp.Body.Close()
+		return nil, c.statusError(resp)
+	}
+	return &ServerStream[Res, PRes]{
+		ctx:          ctx,
+		client:       c,
+		method:       method,
+		resp:         resp,
+		decoder:      json.NewDecoder(resp.Body),
+		responseBody: b.ResponseBody,
+	}, nil
+}
+
+// Recv returns the next message of the stream. It returns io.EOF once the stream
+// ended successfully, or the error the gateway sent.
+func (s *ServerStream[Res, PRes]) Recv() (*Res, error) {
+	m := PRes(new(Res))
+	if err := s.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// RecvMsg reads the next message of the stream into m, which must be of the
+// response type of the method.
+func (s *ServerStream[Res, PRes]) RecvMsg(m any) error {
+	if s.err != nil {
+		return s.err
+	}
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return status.Errorf(codes.Internal, "%T is not a proto message", m)
+	}
+	s.err = s.recv(msg)
+	if s.err != nil {
+		s.resp.Body.Close()
+	}
+	return s.err
+}
+
+func (s *ServerStream[Res, PRes]) recv(m proto.Message) error {
+	var chunk struct {
+		Result json.RawMessage `json:"result"`
+		Error  json.RawMessage `json:"error"`
+	}
+	if err := s.decoder.Decode(&chunk); err != nil {
+		if errors.Is(err, io.EOF) {
+			return io.EOF
+		}
+		if s.ctx.Err() != nil {
+			return status.FromContextError(s.ctx.Err()).Err()
+		}
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			return status.Errorf(codes.Internal, "failed to decode a chunk of %s: %v", s.method, err)
+		}
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	if len(chunk.Error) > 0 {
+		st := new(spb.Status)
+		if err := s.client.marshaler.Unmarshal(chunk.Error, st); err != nil {
+			return status.Errorf(codes.Internal, "failed to decode an error of %s: %v", s.method, err)
+		}
+		return status.ErrorProto(st)
+	}
+	if len(chunk.Result) == 0 {
+		return status.Errorf(codes.Internal, "chunk of %s has no result", s.method)
+	}
+	if err := s.client.unmarshalResponse(chunk.Result, s.responseBody, m); err != nil {
+		return status.Errorf(codes.Internal, "failed to decode a chunk of %s: %v", s.method, err)
+	}
+	return nil
+}
+
+// Header returns the header metadata sent by the server.
+func (s *ServerStream[Res, PRes]) Header() (metadata.MD, error) {
+	return responseMetadata(s.resp.Header, runtime.MetadataHeaderPrefix), nil
+}
+
+// Trailer returns the trailer metadata sent by the server. It is only available
+// once Recv returned an error, io.EOF included.
+func (s *ServerStream[Res, PRes]) Trailer() metadata.MD {
+	if s.err == nil {
+		return nil
+	}
+	return responseMetadata(s.resp.Trailer, runtime.MetadataTrailerPrefix)
+}
+
+// CloseSend does nothing, as the request is sent when the stream is created.
+func (s *ServerStream[Res, PRes]) CloseSend() error {
+	return nil
+}
+
+// Context returns the context of the stream.
+func (s *ServerStream[Res, PRes]) Context() context.Context {
+	return s.ctx
+}
+
+// SendMsg is not supported, as the request is sent when the stream is created.
+func (s *ServerStream[Res, PRes]) SendMsg(any) error {
+	return status.Error(codes.Internal, "SendMsg is not supported by server streams")
+}
@@ -0,0 +1,350 @@
+// Package httprequest encodes request messages into the paths, the query
+// parameters and the bodies of the HTTP requests of their bindings, as the
+// gateway decodes them. It is shared by runtime.NewRouteRequest and the
+// generated HTTP clients.
+package httprequest
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// pathParams returns the field paths of the variables of a path template, e.g.
+// ["name"] for "/v1/{name=shelves/*}".
+func pathParams(template string) []string {
+	var params []string
+	for {
+		start := strings.IndexByte(template, '{')
+		if start < 0 {
+			return params
+		}
+		end := strings.IndexByte(template[start:], '}')
+		if end < 0 {
+			return params
+		}
+		name, _, _ := strings.Cut(template[start+1:start+end], "=")
+		params = append(params, name)
+		template = template[start+end+1:]
+	}
+}
+
+// PathParamsSet reports whether all the fields bound to variables of template are
+// set in msg.
+func PathParamsSet(template string, msg proto.Message) bool {
+	for _, param := range pathParams(template) {
+		m, fd, err := resolveField(msg.ProtoReflect(), param)
+		if err != nil || m == nil || !m.Has(fd) {
+			return false
+		}
+	}
+	return true
+}
+
+// ExpandPath substitutes the variables of a path template with the values of the
+// fields of msg they are bound to, e.g. "/v1/{name=shelves/*}" becomes
+// "/v1/shelves/1" if the name field is "shelves/1". It also returns the field paths
+// of the variables, which must not be sent as query parameters.
+func ExpandPath(template string, msg proto.Message) (string, []string, error) {
+	var sb strings.Builder
+	var params []string
+	rest := template
+	for {
+		start := strings.IndexByte(rest, '{')
+		if start < 0 {
+			sb.WriteString(rest)
+			return sb.String(), params, nil
+		}
+		end := strings.IndexByte(rest[start:], '}')
+		if end < 0 {
+			return "", nil, fmt.Errorf("unterminated variable in path template %q", template)
+		}
+		sb.WriteString(rest[:start])
+		name, pattern, _ := strings.Cut(rest[start+1:start+end], "=")
+		rest = rest[start+end+1:]
+
+		m, fd, err := resolveField(msg.ProtoReflect(), name)
+		if err != nil {
+			return "", nil, err
+		}
+		var value string
+		if m != nil {
+			if value, err = formatPathValue(fd, m.Get(fd)); err != nil {
+				return "", nil, fmt.Errorf("field %q: %w", name, err)
+			}
+		}
+		if value == "" {
+			return "", nil, fmt.Errorf("field %q bound to the path of %q is not set", name, template)
+		}
+		if pattern == "" || pattern == "*" {
+			sb.WriteString(url.PathEscape(value))
+		} else {
+			segments := strings.Split(value, "/")
+			for i, segment := range segments {
+				segments[i] = url.PathEscape(segment)
+			}
+			sb.WriteString(strings.Join(segments, "/"))
+		}
+		params = append(params, name)
+	}
+}
+
+// formatPathValue formats the value of a field bound to a path variable. Repeated
+// fields are joined with commas, the default separator of the gateway.
+func formatPathValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) (string, error) {
+	if fd.IsMap() {
+		return "", fmt.Errorf("map fields cannot be bound to the path")
+	}
+	if !fd.IsList() {
+		return formatValue(fd, v)
+	}
+	values := make([]string, v.List().Len())
+	for i := range values {
+		s, err := formatValue(fd, v.List().Get(i))
+		if err != nil {
+			return "", err
+		}
+		values[i] = s
+	}
+	return strings.Join(values, ","), nil
+}
+
+// resolveField returns the field at the dotted path in msg, and the message it
+// belongs to. The message is nil if one of the parent messages is not set.
+func resolveField(msg protoreflect.Message, path string) (protoreflect.Message, protoreflect.FieldDescriptor, error) {
+	names := strings.Split(path, ".")
+	for i, name := range names {
+		fd := msg.Descriptor().Fields().ByName(protoreflect.Name(name))
+		if fd == nil {
+			fd = msg.Descriptor().Fields().ByJSONName(name)
+		}
+		if fd == nil {
+			return nil, nil, fmt.Errorf("no field %q in message %s", path, msg.Descriptor().FullName())
+		}
+		if i == len(names)-1 {
+			return msg, fd, nil
+		}
+		if fd.Message() == nil || fd.IsList() || fd.IsMap() {
+			return nil, nil, fmt.Errorf("field %q of message %s is not a singular message", name, msg.Descriptor().FullName())
+		}
+		if !msg.Has(fd) {
+			return nil, fd, nil
+		}
+		msg = msg.Get(fd).Message()
+	}
+	return nil, nil, fmt.Errorf("empty field path")
+}
+
+// QueryValues encodes the populated fields of msg as query parameters, in the
+// format runtime.DefaultQueryParser parses. Fields whose path is in exclude, e.g.
+// the fields bound to the path or the body, and their subfields are left out.
+func QueryValues(msg proto.Message, exclude ...string) (url.Values, error) {
+	values := url.Values{}
+	excluded := make(map[string]bool, len(exclude))
+	for _, path := range exclude {
+		excluded[path] = true
+	}
+	if err := appendQueryValues(values, msg.ProtoReflect(), "", excluded); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+func appendQueryValues(values url.Values, msg protoreflect.Message, prefix string, excluded map[string]bool) error {
+	var err error
+	msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		key := prefix + string(fd.Name())
+		if excluded[key] {
+			return true
+		}
+		switch {
+		case fd.IsMap():
+			v.Map().Range(func(k protoreflect.MapKey, mv protoreflect.Value) bool {
+				var s string
+				if s, err = formatValue(fd.MapValue(), mv); err != nil {
+					err = fmt.Errorf("field %q: %w", key, err)
+					return false
+				}
+				values.Add(fmt.Sprintf("%s[%s]", key, k.String()), s)
+				return true
+			})
+		case fd.IsList():
+			for i := 0; i < v.List().Len(); i++ {
+				var s string
+				if s, err = formatValue(fd, v.List().Get(i)); err != nil {
+					err = fmt.Errorf("field %q: %w", key, err)
+					return false
+				}
+				values.Add(key, s)
+			}
+		case fd.Message() != nil && !isScalarMessage(fd.Message()):
+			err = appendQueryValues(values, v.Message(), key+".", excluded)
+		default:
+			var s string
+			if s, err = formatValue(fd, v); err != nil {
+				err = fmt.Errorf("field %q: %w", key, err)
+				return false
+			}
+			values.Add(key, s)
+		}
+		return err == nil
+	})
+	return err
+}
+
+// isScalarMessage reports whether messages of md are encoded as a single query
+// parameter or path segment rather than one per field.
+func isScalarMessage(md protoreflect.MessageDescriptor) bool {
+	switch md.FullName() {
+	case "google.protobuf.Timestamp",
+		"google.protobuf.Duration",
+		"google.protobuf.DoubleValue",
+		"google.protobuf.FloatValue",
+		"google.protobuf.Int64Value",
+		"google.protobuf.Int32Value",
+		"google.protobuf.UInt64Value",
+		"google.protobuf.UInt32Value",
+		"google.protobuf.BoolValue",
+		"google.protobuf.StringValue",
+		"google.protobuf.BytesValue",
+		"google.protobuf.FieldMask",
+		"google.protobuf.Value",
+		"google.protobuf.Struct":
+		return true
+	}
+	return false
+}
+
+// formatValue formats a single value of the field fd, i.e. an element if the field
+// is repeated, as runtime.DefaultQueryParser parses it.
+func formatValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) (string, error) {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return strconv.FormatBool(v.Bool()), nil
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			return string(ev.Name()), nil
+		}
+		return strconv.Itoa(int(v.Enum())), nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case protoreflect.FloatKind:
+		return strconv.FormatFloat(v.Float(), 'g', -1, 32), nil
+	case protoreflect.DoubleKind:
+		return strconv.FormatFloat(v.Float(), 'g', -1, 64), nil
+	case protoreflect.StringKind:
+		return v.String(), nil
+	case protoreflect.BytesKind:
+		return base64.URLEncoding.EncodeToString(v.Bytes()), nil
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return formatMessage(v.Message())
+	default:
+		return "", fmt.Errorf("unsupported field kind %v", fd.Kind())
+	}
+}
+
+func formatMessage(msg protoreflect.Message) (string, error) {
+	md := msg.Descriptor()
+	switch md.FullName() {
+	case "google.protobuf.Timestamp":
+		ts := msg.Interface().(*timestamppb.Timestamp)
+		if err := ts.CheckValid(); err != nil {
+			return "", err
+		}
+		return ts.AsTime().Format("2006-01-02T15:04:05.999999999Z07:00"), nil
+	case "google.protobuf.Duration":
+		d := msg.Interface().(*durationpb.Duration)
+		if err := d.CheckValid(); err != nil {
+			return "", err
+		}
+		return d.AsDuration().String(), nil
+	case "google.protobuf.FieldMask":
+		return strings.Join(msg.Interface().(*fieldmaskpb.FieldMask).GetPaths(), ","), nil
+	case "google.protobuf.Value", "google.protobuf.Struct":
+		b, err := protojson.Marshal(msg.Interface())
+		if err != nil {
+			return "", err
+		}
+		return string(b), nil
+	}
+	if isScalarMessage(md) {
+		fd := md.Fields().ByName("value")
+		return formatValue(fd, msg.Get(fd))
+	}
+	return "", fmt.Errorf("message %s cannot be encoded as a single value", md.FullName())
+}
+
+// MarshalBody marshals the body of a request with marshal: the whole message if
+// body is "*", without the fields at the paths in pathParams, which are bound to
+// the path, or the field at the path body otherwise.
+func MarshalBody(marshal func(v interface{}) ([]byte, error), msg proto.Message, body string, pathParams []string) ([]byte, error) {
+	if body == "*" {
+		if len(pathParams) == 0 {
+			return marshal(msg)
+		}
+		msg = proto.Clone(msg)
+		for _, param := range pathParams {
+			m, fd, err := resolveField(msg.ProtoReflect(), param)
+			if err != nil {
+				return nil, err
+			}
+			if m != nil {
+				m.Clear(fd)
+			}
+		}
+		return marshal(msg)
+	}
+	m, fd, err := resolveField(msg.ProtoReflect(), body)
+	if err != nil {
+		return nil, err
+	}
+	if m == nil || (!m.Has(fd) && fd.Message() != nil && !fd.IsList() && !fd.IsMap()) {
+		return []byte("{}"), nil
+	}
+	if fd.Message() != nil && !fd.IsList() && !fd.IsMap() {
+		return marshal(m.Get(fd).Message().Interface())
+	}
+
+	// Marshal a copy of the parent message with only the body field set and
+	// extract its value, so that the field is encoded like the marshaler encodes
+	// messages.
+	parent := m.New()
+	parent.Set(fd, m.Get(fd))
+	b, err := marshal(parent.Interface())
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, err
+	}
+	for _, key := range []string{fd.JSONName(), string(fd.Name())} {
+		if v, ok := fields[key]; ok {
+			return v, nil
+		}
+	}
+	// The marshaler omits unpopulated fields, encode the zero value instead.
+	b, err = protojson.MarshalOptions{EmitUnpopulated: true, UseProtoNames: true}.Marshal(parent.Interface())
+	if err != nil {
+		return nil, err
+	}
+	fields = nil
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, err
+	}
+	return fields[string(fd.Name())], nil
+}
@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"io"
 	"reflect"
-	"strconv"
 
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
@@ -129,7 +128,12 @@ func (j *JSONPb) marshalNonProtoField(v interface{}) ([]byte, error) {
 				}
 				var err error
 				if j.UseEnumNumbers {
-					_, err = buf.WriteString(strconv.FormatInt(rv.Index(i).Int(), 10))
+					// Enums marshal to their numbers with encoding/json, whether
+					// they are integers or implement json.Marshaler.
+					var b []byte
+					if b, err = json.Marshal(rv.Index(i).Interface()); err == nil {
+						_, err = buf.Write(b)
+					}
 				} else {
 					_, err = buf.WriteString("\"" + rv.Index(i).Interface().(protoEnum).String() + "\"")
 				}
@@ -4,10 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"net/textproto"
 	"regexp"
 	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/internal/httprule"
 	"google.golang.org/grpc"
@@ -57,8 +61,11 @@ type Middleware func(HandlerFunc) HandlerFunc
 // ServeMux is a request multiplexer for grpc-gateway.
 // It matches http requests to patterns and invokes the corresponding handler.
 type ServeMux struct {
-	// handlers maps HTTP method to a list of handlers.
-	handlers                  map[string][]handler
+	// routes holds the route table, which is shared with the ServeMuxes
+	// returned by WithRouteOptions.
+	routes *routeStore
+	// routeOptions are applied to the handlers registered with Handle.
+	routeOptions              []RouteOption
 	middlewares               []Middleware
 	forwardResponseOptions    []func(context.Context, http.ResponseWriter, proto.Message) error
 	forwardResponseRewriter   ForwardResponseRewriter
@@ -75,6 +82,9 @@ type ServeMux struct {
 	unescapingMode            UnescapingMode
 	writeContentLength        bool
 	disableChunkedEncoding    bool
+	deadlinePolicies          map[string]DeadlinePolicy
+	httpStatusMapping         HTTPStatusMapping
+	connectProtocol           bool
 }
 
 // ServeMuxOption is an option that can be given to a ServeMux on construction.
@@ -137,6 +147,72 @@ func WithDisableChunkedEncoding() ServeMuxOption {
 	}
 }
 
+// DeadlinePolicy configures the deadline of calls to a single RPC method.
+type DeadlinePolicy struct {
+	// Default is the timeout applied when the request does not carry one of the
+	// Grpc-Timeout, Request-Timeout or Prefer: wait= headers. If zero, the
+	// default configured in the generated code, or DefaultContextTimeout, is used.
+	Default time.Duration
+	// Max is the upper bound of the timeout of a call, applied to both the
+	// default and the timeout supplied by the client. If zero, the timeout is
+	// not limited.
+	Max time.Duration
+}
+
+// WithDeadlinePolicies returns a ServeMuxOption that configures the default and maximum
+// deadlines of individual RPC methods. The map is keyed by the full RPC method name in the
+// format of "/package.service/method", as returned by RPCMethod.
+//
+// Policies configured here take precedence over the defaults derived from the backend rules
+// of a gRPC API Configuration at generation time. The option can be given multiple times,
+// in which case later policies replace earlier ones for the same method.
+func WithDeadlinePolicies(policies map[string]DeadlinePolicy) ServeMuxOption {
+	return func(serveMux *ServeMux) {
+		if serveMux.deadlinePolicies == nil {
+			serveMux.deadlinePolicies = make(map[string]DeadlinePolicy, len(policies))
+		}
+		for method, policy := range policies {
+			serveMux.deadlinePolicies[method] = policy
+		}
+	}
+}
+
+// HTTPStatusMapping overrides the HTTP status the gateway replies with for errors.
+type HTTPStatusMapping struct {
+	// Codes maps gRPC codes to HTTP statuses. Codes missing from the map are
+	// converted by HTTPStatusFromCode.
+	Codes map[codes.Code]int
+	// Reasons maps the reason of a google.rpc.ErrorInfo detail of the error to
+	// an HTTP status. It takes precedence over Codes.
+	Reasons map[string]int
+}
+
+// WithHTTPStatusMapping returns a ServeMuxOption that overrides the mapping from gRPC errors to
+// HTTP statuses used by DefaultHTTPErrorHandler, DefaultRoutingErrorHandler and the error
+// responses of server streaming methods.
+//
+// The option can be given multiple times, in which case later entries replace earlier ones
+// for the same code or reason.
+//
+// NOTE: Pass the same mapping to `protoc-gen-openapiv2` and `protoc-gen-openapiv3` with the
+// `http_status_mapping` and `http_status_reason_mapping` options to document the responses.
+func WithHTTPStatusMapping(mapping HTTPStatusMapping) ServeMuxOption {
+	return func(serveMux *ServeMux) {
+		if serveMux.httpStatusMapping.Codes == nil {
+			serveMux.httpStatusMapping.Codes = make(map[codes.Code]int, len(mapping.Codes))
+		}
+		for code, st := range mapping.Codes {
+			serveMux.httpStatusMapping.Codes[code] = st
+		}
+		if serveMux.httpStatusMapping.Reasons == nil {
+			serveMux.httpStatusMapping.Reasons = make(map[string]int, len(mapping.Reasons))
+		}
+		for reason, st := range mapping.Reasons {
+			serveMux.httpStatusMapping.Reasons[reason] = st
+		}
+	}
+}
+
 // SetQueryParameterParser sets the query parameter parser, used to populate message from query parameters.
 // Configuring this will mean the generated OpenAPI output is no longer correct, and it should be
 // done with careful consideration.
@@ -307,11 +383,12 @@ func WithHealthEndpointAt(healthCheckClient grpc_health_v1.HealthClient, endpoin
 			http.MethodGet, endpointPath, func(w http.ResponseWriter, r *http.Request, _ map[string]string,
 			) {
 				_, outboundMarshaler := MarshalerForRequest(s, r)
-				annotatedContext, err := AnnotateContext(r.Context(), s, r, grpc_health_v1.Health_Check_FullMethodName, WithHTTPPathPattern(endpointPath))
+				annotatedContext, cancel, err := AnnotateContextWithCancel(r.Context(), s, r, grpc_health_v1.Health_Check_FullMethodName, WithHTTPPathPattern(endpointPath))
 				if err != nil {
 					s.errorHandler(r.Context(), s, outboundMarshaler, w, r, err)
 					return
 				}
+				defer cancel()
 
 				var md ServerMetadata
 				resp, err := healthCheckClient.Check(annotatedContext, &grpc_health_v1.HealthCheckRequest{
@@ -352,7 +429,6 @@ func WithHealthzEndpoint(healthCheckClient grpc_health_v1.HealthClient) ServeMux
 // NewServeMux returns a new ServeMux whose internal mapping is empty.
 func NewServeMux(opts ...ServeMuxOption) *ServeMux {
 	serveMux := &ServeMux{
-		handlers:                make(map[string][]handler),
 		forwardResponseOptions:  make([]func(context.Context, http.ResponseWriter, proto.Message) error, 0),
 		forwardResponseRewriter: func(ctx context.Context, response proto.Message) (any, error) { return response, nil },
 		marshalers:              makeMarshalerMIMERegistry(),
@@ -361,10 +437,23 @@ func NewServeMux(opts ...ServeMuxOption) *ServeMux {
 		routingErrorHandler:     DefaultRoutingErrorHandler,
 		unescapingMode:          UnescapingModeDefault,
 	}
+	serveMux.routes = newRouteStore()
 
 	for _, opt := range opts {
 		opt(serveMux)
 	}
+	// The handlers registered by options are kept by ReplaceRoutes. They are
+	// marked in copies, as the handler lists are shared with the stored table.
+	serveMux.updateRoutes(func(rt *routeTable) {
+		for meth, handlers := range rt.handlers {
+			marked := make([]handler, len(handlers))
+			for i, h := range handlers {
+				h.fromOption = true
+				marked[i] = h
+			}
+			rt.handlers[meth] = marked
+		}
+	})
 
 	if serveMux.incomingHeaderMatcher == nil {
 		serveMux.incomingHeaderMatcher = DefaultHeaderMatcher
@@ -379,17 +468,131 @@ func NewServeMux(opts ...ServeMuxOption) *ServeMux {
 	return serveMux
 }
 
+// RouteOption is an option that can be given to ServeMux.Handle.
+type RouteOption func(*handler)
+
+// WithRPCMethod returns a RouteOption recording the full name of the gRPC method the
+// handler forwards to, e.g. "/example.v1.EchoService/Echo", so that the handler can be
+// removed with ServeMux.RemoveService. The generated Register* functions set it.
+func WithRPCMethod(name string) RouteOption {
+	return func(h *handler) {
+		if i := strings.LastIndex(name, "/"); i > 0 {
+			h.service = strings.TrimPrefix(name[:i], "/")
+		}
+	}
+}
+
+// A RoutePredicate reports whether a request whose method and path match the pattern
+// of a handler should be handled by it.
+type RoutePredicate func(r *http.Request) bool
+
+// WithRoutePredicates returns a RouteOption restricting the handler to the requests for
+// which all the given predicates hold.
+//
+// Handlers are tried in the reverse order of registration, and the request is handled
+// by the first handler whose pattern and predicates match. A request whose path only
+// matches handlers whose predicates do not hold is answered with 404 Not Found, as if
+// these handlers did not exist.
+func WithRoutePredicates(predicates ...RoutePredicate) RouteOption {
+	return func(h *handler) {
+		h.predicates = append(h.predicates, predicates...)
+	}
+}
+
+// MatchHost returns a RoutePredicate holding for requests to the given host. The
+// comparison is case-insensitive, and the port of the request is ignored unless host
+// includes one. A leading "*." matches any subdomain, e.g. "*.example.com" matches
+// "api.example.com" but not "example.com".
+func MatchHost(host string) RoutePredicate {
+	host = strings.ToLower(host)
+	_, _, err := net.SplitHostPort(host)
+	withPort := err == nil
+	if !withPort {
+		host = strings.TrimSuffix(strings.TrimPrefix(host, "["), "]")
+	}
+	return func(r *http.Request) bool {
+		reqHost := strings.ToLower(r.Host)
+		if !withPort {
+			if h, _, err := net.SplitHostPort(reqHost); err == nil {
+				reqHost = h
+			}
+		}
+		if suffix, ok := strings.CutPrefix(host, "*"); ok {
+			return strings.HasSuffix(reqHost, suffix) && len(reqHost) > len(suffix)
+		}
+		return reqHost == host
+	}
+}
+
+// MatchHeader returns a RoutePredicate holding for requests with a header called name
+// with the given value. If value is empty, the header only needs to be present.
+func MatchHeader(name, value string) RoutePredicate {
+	name = textproto.CanonicalMIMEHeaderKey(name)
+	return func(r *http.Request) bool {
+		values, ok := r.Header[name]
+		if !ok {
+			return false
+		}
+		if value == "" {
+			return true
+		}
+		for _, v := range values {
+			if v == value {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// MatchCookie returns a RoutePredicate holding for requests with a cookie called name
+// with the given value. If value is empty, the cookie only needs to be present.
+func MatchCookie(name, value string) RoutePredicate {
+	return func(r *http.Request) bool {
+		for _, c := range r.CookiesNamed(name) {
+			if value == "" || c.Value == value {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// WithRouteOptions returns a ServeMux sharing the routes and the options of s, whose
+// Handle applies opts to every handler it registers, before the options given to
+// Handle. It allows to attach route options, e.g. predicates, to the handlers
+// registered by the generated Register* functions:
+//
+//	v2 := mux.WithRouteOptions(runtime.WithRoutePredicates(runtime.MatchHeader("Accept-Version", "v2")))
+//	err := examplepb.RegisterEchoServiceHandler(ctx, v2, v2Conn)
+func (s *ServeMux) WithRouteOptions(opts ...RouteOption) *ServeMux {
+	scoped := *s
+	scoped.routeOptions = append(append([]RouteOption(nil), s.routeOptions...), opts...)
+	return &scoped
+}
+
 // Handle associates "h" to the pair of HTTP method and path pattern.
-func (s *ServeMux) Handle(meth string, pat Pattern, h HandlerFunc) {
+//
+// Handle may be called while s is serving requests.
+func (s *ServeMux) Handle(meth string, pat Pattern, h HandlerFunc, opts ...RouteOption) {
 	if len(s.middlewares) > 0 {
 		h = chainMiddlewares(s.middlewares)(h)
 	}
-	s.handlers[meth] = append([]handler{{pat: pat, h: h}}, s.handlers[meth]...)
+	hd := handler{pat: pat, h: h}
+	for _, opt := range s.routeOptions {
+		opt(&hd)
+	}
+	for _, opt := range opts {
+		opt(&hd)
+	}
+	s.updateRoutes(func(rt *routeTable) {
+		rt.handlers[meth] = append([]handler{hd}, rt.handlers[meth]...)
+	})
 }
 
 // HandlePath allows users to configure custom path handlers.
 // refer: https://grpc-ecosystem.github.io/grpc-gateway/docs/operations/inject_router/
-func (s *ServeMux) HandlePath(meth string, pathPattern string, h HandlerFunc) error {
+func (s *ServeMux) HandlePath(meth string, pathPattern string, h HandlerFunc, opts ...RouteOption) error {
 	compiler, err := httprule.Parse(pathPattern)
 	if err != nil {
 		return fmt.Errorf("parsing path pattern: %w", err)
@@ -399,10 +602,129 @@ func (s *ServeMux) HandlePath(meth string, pathPattern string, h HandlerFunc) er
 	if err != nil {
 		return fmt.Errorf("creating new pattern: %w", err)
 	}
-	s.Handle(meth, pattern, h)
+	s.Handle(meth, pattern, h, opts...)
 	return nil
 }
 
+// RemoveHandler removes the handlers associated to the HTTP method meth and a path
+// pattern equal to pat, as reported by Pattern.String. It reports whether any handler
+// was removed.
+//
+// Requests already dispatched to a removed handler are served to completion.
+func (s *ServeMux) RemoveHandler(meth string, pat Pattern) bool {
+	key := pat.String()
+	return s.removeHandlers(func(m string, h handler) bool {
+		return m == meth && h.pat.String() == key
+	}) > 0
+}
+
+// RemoveService removes the handlers forwarding to the gRPC service with the given fully
+// qualified name, e.g. "example.v1.EchoService", and returns the number of removed
+// handlers. Only handlers registered with WithRPCMethod, like the handlers registered by
+// the generated Register* functions, or registered through ReplaceService are known to
+// belong to a service.
+//
+// Requests already dispatched to a removed handler are served to completion.
+func (s *ServeMux) RemoveService(service string) int {
+	return s.removeHandlers(func(_ string, h handler) bool {
+		return h.service == service
+	})
+}
+
+// ReplaceRoutes replaces all the handlers of s with the handlers that register
+// registers, except for the handlers registered by the options s was created
+// with, e.g. WithHealthzEndpoint. The ServeMux given to register shares the
+// configuration of s, so that the new handlers marshal messages and report
+// errors the way s does, but has an empty route table.
+//
+// The new route set takes effect atomically: requests already dispatched keep running
+// the handlers they were dispatched to, and later requests only see the new handlers.
+// If register returns an error, s is left unchanged.
+//
+// The ServeMux given to register must not be used once register returns.
+func (s *ServeMux) ReplaceRoutes(register func(*ServeMux) error) error {
+	staging := s.staging()
+	if err := register(staging); err != nil {
+		return err
+	}
+	added := staging.routes.table.Load()
+	s.updateRoutes(func(rt *routeTable) {
+		for meth, handlers := range rt.handlers {
+			rt.handlers[meth] = filterHandlers(handlers, func(h handler) bool {
+				return !h.fromOption
+			})
+		}
+		for meth, handlers := range added.handlers {
+			rt.handlers[meth] = append(append([]handler(nil), handlers...), rt.handlers[meth]...)
+		}
+	})
+	return nil
+}
+
+// ReplaceService is like ReplaceRoutes, but only replaces the handlers of the gRPC
+// service with the given fully qualified name, e.g. "example.v1.EchoService", as
+// RemoveService would remove them. It can be used to point a service to a new backend:
+//
+//	err := mux.ReplaceService("example.v1.EchoService", func(mux *runtime.ServeMux) error {
+//		return examplepb.RegisterEchoServiceHandler(ctx, mux, conn)
+//	})
+//
+// All the handlers registered by register are considered to belong to service.
+func (s *ServeMux) ReplaceService(service string, register func(*ServeMux) error) error {
+	staging := s.staging()
+	if err := register(staging); err != nil {
+		return err
+	}
+	added := staging.routes.table.Load()
+	s.updateRoutes(func(rt *routeTable) {
+		for meth, handlers := range rt.handlers {
+			rt.handlers[meth] = filterHandlers(handlers, func(h handler) bool {
+				return h.service == service
+			})
+		}
+		for meth, handlers := range added.handlers {
+			hs := make([]handler, 0, len(handlers)+len(rt.handlers[meth]))
+			for _, h := range handlers {
+				h.service = service
+				hs = append(hs, h)
+			}
+			rt.handlers[meth] = append(hs, rt.handlers[meth]...)
+		}
+	})
+	return nil
+}
+
+// staging returns a ServeMux sharing the configuration and the route options of s,
+// whose handlers are registered in a new, empty route table.
+func (s *ServeMux) staging() *ServeMux {
+	staging := *s
+	staging.routes = newRouteStore()
+	return &staging
+}
+
+// updateRoutes stores a copy of the current route table, modified by update.
+func (s *ServeMux) updateRoutes(update func(*routeTable)) {
+	s.routes.mu.Lock()
+	defer s.routes.mu.Unlock()
+	rt := s.routes.table.Load().clone()
+	update(rt)
+	s.routes.table.Store(rt)
+}
+
+func (s *ServeMux) removeHandlers(remove func(meth string, h handler) bool) int {
+	var n int
+	s.updateRoutes(func(rt *routeTable) {
+		for meth, handlers := range rt.handlers {
+			kept := filterHandlers(handlers, func(h handler) bool {
+				return remove(meth, h)
+			})
+			n += len(handlers) - len(kept)
+			rt.handlers[meth] = kept
+		}
+	})
+	return n
+}
+
 // ServeHTTP dispatches the request to the first handler whose pattern matches to r.Method and r.URL.Path.
 func (s *ServeMux) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -442,7 +764,8 @@ func (s *ServeMux) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	lastPathComponent := pathComponents[len(pathComponents)-1]
 
-	for _, h := range s.handlers[r.Method] {
+	routes := s.routes.table.Load()
+	for _, h := range routes.handlers[r.Method] {
 		// If the pattern has a verb, explicitly look for a suffix in the last
 		// component that matches a colon plus the verb. This allows us to
 		// handle some cases that otherwise can't be correctly handled by the
@@ -485,6 +808,9 @@ func (s *ServeMux) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			}
 			continue
 		}
+		if !h.matches(r) {
+			continue
+		}
 		s.handleHandler(h, w, r, pathParams)
 		return
 	}
@@ -495,7 +821,7 @@ func (s *ServeMux) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	// Note we are not eagerly checking the request here as we want to return the
 	// right HTTP status code, and we need to process the fallback candidates in
 	// order to do that.
-	for m, handlers := range s.handlers {
+	for m, handlers := range routes.handlers {
 		if m == r.Method {
 			continue
 		}
@@ -528,6 +854,9 @@ func (s *ServeMux) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				}
 				continue
 			}
+			if !h.matches(r) {
+				continue
+			}
 
 			// X-HTTP-Method-Override is optional. Always allow fallback to POST.
 			// Also, only consider POST -> GET fallbacks, and avoid falling back to
@@ -564,6 +893,64 @@ func (s *ServeMux) isPathLengthFallback(r *http.Request) bool {
 type handler struct {
 	pat Pattern
 	h   HandlerFunc
+	// service is the fully qualified name of the gRPC service the handler
+	// forwards to, if known.
+	service    string
+	predicates []RoutePredicate
+	// fromOption reports whether the handler was registered by a ServeMuxOption.
+	fromOption bool
+}
+
+// matches reports whether all the predicates of h hold for r.
+func (h handler) matches(r *http.Request) bool {
+	for _, p := range h.predicates {
+		if !p(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// routeStore holds the current route table. Tables are never modified once
+// stored, so that requests can be served while handlers are added or removed;
+// changes store a modified copy while holding mu.
+type routeStore struct {
+	mu    sync.Mutex
+	table atomic.Pointer[routeTable]
+}
+
+func newRouteStore() *routeStore {
+	rs := new(routeStore)
+	rs.table.Store(&routeTable{handlers: make(map[string][]handler)})
+	return rs
+}
+
+// routeTable maps HTTP method to a list of handlers, in the order they are
+// matched.
+type routeTable struct {
+	handlers map[string][]handler
+}
+
+// clone returns a copy of rt. Handler lists are shared, so they must be
+// replaced rather than modified in place.
+func (rt *routeTable) clone() *routeTable {
+	handlers := make(map[string][]handler, len(rt.handlers))
+	for meth, hs := range rt.handlers {
+		handlers[meth] = hs
+	}
+	return &routeTable{handlers: handlers}
+}
+
+// filterHandlers returns the handlers for which remove returns false, in a new
+// slice.
+func filterHandlers(handlers []handler, remove func(handler) bool) []handler {
+	kept := make([]handler, 0, len(handlers))
+	for _, h := range handlers {
+		if !remove(h) {
+			kept = append(kept, h)
+		}
+	}
+	return kept
 }
 
 func (s *ServeMux) handleHandler(h handler, w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
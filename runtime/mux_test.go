@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -11,6 +12,7 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
@@ -991,3 +993,374 @@ func TestServeHTTP_WithDisableHTTPMethodOverride(t *testing.T) {
 		t.Errorf("w.Body = %q; want %q", got, want)
 	}
 }
+
+func TestServeMux_RemoveHandler(t *testing.T) {
+	mux := runtime.NewServeMux()
+	for _, meth := range []string{"GET", "POST"} {
+		body := meth + " /foo"
+		if err := mux.HandlePath(meth, "/foo", func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+			_, _ = fmt.Fprint(w, body)
+		}); err != nil {
+			t.Fatalf("mux.HandlePath(%q, %q) failed with %v; want success", meth, "/foo", err)
+		}
+	}
+	pat, err := runtime.NewPattern(1, []int{int(utilities.OpLitPush), 0}, []string{"foo"}, "")
+	if err != nil {
+		t.Fatalf("runtime.NewPattern failed: %v", err)
+	}
+
+	if !mux.RemoveHandler("GET", pat) {
+		t.Errorf("mux.RemoveHandler(%q, %q) = false; want true", "GET", pat)
+	}
+	if mux.RemoveHandler("GET", pat) {
+		t.Errorf("mux.RemoveHandler(%q, %q) = true after removal; want false", "GET", pat)
+	}
+
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest("GET", "/foo", nil))
+	if got, want := w.Code, http.StatusNotImplemented; got != want {
+		t.Errorf("w.Code = %d; want %d", got, want)
+	}
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest("POST", "/foo", nil))
+	if got, want := w.Body.String(), "POST /foo"; got != want {
+		t.Errorf("w.Body = %q; want %q", got, want)
+	}
+}
+
+func TestServeMux_RemoveService(t *testing.T) {
+	mux := runtime.NewServeMux()
+	for _, spec := range []struct {
+		path      string
+		rpcMethod string
+	}{
+		{path: "/v1/a", rpcMethod: "/example.v1.A/Get"},
+		{path: "/v1/a/{id}", rpcMethod: "/example.v1.A/List"},
+		{path: "/v1/b", rpcMethod: "/example.v1.B/Get"},
+	} {
+		if err := mux.HandlePath("GET", spec.path, func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {}, runtime.WithRPCMethod(spec.rpcMethod)); err != nil {
+			t.Fatalf("mux.HandlePath(%q) failed with %v; want success", spec.path, err)
+		}
+	}
+
+	if got, want := mux.RemoveService("example.v1.A"), 2; got != want {
+		t.Errorf("mux.RemoveService(%q) = %d; want %d", "example.v1.A", got, want)
+	}
+	for path, want := range map[string]int{
+		"/v1/a":     http.StatusNotFound,
+		"/v1/a/foo": http.StatusNotFound,
+		"/v1/b":     http.StatusOK,
+	} {
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, httptest.NewRequest("GET", path, nil))
+		if w.Code != want {
+			t.Errorf("GET %s: w.Code = %d; want %d", path, w.Code, want)
+		}
+	}
+}
+
+func TestServeMux_ReplaceRoutes(t *testing.T) {
+	mux := runtime.NewServeMux(runtime.WithHealthzEndpoint(&dummyHealthCheckClient{status: grpc_health_v1.HealthCheckResponse_SERVING}))
+	if err := mux.HandlePath("GET", "/old", func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {}); err != nil {
+		t.Fatal(err)
+	}
+
+	errRegister := errors.New("registration failed")
+	err := mux.ReplaceRoutes(func(mux *runtime.ServeMux) error {
+		return errRegister
+	})
+	if !errors.Is(err, errRegister) {
+		t.Errorf("mux.ReplaceRoutes() = %v; want %v", err, errRegister)
+	}
+
+	err = mux.ReplaceRoutes(func(mux *runtime.ServeMux) error {
+		return mux.HandlePath("GET", "/new", func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {})
+	})
+	if err != nil {
+		t.Fatalf("mux.ReplaceRoutes() failed with %v; want success", err)
+	}
+	for path, want := range map[string]int{
+		"/old":     http.StatusNotFound,
+		"/new":     http.StatusOK,
+		"/healthz": http.StatusOK,
+	} {
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, httptest.NewRequest("GET", path, nil))
+		if w.Code != want {
+			t.Errorf("GET %s: w.Code = %d; want %d", path, w.Code, want)
+		}
+	}
+}
+
+func TestServeMux_ReplaceService(t *testing.T) {
+	mux := runtime.NewServeMux()
+	handle := func(mux *runtime.ServeMux, path, body string, opts ...runtime.RouteOption) error {
+		return mux.HandlePath("GET", path, func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+			_, _ = fmt.Fprint(w, body)
+		}, opts...)
+	}
+	if err := handle(mux, "/v1/a", "old a", runtime.WithRPCMethod("/example.v1.A/Get")); err != nil {
+		t.Fatal(err)
+	}
+	if err := handle(mux, "/v1/b", "b", runtime.WithRPCMethod("/example.v1.B/Get")); err != nil {
+		t.Fatal(err)
+	}
+
+	// In-flight requests finish with the handler they were dispatched to.
+	started, release := make(chan struct{}), make(chan struct{})
+	if err := mux.HandlePath("GET", "/v1/slow", func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		close(started)
+		<-release
+		_, _ = fmt.Fprint(w, "old slow")
+	}, runtime.WithRPCMethod("/example.v1.A/Slow")); err != nil {
+		t.Fatal(err)
+	}
+	slow := httptest.NewRecorder()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		mux.ServeHTTP(slow, httptest.NewRequest("GET", "/v1/slow", nil))
+	}()
+	<-started
+
+	err := mux.ReplaceService("example.v1.A", func(mux *runtime.ServeMux) error {
+		return handle(mux, "/v1/a", "new a")
+	})
+	if err != nil {
+		t.Fatalf("mux.ReplaceService() failed with %v; want success", err)
+	}
+	close(release)
+	<-done
+	if got, want := slow.Body.String(), "old slow"; got != want {
+		t.Errorf("in-flight request: w.Body = %q; want %q", got, want)
+	}
+
+	for path, want := range map[string]string{
+		"/v1/a": "new a",
+		"/v1/b": "b",
+	} {
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, httptest.NewRequest("GET", path, nil))
+		if got := w.Body.String(); got != want {
+			t.Errorf("GET %s: w.Body = %q; want %q", path, got, want)
+		}
+	}
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest("GET", "/v1/slow", nil))
+	if got, want := w.Code, http.StatusNotFound; got != want {
+		t.Errorf("GET /v1/slow: w.Code = %d; want %d", got, want)
+	}
+
+	// Handlers registered through ReplaceService belong to the service.
+	if got, want := mux.RemoveService("example.v1.A"), 1; got != want {
+		t.Errorf("mux.RemoveService(%q) = %d; want %d", "example.v1.A", got, want)
+	}
+}
+
+func TestServeMux_ReplaceRoutesUsesConfiguration(t *testing.T) {
+	// Each application of the option configures a distinct error handler,
+	// so that a handler bound to another ServeMux created with the same
+	// options is told apart.
+	var applied int
+	withErrorHandler := func(mux *runtime.ServeMux) {
+		applied++
+		id := strconv.Itoa(applied)
+		runtime.WithErrorHandler(func(ctx context.Context, mux *runtime.ServeMux, m runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+			w.WriteHeader(http.StatusTeapot)
+			_, _ = fmt.Fprint(w, id)
+		})(mux)
+	}
+	mux := runtime.NewServeMux(withErrorHandler)
+	failing := func(mux *runtime.ServeMux) error {
+		return mux.HandlePath("GET", "/v1/fail", func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+			_, outboundMarshaler := runtime.MarshalerForRequest(mux, r)
+			runtime.HTTPError(r.Context(), mux, outboundMarshaler, w, r, errors.New("failed"))
+		}, runtime.WithRPCMethod("/example.v1.A/Fail"))
+	}
+
+	for name, replace := range map[string]func() error{
+		"ReplaceRoutes": func() error {
+			return mux.ReplaceRoutes(failing)
+		},
+		"ReplaceService": func() error {
+			return mux.ReplaceService("example.v1.A", failing)
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if err := replace(); err != nil {
+				t.Fatalf("mux.%s() failed with %v; want success", name, err)
+			}
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, httptest.NewRequest("GET", "/v1/fail", nil))
+			if got, want := w.Code, http.StatusTeapot; got != want {
+				t.Errorf("w.Code = %d; want %d", got, want)
+			}
+			if got, want := w.Body.String(), "1"; got != want {
+				t.Errorf("w.Body = %q; want the error handler of mux %q", got, want)
+			}
+		})
+	}
+}
+
+func TestServeMux_HandleWhileServing(t *testing.T) {
+	mux := runtime.NewServeMux()
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				_ = mux.HandlePath("GET", fmt.Sprintf("/v1/%d/%d", i, j), func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {})
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				mux.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", fmt.Sprintf("/v1/%d/%d", i, j), nil))
+				mux.RemoveService("example.v1.A")
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestServeMux_RoutePredicates(t *testing.T) {
+	mux := runtime.NewServeMux()
+	handle := func(mux *runtime.ServeMux, meth, path, body string, opts ...runtime.RouteOption) {
+		t.Helper()
+		if err := mux.HandlePath(meth, path, func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+			_, _ = fmt.Fprint(w, body)
+		}, opts...); err != nil {
+			t.Fatalf("mux.HandlePath(%q) failed with %v; want success", meth, err)
+		}
+	}
+	handle(mux, "GET", "/v1/items", "default")
+	handle(mux.WithRouteOptions(runtime.WithRoutePredicates(runtime.MatchHeader("Accept-Version", "v2"))), "GET", "/v1/items", "v2")
+	handle(mux, "GET", "/v1/items", "canary", runtime.WithRoutePredicates(runtime.MatchCookie("canary", "")))
+	handle(mux, "GET", "/v1/items", "eu", runtime.WithRoutePredicates(runtime.MatchHost("*.eu.example.com")))
+	handle(mux, "DELETE", "/v1/admin", "admin", runtime.WithRoutePredicates(runtime.MatchHost("admin.example.com")))
+
+	for _, spec := range []struct {
+		name       string
+		method     string
+		path       string
+		host       string
+		headers    map[string]string
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "no predicates",
+			method:     "GET",
+			wantStatus: http.StatusOK,
+			wantBody:   "default",
+		},
+		{
+			name:       "header",
+			method:     "GET",
+			headers:    map[string]string{"accept-version": "v2"},
+			wantStatus: http.StatusOK,
+			wantBody:   "v2",
+		},
+		{
+			name:       "other header value",
+			method:     "GET",
+			headers:    map[string]string{"Accept-Version": "v3"},
+			wantStatus: http.StatusOK,
+			wantBody:   "default",
+		},
+		{
+			name:       "cookie",
+			method:     "GET",
+			headers:    map[string]string{"Cookie": "canary=1", "Accept-Version": "v2"},
+			wantStatus: http.StatusOK,
+			wantBody:   "canary",
+		},
+		{
+			name:       "host with port",
+			method:     "GET",
+			host:       "API.eu.example.com:8080",
+			wantStatus: http.StatusOK,
+			wantBody:   "eu",
+		},
+		{
+			name:       "host",
+			method:     "DELETE",
+			path:       "/v1/admin",
+			host:       "admin.example.com",
+			wantStatus: http.StatusOK,
+			wantBody:   "admin",
+		},
+		{
+			name:       "not allowed",
+			method:     "GET",
+			path:       "/v1/admin",
+			host:       "admin.example.com",
+			wantStatus: http.StatusNotImplemented,
+		},
+		{
+			name:       "predicates do not hold",
+			method:     "DELETE",
+			path:       "/v1/admin",
+			host:       "example.com",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "predicates of other methods do not hold",
+			method:     "GET",
+			path:       "/v1/admin",
+			host:       "example.com",
+			wantStatus: http.StatusNotFound,
+		},
+	} {
+		t.Run(spec.name, func(t *testing.T) {
+			path := spec.path
+			if path == "" {
+				path = "/v1/items"
+			}
+			r := httptest.NewRequest(spec.method, path, nil)
+			if spec.host != "" {
+				r.Host = spec.host
+			}
+			for k, v := range spec.headers {
+				r.Header.Set(k, v)
+			}
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, r)
+			if got, want := w.Code, spec.wantStatus; got != want {
+				t.Errorf("w.Code = %d; want %d", got, want)
+			}
+			if spec.wantBody == "" {
+				return
+			}
+			if got, want := w.Body.String(), spec.wantBody; got != want {
+				t.Errorf("w.Body = %q; want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestMatchHost(t *testing.T) {
+	for _, spec := range []struct {
+		host    string
+		reqHost string
+		want    bool
+	}{
+		{host: "example.com", reqHost: "example.com", want: true},
+		{host: "example.com", reqHost: "EXAMPLE.com:443", want: true},
+		{host: "example.com", reqHost: "api.example.com", want: false},
+		{host: "example.com:8080", reqHost: "example.com:8080", want: true},
+		{host: "example.com:8080", reqHost: "example.com", want: false},
+		{host: "*.example.com", reqHost: "api.example.com", want: true},
+		{host: "*.example.com", reqHost: "example.com", want: false},
+		{host: "*.example.com", reqHost: "api.example.org", want: false},
+		{host: "[::1]", reqHost: "[::1]:8080", want: true},
+		{host: "::1", reqHost: "[::1]:8080", want: true},
+	} {
+		r := httptest.NewRequest("GET", "/", nil)
+		r.Host = spec.reqHost
+		if got := runtime.MatchHost(spec.host)(r); got != spec.want {
+			t.Errorf("MatchHost(%q) for host %q = %t; want %t", spec.host, spec.reqHost, got, spec.want)
+		}
+	}
+}
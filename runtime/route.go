@@ -0,0 +1,77 @@
+package runtime
+
+import (
+	"fmt"
+)
+
+// StreamingKind is the kind of streaming of an RPC method.
+type StreamingKind int
+
+const (
+	// StreamingKindUnary is the kind of methods without streaming.
+	StreamingKindUnary StreamingKind = iota
+	// StreamingKindClientStreaming is the kind of methods streaming requests.
+	StreamingKindClientStreaming
+	// StreamingKindServerStreaming is the kind of methods streaming responses.
+	StreamingKindServerStreaming
+	// StreamingKindBidiStreaming is the kind of methods streaming requests and responses.
+	StreamingKindBidiStreaming
+)
+
+var streamingKindNames = []string{
+	StreamingKindUnary:           "unary",
+	StreamingKindClientStreaming: "client_streaming",
+	StreamingKindServerStreaming: "server_streaming",
+	StreamingKindBidiStreaming:   "bidi_streaming",
+}
+
+// String returns the name of k, e.g. "server_streaming".
+func (k StreamingKind) String() string {
+	if k < 0 || int(k) >= len(streamingKindNames) {
+		return fmt.Sprintf("StreamingKind(%d)", int(k))
+	}
+	return streamingKindNames[k]
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (k StreamingKind) MarshalText() ([]byte, error) {
+	if k < 0 || int(k) >= len(streamingKindNames) {
+		return nil, fmt.Errorf("invalid streaming kind %d", int(k))
+	}
+	return []byte(streamingKindNames[k]), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (k *StreamingKind) UnmarshalText(text []byte) error {
+	for i, name := range streamingKindNames {
+		if name == string(text) {
+			*k = StreamingKind(i)
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid streaming kind %q", text)
+}
+
+// Route describes an HTTP binding of an RPC method.
+//
+// protoc-gen-grpc-gateway generates a Route for each binding with the
+// generate_route_descriptors option, and a JSON manifest of the Routes of each
+// proto file with the generate_route_manifest option.
+type Route struct {
+	// HTTPMethod is the HTTP method of the binding, e.g. "GET".
+	HTTPMethod string `json:"httpMethod"`
+	// PathTemplate is the path template of the binding, e.g.
+	// "/v1/{name=shelves/*}".
+	PathTemplate string `json:"pathTemplate"`
+	// RPCMethod is the full name of the RPC method, e.g.
+	// "/example.v1.EchoService/Echo", which is the name returned by RPCMethod.
+	RPCMethod string `json:"rpcMethod"`
+	// Body is the path of the request field mapped to the request body, "*"
+	// if the whole request is mapped to it, or empty if the binding has no body.
+	Body string `json:"body,omitempty"`
+	// ResponseBody is the path of the response field mapped to the response
+	// body, or empty if the whole response is mapped to it.
+	ResponseBody string `json:"responseBody,omitempty"`
+	// Streaming is the kind of streaming of the RPC method.
+	Streaming StreamingKind `json:"streaming"`
+}
@@ -0,0 +1,67 @@
+package runtime
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime/internal/httprequest"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// NewRouteRequest returns an HTTP request calling the RPC method of route with
+// the request message req, e.g. to test the handlers of the route with
+// httptest. The path variables of the route are set to the fields of req they
+// are bound to, the field mapped to the request body is marshaled as JSON to the
+// body, and the other populated fields of req are encoded as query parameters.
+// Requests are encoded like the generated HTTP clients of the httpclient package
+// encode them.
+//
+// protoc-gen-grpc-gateway generates a function calling NewRouteRequest for each
+// binding with the generate_fakes option.
+func NewRouteRequest(ctx context.Context, route Route, req proto.Message) (*http.Request, error) {
+	path, params, err := httprequest.ExpandPath(route.PathTemplate, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var query url.Values
+	switch route.Body {
+	case "*":
+	case "":
+		query, err = httprequest.QueryValues(req, params...)
+	default:
+		query, err = httprequest.QueryValues(req, append(params, route.Body)...)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var body io.Reader
+	if route.Body != "" {
+		marshal := func(v interface{}) ([]byte, error) {
+			return protojson.MarshalOptions{UseProtoNames: true}.Marshal(v.(proto.Message))
+		}
+		buf, err := httprequest.MarshalBody(marshal, req, route.Body, params)
+		if err != nil {
+			return nil, err
+		}
+		body = bytes.NewReader(buf)
+	}
+
+	target := path
+	if len(query) > 0 {
+		target += "?" + query.Encode()
+	}
+	r, err := http.NewRequestWithContext(ctx, route.HTTPMethod, target, body)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		r.Header.Set("Content-Type", "application/json")
+	}
+	return r, nil
+}
@@ -0,0 +1,219 @@
+package runtime_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime/httpclient"
+	pb "github.com/grpc-ecosystem/grpc-gateway/v2/runtime/internal/examplepb"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestNewRouteRequest(t *testing.T) {
+	for _, spec := range []struct {
+		name      string
+		route     runtime.Route
+		req       proto.Message
+		wantPath  string
+		wantQuery url.Values
+		wantBody  string
+	}{
+		{
+			name:  "query parameters",
+			route: runtime.Route{HTTPMethod: "GET", PathTemplate: "/v1/{uuid}"},
+			req: &pb.ABitOfEverything{
+				Uuid:                "a/b",
+				Int32Value:          3,
+				RepeatedStringValue: []string{"x", "y"},
+				SingleNested:        &pb.ABitOfEverything_Nested{Name: "n", Ok: pb.ABitOfEverything_Nested_TRUE},
+				MappedStringValue:   map[string]string{"k": "v"},
+			},
+			wantPath: "/v1/a%2Fb",
+			wantQuery: url.Values{
+				"int32_value":            {"3"},
+				"repeated_string_value":  {"x", "y"},
+				"single_nested.name":     {"n"},
+				"single_nested.ok":       {"TRUE"},
+				"mapped_string_value[k]": {"v"},
+			},
+		},
+		{
+			name:  "whole body",
+			route: runtime.Route{HTTPMethod: "POST", PathTemplate: "/v1/{single_nested.name=nested/*}:create", Body: "*"},
+			req: &pb.ABitOfEverything{
+				Uuid:         "foo",
+				SingleNested: &pb.ABitOfEverything_Nested{Name: "nested/a b", Amount: 2},
+			},
+			wantPath:  "/v1/nested/a%20b:create",
+			wantQuery: url.Values{},
+			wantBody:  `{"single_nested":{"amount":2},"uuid":"foo"}`,
+		},
+		{
+			name:  "body field",
+			route: runtime.Route{HTTPMethod: "PATCH", PathTemplate: "/v1/{uuid}/{int32_value}", Body: "single_nested"},
+			req: &pb.ABitOfEverything{
+				Uuid:         "foo",
+				StringValue:  "bar",
+				SingleNested: &pb.ABitOfEverything_Nested{Name: "n"},
+			},
+			wantPath:  "/v1/foo/0",
+			wantQuery: url.Values{"string_value": {"bar"}},
+			wantBody:  `{"name":"n"}`,
+		},
+		{
+			name:      "deep wildcard",
+			route:     runtime.Route{HTTPMethod: "GET", PathTemplate: "/v1/{uuid=**}"},
+			req:       &pb.ABitOfEverything{Uuid: "a/b/c"},
+			wantPath:  "/v1/a/b/c",
+			wantQuery: url.Values{},
+		},
+	} {
+		t.Run(spec.name, func(t *testing.T) {
+			r, err := runtime.NewRouteRequest(context.Background(), spec.route, spec.req)
+			if err != nil {
+				t.Fatalf("runtime.NewRouteRequest(ctx, %+v, %v) failed with %v; want success", spec.route, spec.req, err)
+			}
+			if got, want := r.Method, spec.route.HTTPMethod; got != want {
+				t.Errorf("r.Method = %q; want %q", got, want)
+			}
+			if got, want := r.URL.EscapedPath(), spec.wantPath; got != want {
+				t.Errorf("r.URL.EscapedPath() = %q; want %q", got, want)
+			}
+			if got, want := r.URL.Query(), spec.wantQuery; !reflect.DeepEqual(got, want) {
+				t.Errorf("r.URL.Query() = %v; want %v", got, want)
+			}
+			if spec.wantBody == "" {
+				if r.Body != nil {
+					t.Errorf("r.Body = %v; want no body", r.Body)
+				}
+				return
+			}
+			if got, want := r.Header.Get("Content-Type"), "application/json"; got != want {
+				t.Errorf("Content-Type = %q; want %q", got, want)
+			}
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				t.Fatalf("io.ReadAll(r.Body) failed with %v; want success", err)
+			}
+			var got, want interface{}
+			if err := json.Unmarshal(body, &got); err != nil {
+				t.Fatalf("json.Unmarshal(%q) failed with %v; want success", body, err)
+			}
+			if err := json.Unmarshal([]byte(spec.wantBody), &want); err != nil {
+				t.Fatalf("json.Unmarshal(%q) failed with %v; want success", spec.wantBody, err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("body = %s; want %s", body, spec.wantBody)
+			}
+		})
+	}
+}
+
+func TestNewRouteRequestRouting(t *testing.T) {
+	route := runtime.Route{HTTPMethod: "GET", PathTemplate: "/v1/{uuid=things/*}", RPCMethod: "/example.Service/Get"}
+	mux := runtime.NewServeMux()
+	var got pb.ABitOfEverything
+	if err := mux.HandlePath(route.HTTPMethod, route.PathTemplate, func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		got.Uuid = pathParams["uuid"]
+		if err := runtime.PopulateQueryParameters(&got, r.URL.Query(), utilities.NewDoubleArray(nil)); err != nil {
+			t.Errorf("runtime.PopulateQueryParameters(...) failed with %v; want success", err)
+		}
+	}); err != nil {
+		t.Fatalf("mux.HandlePath(...) failed with %v; want success", err)
+	}
+
+	want := &pb.ABitOfEverything{
+		Uuid:              "things/1",
+		Int64Value:        -5,
+		BoolValue:         true,
+		RepeatedEnumValue: []pb.NumericEnum{pb.NumericEnum_ONE},
+		SingleNested:      &pb.ABitOfEverything_Nested{Amount: 7},
+	}
+	r, err := runtime.NewRouteRequest(context.Background(), route, want)
+	if err != nil {
+		t.Fatalf("runtime.NewRouteRequest(ctx, %+v, %v) failed with %v; want success", route, want, err)
+	}
+	mux.ServeHTTP(httptest.NewRecorder(), r)
+	if !proto.Equal(&got, want) {
+		t.Errorf("request = %v; want %v", &got, want)
+	}
+}
+
+func TestNewRouteRequestEncoding(t *testing.T) {
+	route := runtime.Route{HTTPMethod: "GET", PathTemplate: "/v1/{string_value}"}
+	want := &pb.Proto3Message{
+		StringValue:       "foo",
+		BytesValue:        []byte{0xfb, 0xff},
+		WrapperBytesValue: wrapperspb.Bytes([]byte{0xfe}),
+		StructValueValue:  structpb.NewStringValue("value"),
+		StructValue: &structpb.Struct{Fields: map[string]*structpb.Value{
+			"k": structpb.NewNumberValue(1),
+		}},
+	}
+	r, err := runtime.NewRouteRequest(context.Background(), route, want)
+	if err != nil {
+		t.Fatalf("runtime.NewRouteRequest(ctx, %+v, %v) failed with %v; want success", route, want, err)
+	}
+
+	// The query must be the one the generated HTTP clients send.
+	query, err := httpclient.QueryValues(want, "string_value")
+	if err != nil {
+		t.Fatalf("httpclient.QueryValues(%v) failed with %v; want success", want, err)
+	}
+	if got := r.URL.Query(); !reflect.DeepEqual(got, query) {
+		t.Errorf("r.URL.Query() = %v; want %v", got, query)
+	}
+
+	got := &pb.Proto3Message{StringValue: "foo"}
+	if err := runtime.PopulateQueryParameters(got, r.URL.Query(), utilities.NewDoubleArray(nil)); err != nil {
+		t.Fatalf("runtime.PopulateQueryParameters(%q) failed with %v; want success", r.URL.RawQuery, err)
+	}
+	if !proto.Equal(got, want) {
+		t.Errorf("request = %v; want %v", got, want)
+	}
+}
+
+func TestNewRouteRequestErrors(t *testing.T) {
+	for _, spec := range []struct {
+		name  string
+		route runtime.Route
+		req   proto.Message
+	}{
+		{
+			name:  "invalid template",
+			route: runtime.Route{HTTPMethod: "GET", PathTemplate: "v1/{uuid"},
+			req:   &pb.ABitOfEverything{Uuid: "foo"},
+		},
+		{
+			name:  "empty path variable",
+			route: runtime.Route{HTTPMethod: "GET", PathTemplate: "/v1/{uuid}"},
+			req:   &pb.ABitOfEverything{},
+		},
+		{
+			name:  "message path variable",
+			route: runtime.Route{HTTPMethod: "GET", PathTemplate: "/v1/{single_nested}"},
+			req:   &pb.ABitOfEverything{SingleNested: &pb.ABitOfEverything_Nested{Name: "n"}},
+		},
+		{
+			name:  "repeated message query parameter",
+			route: runtime.Route{HTTPMethod: "GET", PathTemplate: "/v1/things"},
+			req:   &pb.ABitOfEverything{Nested: []*pb.ABitOfEverything_Nested{{Name: "n"}}},
+		},
+	} {
+		t.Run(spec.name, func(t *testing.T) {
+			if r, err := runtime.NewRouteRequest(context.Background(), spec.route, spec.req); err == nil {
+				t.Errorf("runtime.NewRouteRequest(ctx, %+v, %v) = %v; want an error", spec.route, spec.req, r.URL)
+			}
+		})
+	}
+}
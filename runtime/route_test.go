@@ -0,0 +1,77 @@
+package runtime_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+)
+
+func TestRouteJSON(t *testing.T) {
+	for _, spec := range []struct {
+		route runtime.Route
+		want  string
+	}{
+		{
+			route: runtime.Route{
+				HTTPMethod:   "GET",
+				PathTemplate: "/v1/{name=shelves/*}",
+				RPCMethod:    "/example.Library/GetShelf",
+			},
+			want: `{"httpMethod":"GET","pathTemplate":"/v1/{name=shelves/*}","rpcMethod":"/example.Library/GetShelf","streaming":"unary"}`,
+		},
+		{
+			route: runtime.Route{
+				HTTPMethod:   "POST",
+				PathTemplate: "/v1/shelves:watch",
+				RPCMethod:    "/example.Library/WatchShelves",
+				Body:         "*",
+				ResponseBody: "shelf.books",
+				Streaming:    runtime.StreamingKindBidiStreaming,
+			},
+			want: `{"httpMethod":"POST","pathTemplate":"/v1/shelves:watch","rpcMethod":"/example.Library/WatchShelves","body":"*","responseBody":"shelf.books","streaming":"bidi_streaming"}`,
+		},
+	} {
+		buf, err := json.Marshal(spec.route)
+		if err != nil {
+			t.Errorf("json.Marshal(%+v) failed with %v; want success", spec.route, err)
+			continue
+		}
+		if got := string(buf); got != spec.want {
+			t.Errorf("json.Marshal(%+v) = %s; want %s", spec.route, got, spec.want)
+		}
+		var got runtime.Route
+		if err := json.Unmarshal(buf, &got); err != nil {
+			t.Errorf("json.Unmarshal(%s) failed with %v; want success", buf, err)
+			continue
+		}
+		if got != spec.route {
+			t.Errorf("json.Unmarshal(%s) = %+v; want %+v", buf, got, spec.route)
+		}
+	}
+}
+
+func TestStreamingKind(t *testing.T) {
+	for _, spec := range []struct {
+		kind runtime.StreamingKind
+		want string
+	}{
+		{kind: runtime.StreamingKindUnary, want: "unary"},
+		{kind: runtime.StreamingKindClientStreaming, want: "client_streaming"},
+		{kind: runtime.StreamingKindServerStreaming, want: "server_streaming"},
+		{kind: runtime.StreamingKindBidiStreaming, want: "bidi_streaming"},
+		{kind: runtime.StreamingKind(42), want: "StreamingKind(42)"},
+	} {
+		if got := spec.kind.String(); got != spec.want {
+			t.Errorf("%d.String() = %q; want %q", int(spec.kind), got, spec.want)
+		}
+	}
+
+	if _, err := runtime.StreamingKind(42).MarshalText(); err == nil {
+		t.Errorf("StreamingKind(42).MarshalText() succeeded; want an error")
+	}
+	var kind runtime.StreamingKind
+	if err := kind.UnmarshalText([]byte("duplex")); err == nil {
+		t.Errorf("kind.UnmarshalText(%q) succeeded; want an error", "duplex")
+	}
+}